@@ -0,0 +1,287 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package admins rotates the admin certificate of an organization across its MSP definition and the peers and orderers
+// that use it.
+package admins
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"github.com/IBM-Blockchain/ibp-go-sdk/blockchainv3"
+	"github.com/IBM-Blockchain/ibp-go-sdk/certs"
+	"github.com/IBM/go-sdk-core/v4/core"
+	"strings"
+)
+
+// The types of the components a rotation edits.
+const (
+	TypeMSP     = "msp"
+	TypePeer    = blockchainv3.GenericComponentResponse_Type_FabricPeer
+	TypeOrderer = blockchainv3.GenericComponentResponse_Type_FabricOrderer
+)
+
+// Target : A component whose admins a rotation edits.
+type Target struct {
+	ComponentID string `json:"component_id"`
+
+	DisplayName string `json:"display_name,omitempty"`
+
+	// One of TypeMSP, TypePeer and TypeOrderer.
+	Type string `json:"type"`
+
+	// Whether the new certificate was added to the admins of the component, and the old one removed from them.
+	Appended bool `json:"appended"`
+	Removed  bool `json:"removed"`
+
+	// Why the last attempt failed, if it did.
+	Error string `json:"error,omitempty"`
+}
+
+// Rotation : The progress of the rotation of an admin certificate. It can be saved as JSON and passed to Resume.
+type Rotation struct {
+	MspID string `json:"msp_id"`
+
+	// The base 64 encoded PEM certificates.
+	NewCert string `json:"new_cert"`
+	OldCert string `json:"old_cert"`
+
+	Targets []*Target `json:"targets"`
+}
+
+// Done returns true if the old certificate was replaced on every target.
+func (rotation *Rotation) Done() bool {
+	for _, target := range rotation.Targets {
+		if !target.Appended || !target.Removed {
+			return false
+		}
+	}
+	return true
+}
+
+// IncompleteError : The rotation failed on some of its targets. Resume retries them.
+type IncompleteError struct {
+	MspID string
+
+	// The targets on which the last attempt failed.
+	Failed []*Target
+}
+
+func (e *IncompleteError) Error() string {
+	failures := make([]string, len(e.Failed))
+	for i, target := range e.Failed {
+		failures[i] = target.ComponentID + ": " + target.Error
+	}
+	return fmt.Sprintf("the rotation of the %s admin is incomplete: %s", e.MspID, strings.Join(failures, "; "))
+}
+
+// RotateOrgAdmin replaces the old admin certificate of an organization with a new one, in the MSP definitions with the
+// MSP ID and in the admin certificates of the peers and orderers that use it. The certificates are PEM encoded, or base
+// 64 encoded PEMs as the console stores them.
+// The new certificate is appended everywhere first; the old one is removed only once every target accepts the new
+// one, so that the organization keeps a working admin if the rotation fails half way. Each edit of a peer or an orderer
+// is verified against the admin certificates the console reports back.
+// When some targets fail, it returns the rotation along with an *IncompleteError; pass the rotation to Resume once the
+// problem is fixed.
+func RotateOrgAdmin(ctx context.Context, service blockchainv3.BlockchainV3API, mspID, newCertPEM, oldCertPEM string) (*Rotation, error) {
+	newCert, newDER, err := normalize(newCertPEM)
+	if err != nil {
+		return nil, fmt.Errorf("invalid new certificate: %w", err)
+	}
+	oldCert, oldDER, err := normalize(oldCertPEM)
+	if err != nil {
+		return nil, fmt.Errorf("invalid old certificate: %w", err)
+	}
+	if bytes.Equal(newDER, oldDER) {
+		return nil, errors.New("the new and old certificates are the same")
+	}
+
+	list, _, err := service.ListComponentsWithContext(ctx, service.NewListComponentsOptions())
+	if err != nil {
+		return nil, fmt.Errorf("could not list the components: %w", err)
+	}
+	rotation := &Rotation{MspID: mspID, NewCert: newCert, OldCert: oldCert}
+	for _, component := range list.Components {
+		componentType := core.StringNilMapper(component.Type)
+		if core.StringNilMapper(component.MspID) != mspID {
+			continue
+		}
+		if componentType != TypeMSP && componentType != TypePeer && componentType != TypeOrderer {
+			continue
+		}
+		rotation.Targets = append(rotation.Targets, &Target{
+			ComponentID: core.StringNilMapper(component.ID),
+			DisplayName: core.StringNilMapper(component.DisplayName),
+			Type:        componentType,
+		})
+	}
+	if len(rotation.Targets) == 0 {
+		return nil, fmt.Errorf("no component uses the MSP ID %s", mspID)
+	}
+	return rotation, Resume(ctx, service, rotation)
+}
+
+// Resume carries on with a rotation: it appends the new certificate to the targets that do not have it yet and, once
+// all of them do, removes the old certificate from the targets that still have it. It updates the rotation in place and
+// returns an *IncompleteError if some targets fail again.
+func Resume(ctx context.Context, service blockchainv3.BlockchainV3API, rotation *Rotation) error {
+	_, newDER, err := normalize(rotation.NewCert)
+	if err != nil {
+		return fmt.Errorf("invalid new certificate: %w", err)
+	}
+	_, oldDER, err := normalize(rotation.OldCert)
+	if err != nil {
+		return fmt.Errorf("invalid old certificate: %w", err)
+	}
+
+	var failed []*Target
+	for _, target := range rotation.Targets {
+		if target.Appended {
+			continue
+		}
+		if err := edit(ctx, service, rotation, target, true, newDER, oldDER); err != nil {
+			target.Error = err.Error()
+			failed = append(failed, target)
+			continue
+		}
+		target.Appended, target.Error = true, ""
+	}
+	if len(failed) > 0 {
+		return &IncompleteError{MspID: rotation.MspID, Failed: failed}
+	}
+
+	for _, target := range rotation.Targets {
+		if target.Removed {
+			continue
+		}
+		if err := edit(ctx, service, rotation, target, false, newDER, oldDER); err != nil {
+			target.Error = err.Error()
+			failed = append(failed, target)
+			continue
+		}
+		target.Removed, target.Error = true, ""
+	}
+	if len(failed) > 0 {
+		return &IncompleteError{MspID: rotation.MspID, Failed: failed}
+	}
+	return nil
+}
+
+// edit appends the new certificate to the admins of a target, or removes the old one from them.
+func edit(ctx context.Context, service blockchainv3.BlockchainV3API, rotation *Rotation, target *Target, appending bool, newDER, oldDER []byte) error {
+	if target.Type == TypeMSP {
+		return editMsp(ctx, service, rotation, target, appending, newDER, oldDER)
+	}
+
+	options := service.NewEditAdminCertsOptions(target.ComponentID)
+	if appending {
+		options.SetAppendAdminCerts([]string{rotation.NewCert})
+	} else {
+		component, _, err := service.GetComponentWithContext(ctx, service.NewGetComponentOptions(target.ComponentID))
+		if err != nil {
+			return fmt.Errorf("could not get the admin certificates: %w", err)
+		}
+		var current []string
+		if component.Msp != nil && component.Msp.Component != nil {
+			current = component.Msp.Component.AdminCerts
+		}
+		remove := matching(current, oldDER)
+		if len(remove) == 0 {
+			return nil
+		}
+		options.SetRemoveAdminCerts(remove)
+	}
+	result, _, err := service.EditAdminCertsWithContext(ctx, options)
+	if err != nil {
+		return err
+	}
+
+	var set []string
+	for _, item := range result.SetAdminCerts {
+		set = append(set, core.StringNilMapper(item.Base64Pem))
+	}
+	if appending && len(matching(set, newDER)) == 0 {
+		return errors.New("the console does not report the new certificate as an admin certificate")
+	}
+	if !appending && len(matching(set, oldDER)) > 0 {
+		return errors.New("the console still reports the old certificate as an admin certificate")
+	}
+	return nil
+}
+
+// editMsp sets the admins of an MSP definition. The console only reports the definitions of an MSP ID together, so
+// every definition of the MSP ID is given the same admins.
+func editMsp(ctx context.Context, service blockchainv3.BlockchainV3API, rotation *Rotation, target *Target, appending bool, newDER, oldDER []byte) error {
+	msp, _, err := service.GetMspCertificateWithContext(ctx, service.NewGetMspCertificateOptions(rotation.MspID))
+	if err != nil {
+		return fmt.Errorf("could not get the MSP admins: %w", err)
+	}
+	var admins []string
+	for _, definition := range msp.Msps {
+		for _, admin := range definition.Admins {
+			if der, err := certs.DER(admin); err == nil && len(matching(admins, der)) > 0 {
+				continue
+			}
+			if !appending && len(matching([]string{admin}, oldDER)) > 0 {
+				continue
+			}
+			admins = append(admins, admin)
+		}
+	}
+	if appending && len(matching(admins, newDER)) == 0 {
+		admins = append(admins, rotation.NewCert)
+	}
+
+	options := service.NewEditMspOptions(target.ComponentID)
+	options.SetAdmins(admins)
+	result, _, err := service.EditMspWithContext(ctx, options)
+	if err != nil {
+		return err
+	}
+	if appending && len(matching(result.Admins, newDER)) == 0 {
+		return errors.New("the console does not report the new certificate as an admin")
+	}
+	if !appending && len(matching(result.Admins, oldDER)) > 0 {
+		return errors.New("the console still reports the old certificate as an admin")
+	}
+	return nil
+}
+
+// matching returns the certificates that encode the certificate, whatever their encoding.
+func matching(candidates []string, der []byte) []string {
+	var matches []string
+	for _, cert := range candidates {
+		if certDER, err := certs.DER(cert); err == nil && bytes.Equal(certDER, der) {
+			matches = append(matches, cert)
+		}
+	}
+	return matches
+}
+
+// normalize returns a certificate as a base 64 encoded PEM, and its DER encoding.
+func normalize(cert string) (string, []byte, error) {
+	der, err := certs.DER(cert)
+	if err != nil {
+		return "", nil, err
+	}
+	if _, err := x509.ParseCertificate(der); err != nil {
+		return "", nil, err
+	}
+	return certs.Encode(der), der, nil
+}
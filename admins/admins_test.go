@@ -0,0 +1,159 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package admins_test
+
+import (
+	"context"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"github.com/IBM-Blockchain/ibp-go-sdk/admins"
+	"github.com/IBM-Blockchain/ibp-go-sdk/blockchainv3"
+	"github.com/IBM-Blockchain/ibp-go-sdk/blockchainv3fake"
+	"github.com/IBM-Blockchain/ibp-go-sdk/internal/testutil"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"time"
+)
+
+// cert returns a PEM certificate.
+func cert(commonName string) string {
+	return string(testutil.SelfSignedCertPEM(pkix.Name{CommonName: commonName}, time.Now().UnixNano(), time.Now().AddDate(1, 0, 0)))
+}
+
+func encode(pemCert string) string {
+	return base64.StdEncoding.EncodeToString([]byte(pemCert))
+}
+
+func crypto(adminCert string) *blockchainv3.CryptoObject {
+	crypto := testutil.CryptoObject()
+	crypto.Msp.Component.AdminCerts = []string{adminCert}
+	return crypto
+}
+
+func adminCerts(console *testutil.FailingConsole, id string) []string {
+	component, _, err := console.GetComponent(console.NewGetComponentOptions(id))
+	Expect(err).To(BeNil())
+	return component.Msp.Component.AdminCerts
+}
+
+func mspAdmins(console *testutil.FailingConsole, mspID string) []string {
+	msp, _, err := console.GetMspCertificate(console.NewGetMspCertificateOptions(mspID))
+	Expect(err).To(BeNil())
+	return msp.Msps[0].Admins
+}
+
+var _ = Describe(`Admins`, func() {
+	var console *testutil.FailingConsole
+	var oldCert, newCert, otherCert string
+	var mspComponentID string
+	var ordererIDs []string
+
+	BeforeEach(func() {
+		console = testutil.NewFailingConsole(blockchainv3fake.NewConsole())
+		oldCert, newCert, otherCert = cert("old admin"), cert("new admin"), cert("other admin")
+
+		mspOptions := console.NewImportMspOptions("org1msp", "Org1 MSP", []string{"root"})
+		mspOptions.SetAdmins([]string{encode(oldCert), encode(otherCert)})
+		msp, _, err := console.ImportMsp(mspOptions)
+		Expect(err).To(BeNil())
+		mspComponentID = *msp.ID
+
+		peerOptions := console.NewCreatePeerOptions("org1msp", "Peer", crypto(encode(oldCert)))
+		peerOptions.SetID("peer")
+		_, _, err = console.CreatePeer(peerOptions)
+		Expect(err).To(BeNil())
+
+		ordererOptions := console.NewCreateOrdererOptions("raft", "org1msp", "Orderer",
+			[]blockchainv3.CryptoObject{*crypto(encode(oldCert)), *crypto(encode(oldCert)), *crypto(encode(oldCert))})
+		created, _, err := console.CreateOrderer(ordererOptions)
+		Expect(err).To(BeNil())
+		ordererIDs = nil
+		for _, node := range created.Created {
+			ordererIDs = append(ordererIDs, *node.ID)
+		}
+
+		otherOptions := console.NewCreatePeerOptions("org2msp", "Other", crypto(encode(oldCert)))
+		otherOptions.SetID("other")
+		_, _, err = console.CreatePeer(otherOptions)
+		Expect(err).To(BeNil())
+	})
+
+	It(`Rotates the admin of an organization`, func() {
+		rotation, err := admins.RotateOrgAdmin(context.Background(), console, "org1msp", newCert, encode(oldCert))
+		Expect(err).To(BeNil())
+		Expect(rotation.Done()).To(BeTrue())
+		Expect(rotation.NewCert).To(Equal(encode(newCert)))
+		var ids []string
+		for _, target := range rotation.Targets {
+			ids = append(ids, target.ComponentID)
+		}
+		Expect(ids).To(Equal(append([]string{mspComponentID, "peer"}, ordererIDs...)))
+
+		Expect(mspAdmins(console, "org1msp")).To(Equal([]string{encode(otherCert), encode(newCert)}))
+		Expect(adminCerts(console, "peer")).To(Equal([]string{encode(newCert)}))
+		for _, id := range ordererIDs {
+			Expect(adminCerts(console, id)).To(Equal([]string{encode(newCert)}))
+		}
+		Expect(adminCerts(console, "other")).To(Equal([]string{encode(oldCert)}))
+	})
+	It(`Keeps the old admin until every component accepts the new one, and resumes`, func() {
+		console.Fail("EditAdminCerts", ordererIDs[1])
+		rotation, err := admins.RotateOrgAdmin(context.Background(), console, "org1msp", newCert, oldCert)
+		var incompleteErr *admins.IncompleteError
+		Expect(errors.As(err, &incompleteErr)).To(BeTrue())
+		Expect(incompleteErr.Failed).To(HaveLen(1))
+		Expect(incompleteErr.Failed[0].ComponentID).To(Equal(ordererIDs[1]))
+		Expect(err).To(MatchError("the rotation of the org1msp admin is incomplete: " + ordererIDs[1] + ": connection refused"))
+		Expect(rotation.Done()).To(BeFalse())
+		Expect(mspAdmins(console, "org1msp")).To(Equal([]string{encode(oldCert), encode(otherCert), encode(newCert)}))
+		Expect(adminCerts(console, "peer")).To(Equal([]string{encode(oldCert), encode(newCert)}))
+		Expect(adminCerts(console, ordererIDs[1])).To(Equal([]string{encode(oldCert)}))
+
+		data, err := json.Marshal(rotation)
+		Expect(err).To(BeNil())
+		var saved *admins.Rotation
+		Expect(json.Unmarshal(data, &saved)).To(Succeed())
+		Expect(saved).To(Equal(rotation))
+
+		console.Recover("EditAdminCerts", ordererIDs[1])
+		console.Fail("EditAdminCerts", "peer")
+		err = admins.Resume(context.Background(), console, saved)
+		Expect(errors.As(err, &incompleteErr)).To(BeTrue())
+		Expect(incompleteErr.Failed[0].ComponentID).To(Equal("peer"))
+		Expect(mspAdmins(console, "org1msp")).To(Equal([]string{encode(otherCert), encode(newCert)}))
+		Expect(adminCerts(console, ordererIDs[1])).To(Equal([]string{encode(newCert)}))
+		Expect(adminCerts(console, "peer")).To(Equal([]string{encode(oldCert), encode(newCert)}))
+
+		console.Recover("EditAdminCerts", "peer")
+		Expect(admins.Resume(context.Background(), console, saved)).To(Succeed())
+		Expect(saved.Done()).To(BeTrue())
+		for _, target := range saved.Targets {
+			Expect(target.Error).To(BeEmpty())
+		}
+		Expect(adminCerts(console, "peer")).To(Equal([]string{encode(newCert)}))
+	})
+	It(`Rejects invalid rotations`, func() {
+		_, err := admins.RotateOrgAdmin(context.Background(), console, "org1msp", "new", oldCert)
+		Expect(err).To(MatchError("invalid new certificate: not a PEM certificate"))
+		_, err = admins.RotateOrgAdmin(context.Background(), console, "org1msp", newCert, encode(newCert))
+		Expect(err).To(MatchError("the new and old certificates are the same"))
+		_, err = admins.RotateOrgAdmin(context.Background(), console, "org3msp", newCert, oldCert)
+		Expect(err).To(MatchError("no component uses the MSP ID org3msp"))
+	})
+})
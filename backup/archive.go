@@ -0,0 +1,218 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package backup
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"github.com/IBM-Blockchain/ibp-go-sdk/blockchainv3"
+	"github.com/IBM/go-sdk-core/v4/core"
+	"io"
+	"io/ioutil"
+	"os"
+	"time"
+)
+
+// Format identifies the archives written by this package.
+const Format = "ibp-console-backup"
+
+// manifestName is the archive entry that describes the others.
+const manifestName = "manifest.json"
+
+// manifest : The manifest of an archive.
+type manifest struct {
+	Format    string    `json:"format"`
+	Version   int       `json:"version"`
+	CreatedAt time.Time `json:"created_at"`
+
+	// The SHA-256 checksum of every other entry, in hex.
+	Checksums map[string]string `json:"checksums"`
+}
+
+// entries returns the archive entries of a backup, by name.
+func (backup *Backup) entries() map[string]interface{} {
+	return map[string]interface{}{
+		"components.json":   backup.Components,
+		"msps.json":         backup.MSPs,
+		"settings.json":     backup.Settings,
+		"fab_versions.json": backup.FabVersions,
+		"tags.json":         backup.Tags,
+	}
+}
+
+// Write writes the backup as a zip archive holding a JSON file per part of the snapshot, and a manifest with the
+// format version and the checksum of each file.
+func (backup *Backup) Write(w io.Writer) error {
+	archive := zip.NewWriter(w)
+	m := manifest{Format: Format, Version: Version, CreatedAt: backup.CreatedAt, Checksums: map[string]string{}}
+	entries := backup.entries()
+	for _, name := range entryNames {
+		data, err := json.MarshalIndent(entries[name], "", "  ")
+		if err != nil {
+			return fmt.Errorf("could not encode %s: %w", name, err)
+		}
+		sum := sha256.Sum256(data)
+		m.Checksums[name] = hex.EncodeToString(sum[:])
+		if err := writeEntry(archive, name, data); err != nil {
+			return err
+		}
+	}
+	data, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := writeEntry(archive, manifestName, data); err != nil {
+		return err
+	}
+	return archive.Close()
+}
+
+// entryNames are the archive entries, in the order they are written.
+var entryNames = []string{"components.json", "msps.json", "settings.json", "fab_versions.json", "tags.json"}
+
+func writeEntry(archive *zip.Writer, name string, data []byte) error {
+	w, err := archive.Create(name)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(data)
+	return err
+}
+
+// WriteFile writes the backup to a file.
+func (backup *Backup) WriteFile(name string) error {
+	var buffer bytes.Buffer
+	if err := backup.Write(&buffer); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(name, buffer.Bytes(), 0600)
+}
+
+// Read reads a backup archive. It fails if the archive was written by a newer version of this package, or if an
+// entry does not match its checksum.
+func Read(r io.ReaderAt, size int64) (*Backup, error) {
+	archive, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, fmt.Errorf("not a backup archive: %w", err)
+	}
+	files := map[string][]byte{}
+	for _, file := range archive.File {
+		rc, err := file.Open()
+		if err != nil {
+			return nil, err
+		}
+		data, err := ioutil.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			return nil, fmt.Errorf("could not read %s: %w", file.Name, err)
+		}
+		files[file.Name] = data
+	}
+
+	var m manifest
+	data, ok := files[manifestName]
+	if !ok {
+		return nil, fmt.Errorf("not a backup archive: %s is missing", manifestName)
+	}
+	if err := json.Unmarshal(data, &m); err != nil || m.Format != Format {
+		return nil, fmt.Errorf("not a backup archive: invalid %s", manifestName)
+	}
+	if m.Version < 1 || m.Version > Version {
+		return nil, fmt.Errorf("unsupported backup version %d, expected at most %d", m.Version, Version)
+	}
+
+	backup := &Backup{Version: m.Version, CreatedAt: m.CreatedAt}
+	for _, name := range entryNames {
+		data, ok := files[name]
+		if !ok {
+			return nil, fmt.Errorf("invalid backup archive: %s is missing", name)
+		}
+		sum := sha256.Sum256(data)
+		if hex.EncodeToString(sum[:]) != m.Checksums[name] {
+			return nil, fmt.Errorf("invalid backup archive: the checksum of %s does not match", name)
+		}
+		if err := backup.decode(name, data); err != nil {
+			return nil, fmt.Errorf("invalid backup archive: could not decode %s: %w", name, err)
+		}
+	}
+	return backup, nil
+}
+
+// decode decodes an archive entry. The models are decoded with their generated unmarshal functions, which keep the
+// properties the models do not declare.
+func (backup *Backup) decode(name string, data []byte) error {
+	switch name {
+	case "components.json":
+		var raws []map[string]json.RawMessage
+		if err := json.Unmarshal(data, &raws); err != nil {
+			return err
+		}
+		backup.Components = make([]Component, len(raws))
+		for i, raw := range raws {
+			var component *blockchainv3.GenericComponentResponse
+			if err := core.UnmarshalModel(raw, "", &component, blockchainv3.UnmarshalGenericComponentResponse); err != nil {
+				return err
+			}
+			backup.Components[i].GenericComponentResponse = *component
+			if certs, ok := raw["parsed_certs"]; ok {
+				if err := json.Unmarshal(certs, &backup.Components[i].Certs); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	case "msps.json":
+		var raws []json.RawMessage
+		if err := json.Unmarshal(data, &raws); err != nil {
+			return err
+		}
+		backup.MSPs = []blockchainv3.MspPublicData{}
+		return core.UnmarshalModel(raws, "", &backup.MSPs, blockchainv3.UnmarshalMspPublicData)
+	case "settings.json":
+		return decodeModel(data, &backup.Settings, blockchainv3.UnmarshalGetPublicSettingsResponse)
+	case "fab_versions.json":
+		return decodeModel(data, &backup.FabVersions, blockchainv3.UnmarshalGetFabricVersionsResponse)
+	}
+	return json.Unmarshal(data, &backup.Tags)
+}
+
+// decodeModel decodes a JSON model, or leaves the result nil if the entry holds null.
+func decodeModel(data []byte, result interface{}, unmarshal core.ModelUnmarshaller) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil || raw == nil {
+		return err
+	}
+	return core.UnmarshalModel(raw, "", result, unmarshal)
+}
+
+// ReadFile reads a backup archive from a file.
+func ReadFile(name string) (*Backup, error) {
+	file, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	info, err := file.Stat()
+	if err != nil {
+		return nil, err
+	}
+	return Read(file, info.Size())
+}
@@ -0,0 +1,139 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package backup snapshots the state of an IBP console to a portable archive and restores it into another console.
+package backup
+
+import (
+	"context"
+	"fmt"
+	"github.com/IBM-Blockchain/ibp-go-sdk/blockchainv3"
+	"github.com/IBM-Blockchain/ibp-go-sdk/certs"
+	"github.com/IBM-Blockchain/ibp-go-sdk/importer"
+	"github.com/IBM/go-sdk-core/v4/core"
+	"sort"
+	"time"
+)
+
+// Version is the version of the archive format written by this package. Read accepts archives of this version and
+// older.
+const Version = 1
+
+// Backup : A snapshot of a console.
+type Backup struct {
+	// The archive format version.
+	Version int `json:"version"`
+
+	// When the snapshot was taken.
+	CreatedAt time.Time `json:"created_at"`
+
+	// Every component of the console, with its deployment attributes and parsed certificates.
+	Components []Component `json:"components"`
+
+	// The MSP definitions of the MSP components, one per MSP ID.
+	MSPs []blockchainv3.MspPublicData `json:"msps"`
+
+	// The public settings of the console.
+	Settings *blockchainv3.GetPublicSettingsResponse `json:"settings,omitempty"`
+
+	// The Fabric versions the console can deploy.
+	FabVersions *blockchainv3.GetFabricVersionsResponse `json:"fab_versions,omitempty"`
+
+	// The IDs of the components with each tag.
+	Tags map[string][]string `json:"tags,omitempty"`
+}
+
+// Component : A component of the console.
+type Component struct {
+	blockchainv3.GenericComponentResponse
+
+	// The certificates of the component that could be parsed.
+	Certs []certs.Certificate `json:"parsed_certs,omitempty"`
+}
+
+// Create takes a snapshot of the console: its components (with deployment attributes), the MSP definitions of its MSP
+// components, its settings, Fabric versions and tags.
+func Create(ctx context.Context, service blockchainv3.BlockchainV3API) (*Backup, error) {
+	listOptions := service.NewListComponentsOptions()
+	listOptions.SetDeploymentAttrs(blockchainv3.ListComponentsOptions_DeploymentAttrs_Included)
+	listOptions.SetParsedCerts(blockchainv3.ListComponentsOptions_ParsedCerts_Included)
+	listOptions.SetCache(blockchainv3.ListComponentsOptions_Cache_Skip)
+	list, _, err := service.ListComponentsWithContext(ctx, listOptions)
+	if err != nil {
+		return nil, fmt.Errorf("could not list the components: %w", err)
+	}
+	settings, _, err := service.GetSettingsWithContext(ctx, service.NewGetSettingsOptions())
+	if err != nil {
+		return nil, fmt.Errorf("could not get the settings: %w", err)
+	}
+	fabVersions, _, err := service.GetFabVersionsWithContext(ctx, service.NewGetFabVersionsOptions())
+	if err != nil {
+		return nil, fmt.Errorf("could not get the Fabric versions: %w", err)
+	}
+
+	backup := &Backup{
+		Version:     Version,
+		CreatedAt:   time.Now().UTC(),
+		Components:  []Component{},
+		MSPs:        []blockchainv3.MspPublicData{},
+		Settings:    settings,
+		FabVersions: fabVersions,
+		Tags:        map[string][]string{},
+	}
+	mspIDs := map[string]bool{}
+	for _, component := range list.Components {
+		backup.Components = append(backup.Components, Component{
+			GenericComponentResponse: component,
+			Certs:                    parsedCerts(&component),
+		})
+		for _, tag := range component.Tags {
+			backup.Tags[tag] = append(backup.Tags[tag], core.StringNilMapper(component.ID))
+		}
+		if core.StringNilMapper(component.Type) == importer.TypeMSP && component.MspID != nil && !mspIDs[*component.MspID] {
+			mspIDs[*component.MspID] = true
+			msp, _, err := service.GetMspCertificateWithContext(ctx, service.NewGetMspCertificateOptions(*component.MspID))
+			if err != nil {
+				return nil, fmt.Errorf("could not get MSP %s: %w", *component.MspID, err)
+			}
+			backup.MSPs = append(backup.MSPs, msp.Msps...)
+		}
+	}
+	for _, ids := range backup.Tags {
+		sort.Strings(ids)
+	}
+	return backup, nil
+}
+
+// parsedCerts returns the certificates of a component that could be parsed.
+func parsedCerts(component *blockchainv3.GenericComponentResponse) []certs.Certificate {
+	var parsed []certs.Certificate
+	for _, cert := range certs.ComponentCertificates(component) {
+		if cert.Error == "" {
+			parsed = append(parsed, cert)
+		}
+	}
+	return parsed
+}
+
+// MSP returns the definition of an MSP, or nil if the backup has none.
+func (backup *Backup) MSP(mspID string) *blockchainv3.MspPublicData {
+	for i := range backup.MSPs {
+		if core.StringNilMapper(backup.MSPs[i].MspID) == mspID {
+			return &backup.MSPs[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,178 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package backup_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/x509/pkix"
+	"github.com/IBM-Blockchain/ibp-go-sdk/backup"
+	"github.com/IBM-Blockchain/ibp-go-sdk/blockchainv3"
+	"github.com/IBM-Blockchain/ibp-go-sdk/blockchainv3fake"
+	"github.com/IBM-Blockchain/ibp-go-sdk/importer"
+	"github.com/IBM-Blockchain/ibp-go-sdk/internal/testutil"
+	"github.com/IBM/go-sdk-core/v4/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"io/ioutil"
+	"time"
+)
+
+// rewrite returns a copy of a zip archive with one entry replaced.
+func rewrite(archive []byte, name string, data []byte) []byte {
+	reader, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+	Expect(err).To(BeNil())
+	var buffer bytes.Buffer
+	writer := zip.NewWriter(&buffer)
+	for _, file := range reader.File {
+		rc, err := file.Open()
+		Expect(err).To(BeNil())
+		content, err := ioutil.ReadAll(rc)
+		Expect(err).To(BeNil())
+		if file.Name == name {
+			content = data
+		}
+		w, err := writer.Create(file.Name)
+		Expect(err).To(BeNil())
+		_, err = w.Write(content)
+		Expect(err).To(BeNil())
+	}
+	Expect(writer.Close()).To(Succeed())
+	return buffer.Bytes()
+}
+
+var _ = Describe(`Backup`, func() {
+	var source *blockchainv3fake.Console
+	var ecert string
+	var notAfter time.Time
+
+	BeforeEach(func() {
+		source = blockchainv3fake.NewConsole()
+		notAfter = time.Now().Add(90 * 24 * time.Hour).UTC().Truncate(time.Second)
+		ecert = testutil.SelfSignedCert(pkix.Name{CommonName: "peer1"}, 42, notAfter)
+
+		caOptions := source.NewImportCaOptions("Org1 CA", "https://org1ca.example.com:7054", &blockchainv3.ImportCaBodyMsp{
+			Ca:        &blockchainv3.ImportCaBodyMspCa{Name: core.StringPtr("ca"), RootCerts: []string{"cm9vdA=="}},
+			Tlsca:     &blockchainv3.ImportCaBodyMspTlsca{Name: core.StringPtr("tlsca")},
+			Component: &blockchainv3.ImportCaBodyMspComponent{TlsCert: core.StringPtr("dGxz")},
+		})
+		caOptions.SetTags([]string{"org1"})
+		_, _, err := source.ImportCa(caOptions)
+		Expect(err).To(BeNil())
+
+		mspOptions := source.NewImportMspOptions("org1msp", "Org1 MSP", []string{"cm9vdA=="})
+		mspOptions.SetAdmins([]string{"YWRtaW4="})
+		mspOptions.SetTlsRootCerts([]string{"dGxzcm9vdA=="})
+		_, _, err = source.ImportMsp(mspOptions)
+		Expect(err).To(BeNil())
+
+		peerOptions := source.NewImportPeerOptions("Org1 Peer", "https://peer-proxy.example.com:8084", &blockchainv3.MspCryptoField{
+			Tlsca:     &blockchainv3.MspCryptoFieldTlsca{RootCerts: []string{"dGxzcm9vdA=="}},
+			Component: &blockchainv3.MspCryptoFieldComponent{TlsCert: core.StringPtr("dGxz"), Ecert: core.StringPtr(ecert)},
+		}, "org1msp")
+		peerOptions.SetID("org1peer")
+		peerOptions.SetTags([]string{"org1", "peer"})
+		_, _, err = source.ImportPeer(peerOptions)
+		Expect(err).To(BeNil())
+	})
+
+	It(`Snapshots the console`, func() {
+		snapshot, err := backup.Create(context.Background(), source)
+		Expect(err).To(BeNil())
+		Expect(snapshot.Version).To(Equal(backup.Version))
+		Expect(snapshot.Components).To(HaveLen(3))
+		Expect(snapshot.MSPs).To(HaveLen(1))
+		Expect(snapshot.MSP("org1msp").Admins).To(Equal([]string{"YWRtaW4="}))
+		Expect(*snapshot.Settings.MAXREQPERMIN).To(Equal(25.0))
+		Expect(snapshot.FabVersions).ToNot(BeNil())
+		Expect(snapshot.Tags).To(HaveKeyWithValue("peer", []string{"org1peer"}))
+		Expect(snapshot.Tags["org1"]).To(ContainElement("org1peer"))
+		Expect(snapshot.Tags["org1"]).To(HaveLen(2))
+
+		var peer *backup.Component
+		for i := range snapshot.Components {
+			if *snapshot.Components[i].ID == "org1peer" {
+				peer = &snapshot.Components[i]
+			}
+		}
+		Expect(peer).ToNot(BeNil())
+		Expect(peer.Certs).To(HaveLen(1))
+		Expect(peer.Certs[0].Field).To(Equal("msp.component.ecert"))
+		Expect(peer.Certs[0].Subject).To(Equal("CN=peer1"))
+		Expect(peer.Certs[0].SerialNumberHex).To(Equal("2a"))
+		Expect(peer.Certs[0].NotAfter).To(Equal(notAfter))
+	})
+	It(`Writes and reads an archive`, func() {
+		snapshot, err := backup.Create(context.Background(), source)
+		Expect(err).To(BeNil())
+		var buffer bytes.Buffer
+		Expect(snapshot.Write(&buffer)).To(Succeed())
+
+		read, err := backup.Read(bytes.NewReader(buffer.Bytes()), int64(buffer.Len()))
+		Expect(err).To(BeNil())
+		Expect(read.CreatedAt.Equal(snapshot.CreatedAt)).To(BeTrue())
+		read.CreatedAt = snapshot.CreatedAt
+		Expect(read).To(Equal(snapshot))
+	})
+	It(`Rejects corrupted archives and newer versions`, func() {
+		snapshot, err := backup.Create(context.Background(), source)
+		Expect(err).To(BeNil())
+		var buffer bytes.Buffer
+		Expect(snapshot.Write(&buffer)).To(Succeed())
+
+		corrupted := rewrite(buffer.Bytes(), "tags.json", []byte(`{}`))
+		_, err = backup.Read(bytes.NewReader(corrupted), int64(len(corrupted)))
+		Expect(err).To(MatchError(ContainSubstring("the checksum of tags.json does not match")))
+
+		newer := rewrite(buffer.Bytes(), "manifest.json", []byte(`{"format": "ibp-console-backup", "version": 99}`))
+		_, err = backup.Read(bytes.NewReader(newer), int64(len(newer)))
+		Expect(err).To(MatchError(ContainSubstring("unsupported backup version 99")))
+
+		_, err = backup.Read(bytes.NewReader([]byte("not a zip")), 9)
+		Expect(err).To(MatchError(ContainSubstring("not a backup archive")))
+	})
+	It(`Restores into an empty console`, func() {
+		snapshot, err := backup.Create(context.Background(), source)
+		Expect(err).To(BeNil())
+		target := blockchainv3fake.NewConsole()
+
+		results, err := backup.Restore(context.Background(), target, snapshot, nil)
+		Expect(err).To(BeNil())
+		Expect(results).To(HaveLen(3))
+		for _, result := range results {
+			Expect(result.Err).To(BeNil())
+			Expect(result.Status).To(Equal(importer.StatusImported))
+		}
+
+		peer, _, err := target.GetComponent(target.NewGetComponentOptions("org1peer"))
+		Expect(err).To(BeNil())
+		Expect(peer.Tags).To(Equal([]string{"org1", "peer"}))
+		Expect(*peer.Msp.Component.Ecert).To(Equal(ecert))
+		msp, _, err := target.GetMspCertificate(target.NewGetMspCertificateOptions("org1msp"))
+		Expect(err).To(BeNil())
+		Expect(msp.Msps[0].Admins).To(Equal([]string{"YWRtaW4="}))
+
+		_, err = backup.Restore(context.Background(), target, snapshot, nil)
+		Expect(err).To(MatchError(ContainSubstring("restore only into an empty console")))
+		results, err = backup.Restore(context.Background(), target, snapshot, &backup.RestoreOptions{AllowExisting: true})
+		Expect(err).To(BeNil())
+		for _, result := range results {
+			Expect(result.Status).To(Equal(importer.StatusSkipped))
+		}
+	})
+})
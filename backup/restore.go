@@ -0,0 +1,88 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package backup
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/IBM-Blockchain/ibp-go-sdk/blockchainv3"
+	"github.com/IBM-Blockchain/ibp-go-sdk/importer"
+	"github.com/IBM/go-sdk-core/v4/core"
+)
+
+// RestoreOptions : The Restore options.
+type RestoreOptions struct {
+	// Restore into a console that already has components, skipping the components it already has. By default Restore
+	// only restores into an empty console.
+	AllowExisting bool
+
+	// How many components are imported at once. Defaults to importer.DefaultConcurrency.
+	Concurrency int
+}
+
+// Restore imports the components of a backup into a console with the Import APIs, MSPs with the definitions the backup
+// holds for their MSP ID. Components the console deploys in the backed up console are imported, not deployed again.
+// The settings and Fabric versions of the backup are not restored.
+// The results are in the order of the backup components. An error is only returned if the console could not be
+// checked; a component that could not be imported has an importer.StatusFailed result.
+func Restore(ctx context.Context, service blockchainv3.BlockchainV3API, backup *Backup, options *RestoreOptions) ([]*importer.Result, error) {
+	if options == nil {
+		options = new(RestoreOptions)
+	}
+	if !options.AllowExisting {
+		existing, _, err := service.ListComponentsWithContext(ctx, service.NewListComponentsOptions())
+		if err != nil {
+			return nil, fmt.Errorf("could not list the components of the console: %w", err)
+		}
+		if len(existing.Components) > 0 {
+			return nil, fmt.Errorf("the console has %d components, restore only into an empty console", len(existing.Components))
+		}
+	}
+
+	files := make([]importer.File, 0, len(backup.Components))
+	for i := range backup.Components {
+		data, err := backup.definition(&backup.Components[i])
+		if err != nil {
+			return nil, err
+		}
+		name := fmt.Sprintf("components/%s.json", core.StringNilMapper(backup.Components[i].ID))
+		files = append(files, importer.File{Name: name, Data: data})
+	}
+	return importer.Import(ctx, service, files, &importer.Options{Concurrency: options.Concurrency})
+}
+
+// definition returns the import definition of a backup component. The fields of components have the names of the
+// Import API options; MSP components get the certificates of their MSP definition.
+func (backup *Backup) definition(component *Component) ([]byte, error) {
+	if core.StringNilMapper(component.Type) != importer.TypeMSP {
+		return json.Marshal(component.GenericComponentResponse)
+	}
+	definition := map[string]interface{}{
+		"type":         importer.TypeMSP,
+		"id":           component.ID,
+		"display_name": component.DisplayName,
+		"msp_id":       component.MspID,
+		"tags":         component.Tags,
+	}
+	if msp := backup.MSP(core.StringNilMapper(component.MspID)); msp != nil {
+		definition["root_certs"] = msp.RootCerts
+		definition["admins"] = msp.Admins
+		definition["tls_root_certs"] = msp.TlsRootCerts
+	}
+	return json.Marshal(definition)
+}
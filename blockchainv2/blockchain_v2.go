@@ -18,9 +18,12 @@
 package blockchainv2
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
 	"reflect"
+	"time"
 
 	common "github.com/IBM-Blockchain/ibp-go-sdk/common"
 	"github.com/IBM/go-sdk-core/v4/core"
@@ -99,14 +102,65 @@ func NewBlockchainV2(options *BlockchainV2Options) (service *BlockchainV2, err e
 	return
 }
 
+// GetServiceURLForRegion returns the service URL to be used for the specified region
+func GetServiceURLForRegion(region string) (string, error) {
+	return "", fmt.Errorf("service does not support regional URLs")
+}
+
+// Clone makes a copy of "blockchain" suitable for processing requests.
+func (blockchain *BlockchainV2) Clone() *BlockchainV2 {
+	if core.IsNil(blockchain) {
+		return nil
+	}
+	clone := *blockchain
+	clone.Service = blockchain.Service.Clone()
+	return &clone
+}
+
 // SetServiceURL sets the service URL
 func (blockchain *BlockchainV2) SetServiceURL(url string) error {
 	return blockchain.Service.SetServiceURL(url)
 }
 
+// GetServiceURL returns the service URL
+func (blockchain *BlockchainV2) GetServiceURL() string {
+	return blockchain.Service.GetServiceURL()
+}
+
+// SetDefaultHeaders sets HTTP headers to be sent in every request
+func (blockchain *BlockchainV2) SetDefaultHeaders(headers http.Header) {
+	blockchain.Service.SetDefaultHeaders(headers)
+}
+
+// SetEnableGzipCompression sets the service's EnableGzipCompression field
+func (blockchain *BlockchainV2) SetEnableGzipCompression(enableGzip bool) {
+	blockchain.Service.SetEnableGzipCompression(enableGzip)
+}
+
+// GetEnableGzipCompression returns the service's EnableGzipCompression field
+func (blockchain *BlockchainV2) GetEnableGzipCompression() bool {
+	return blockchain.Service.GetEnableGzipCompression()
+}
+
+// EnableRetries enables automatic retries for requests invoked for this service instance.
+// If either parameter is specified as 0, then a default value is used instead.
+func (blockchain *BlockchainV2) EnableRetries(maxRetries int, maxRetryInterval time.Duration) {
+	blockchain.Service.EnableRetries(maxRetries, maxRetryInterval)
+}
+
+// DisableRetries disables automatic retries for requests invoked for this service instance.
+func (blockchain *BlockchainV2) DisableRetries() {
+	blockchain.Service.DisableRetries()
+}
+
 // GetComponent : Get component data
 // Get the IBP console's data on a component (peer, CA, orderer, or MSP). The component might be imported or created.
 func (blockchain *BlockchainV2) GetComponent(getComponentOptions *GetComponentOptions) (result *GenericComponentResponse, response *core.DetailedResponse, err error) {
+	return blockchain.GetComponentWithContext(context.Background(), getComponentOptions)
+}
+
+// GetComponentWithContext is an alternate form of the GetComponent method which supports a Context parameter
+func (blockchain *BlockchainV2) GetComponentWithContext(ctx context.Context, getComponentOptions *GetComponentOptions) (result *GenericComponentResponse, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(getComponentOptions, "getComponentOptions cannot be nil")
 	if err != nil {
 		return
@@ -116,11 +170,14 @@ func (blockchain *BlockchainV2) GetComponent(getComponentOptions *GetComponentOp
 		return
 	}
 
-	pathSegments := []string{"ak/api/v2/components"}
-	pathParameters := []string{*getComponentOptions.ID}
+	pathParamsMap := map[string]string{
+		"id": *getComponentOptions.ID,
+	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	_, err = builder.ConstructHTTPURL(blockchain.Service.Options.URL, pathSegments, pathParameters)
+	builder = builder.WithContext(ctx)
+	builder.EnableGzipCompression = blockchain.GetEnableGzipCompression()
+	_, err = builder.ResolveRequestURL(blockchain.Service.Options.URL, `/ak/api/v2/components/{id}`, pathParamsMap)
 	if err != nil {
 		return
 	}
@@ -175,6 +232,11 @@ func (blockchain *BlockchainV2) GetComponent(getComponentOptions *GetComponentOp
 // Instead use the [Delete component](#delete-component) API to delete the Kubernetes deployment and the IBP console
 // data at once.
 func (blockchain *BlockchainV2) RemoveComponent(removeComponentOptions *RemoveComponentOptions) (result *DeleteComponentResponse, response *core.DetailedResponse, err error) {
+	return blockchain.RemoveComponentWithContext(context.Background(), removeComponentOptions)
+}
+
+// RemoveComponentWithContext is an alternate form of the RemoveComponent method which supports a Context parameter
+func (blockchain *BlockchainV2) RemoveComponentWithContext(ctx context.Context, removeComponentOptions *RemoveComponentOptions) (result *DeleteComponentResponse, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(removeComponentOptions, "removeComponentOptions cannot be nil")
 	if err != nil {
 		return
@@ -184,11 +246,14 @@ func (blockchain *BlockchainV2) RemoveComponent(removeComponentOptions *RemoveCo
 		return
 	}
 
-	pathSegments := []string{"ak/api/v2/components"}
-	pathParameters := []string{*removeComponentOptions.ID}
+	pathParamsMap := map[string]string{
+		"id": *removeComponentOptions.ID,
+	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	_, err = builder.ConstructHTTPURL(blockchain.Service.Options.URL, pathSegments, pathParameters)
+	builder = builder.WithContext(ctx)
+	builder.EnableGzipCompression = blockchain.GetEnableGzipCompression()
+	_, err = builder.ResolveRequestURL(blockchain.Service.Options.URL, `/ak/api/v2/components/{id}`, pathParamsMap)
 	if err != nil {
 		return
 	}
@@ -231,6 +296,11 @@ func (blockchain *BlockchainV2) RemoveComponent(removeComponentOptions *RemoveCo
 // the Kubernetes cluster where it resides. The Kubernetes delete must succeed before the component will be removed from
 // the IBP console.
 func (blockchain *BlockchainV2) DeleteComponent(deleteComponentOptions *DeleteComponentOptions) (result *DeleteComponentResponse, response *core.DetailedResponse, err error) {
+	return blockchain.DeleteComponentWithContext(context.Background(), deleteComponentOptions)
+}
+
+// DeleteComponentWithContext is an alternate form of the DeleteComponent method which supports a Context parameter
+func (blockchain *BlockchainV2) DeleteComponentWithContext(ctx context.Context, deleteComponentOptions *DeleteComponentOptions) (result *DeleteComponentResponse, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(deleteComponentOptions, "deleteComponentOptions cannot be nil")
 	if err != nil {
 		return
@@ -240,11 +310,14 @@ func (blockchain *BlockchainV2) DeleteComponent(deleteComponentOptions *DeleteCo
 		return
 	}
 
-	pathSegments := []string{"ak/api/v2/kubernetes/components"}
-	pathParameters := []string{*deleteComponentOptions.ID}
+	pathParamsMap := map[string]string{
+		"id": *deleteComponentOptions.ID,
+	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	_, err = builder.ConstructHTTPURL(blockchain.Service.Options.URL, pathSegments, pathParameters)
+	builder = builder.WithContext(ctx)
+	builder.EnableGzipCompression = blockchain.GetEnableGzipCompression()
+	_, err = builder.ResolveRequestURL(blockchain.Service.Options.URL, `/ak/api/v2/kubernetes/components/{id}`, pathParamsMap)
 	if err != nil {
 		return
 	}
@@ -281,6 +354,11 @@ func (blockchain *BlockchainV2) DeleteComponent(deleteComponentOptions *DeleteCo
 // CreateCa : Create a CA
 // Create a Hyperledger Fabric Certificate Authority (CA) in your Kubernetes cluster.
 func (blockchain *BlockchainV2) CreateCa(createCaOptions *CreateCaOptions) (result *CaResponse, response *core.DetailedResponse, err error) {
+	return blockchain.CreateCaWithContext(context.Background(), createCaOptions)
+}
+
+// CreateCaWithContext is an alternate form of the CreateCa method which supports a Context parameter
+func (blockchain *BlockchainV2) CreateCaWithContext(ctx context.Context, createCaOptions *CreateCaOptions) (result *CaResponse, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(createCaOptions, "createCaOptions cannot be nil")
 	if err != nil {
 		return
@@ -290,11 +368,10 @@ func (blockchain *BlockchainV2) CreateCa(createCaOptions *CreateCaOptions) (resu
 		return
 	}
 
-	pathSegments := []string{"ak/api/v2/kubernetes/components/fabric-ca"}
-	pathParameters := []string{}
-
 	builder := core.NewRequestBuilder(core.POST)
-	_, err = builder.ConstructHTTPURL(blockchain.Service.Options.URL, pathSegments, pathParameters)
+	builder = builder.WithContext(ctx)
+	builder.EnableGzipCompression = blockchain.GetEnableGzipCompression()
+	_, err = builder.ResolveRequestURL(blockchain.Service.Options.URL, `/ak/api/v2/kubernetes/components/fabric-ca`, nil)
 	if err != nil {
 		return
 	}
@@ -369,6 +446,11 @@ func (blockchain *BlockchainV2) CreateCa(createCaOptions *CreateCaOptions) (resu
 // Import an existing Certificate Authority (CA) to your IBP console. It is recommended to only import components that
 // were created by this or another IBP console.
 func (blockchain *BlockchainV2) ImportCa(importCaOptions *ImportCaOptions) (result *CaResponse, response *core.DetailedResponse, err error) {
+	return blockchain.ImportCaWithContext(context.Background(), importCaOptions)
+}
+
+// ImportCaWithContext is an alternate form of the ImportCa method which supports a Context parameter
+func (blockchain *BlockchainV2) ImportCaWithContext(ctx context.Context, importCaOptions *ImportCaOptions) (result *CaResponse, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(importCaOptions, "importCaOptions cannot be nil")
 	if err != nil {
 		return
@@ -378,11 +460,10 @@ func (blockchain *BlockchainV2) ImportCa(importCaOptions *ImportCaOptions) (resu
 		return
 	}
 
-	pathSegments := []string{"ak/api/v2/components/fabric-ca"}
-	pathParameters := []string{}
-
 	builder := core.NewRequestBuilder(core.POST)
-	_, err = builder.ConstructHTTPURL(blockchain.Service.Options.URL, pathSegments, pathParameters)
+	builder = builder.WithContext(ctx)
+	builder.EnableGzipCompression = blockchain.GetEnableGzipCompression()
+	_, err = builder.ResolveRequestURL(blockchain.Service.Options.URL, `/ak/api/v2/components/fabric-ca`, nil)
 	if err != nil {
 		return
 	}
@@ -450,6 +531,11 @@ func (blockchain *BlockchainV2) ImportCa(importCaOptions *ImportCaOptions) (resu
 // UpdateCa : Update a CA
 // Update Kubernetes deployment attributes of a Hyperledger Fabric Certificate Authority (CA) in your cluster.
 func (blockchain *BlockchainV2) UpdateCa(updateCaOptions *UpdateCaOptions) (result *CaResponse, response *core.DetailedResponse, err error) {
+	return blockchain.UpdateCaWithContext(context.Background(), updateCaOptions)
+}
+
+// UpdateCaWithContext is an alternate form of the UpdateCa method which supports a Context parameter
+func (blockchain *BlockchainV2) UpdateCaWithContext(ctx context.Context, updateCaOptions *UpdateCaOptions) (result *CaResponse, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(updateCaOptions, "updateCaOptions cannot be nil")
 	if err != nil {
 		return
@@ -459,11 +545,14 @@ func (blockchain *BlockchainV2) UpdateCa(updateCaOptions *UpdateCaOptions) (resu
 		return
 	}
 
-	pathSegments := []string{"ak/api/v2/kubernetes/components/fabric-ca"}
-	pathParameters := []string{*updateCaOptions.ID}
+	pathParamsMap := map[string]string{
+		"id": *updateCaOptions.ID,
+	}
 
 	builder := core.NewRequestBuilder(core.PUT)
-	_, err = builder.ConstructHTTPURL(blockchain.Service.Options.URL, pathSegments, pathParameters)
+	builder = builder.WithContext(ctx)
+	builder.EnableGzipCompression = blockchain.GetEnableGzipCompression()
+	_, err = builder.ResolveRequestURL(blockchain.Service.Options.URL, `/ak/api/v2/kubernetes/components/fabric-ca/{id}`, pathParamsMap)
 	if err != nil {
 		return
 	}
@@ -523,6 +612,11 @@ func (blockchain *BlockchainV2) UpdateCa(updateCaOptions *UpdateCaOptions) (resu
 // Modify local metadata fields of a Certificate Authority (CA). For example, the "display_name" field. This API will
 // **not** change any Kubernetes deployment attributes for the CA.
 func (blockchain *BlockchainV2) EditCa(editCaOptions *EditCaOptions) (result *CaResponse, response *core.DetailedResponse, err error) {
+	return blockchain.EditCaWithContext(context.Background(), editCaOptions)
+}
+
+// EditCaWithContext is an alternate form of the EditCa method which supports a Context parameter
+func (blockchain *BlockchainV2) EditCaWithContext(ctx context.Context, editCaOptions *EditCaOptions) (result *CaResponse, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(editCaOptions, "editCaOptions cannot be nil")
 	if err != nil {
 		return
@@ -532,11 +626,14 @@ func (blockchain *BlockchainV2) EditCa(editCaOptions *EditCaOptions) (result *Ca
 		return
 	}
 
-	pathSegments := []string{"ak/api/v2/components/fabric-ca"}
-	pathParameters := []string{*editCaOptions.ID}
+	pathParamsMap := map[string]string{
+		"id": *editCaOptions.ID,
+	}
 
 	builder := core.NewRequestBuilder(core.PUT)
-	_, err = builder.ConstructHTTPURL(blockchain.Service.Options.URL, pathSegments, pathParameters)
+	builder = builder.WithContext(ctx)
+	builder.EnableGzipCompression = blockchain.GetEnableGzipCompression()
+	_, err = builder.ResolveRequestURL(blockchain.Service.Options.URL, `/ak/api/v2/components/fabric-ca/{id}`, pathParamsMap)
 	if err != nil {
 		return
 	}
@@ -598,6 +695,11 @@ func (blockchain *BlockchainV2) EditCa(editCaOptions *EditCaOptions) (result *Ca
 // CreatePeer : Create a peer
 // Create a Hyperledger Fabric peer in your Kubernetes cluster.
 func (blockchain *BlockchainV2) CreatePeer(createPeerOptions *CreatePeerOptions) (result *PeerResponse, response *core.DetailedResponse, err error) {
+	return blockchain.CreatePeerWithContext(context.Background(), createPeerOptions)
+}
+
+// CreatePeerWithContext is an alternate form of the CreatePeer method which supports a Context parameter
+func (blockchain *BlockchainV2) CreatePeerWithContext(ctx context.Context, createPeerOptions *CreatePeerOptions) (result *PeerResponse, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(createPeerOptions, "createPeerOptions cannot be nil")
 	if err != nil {
 		return
@@ -607,11 +709,10 @@ func (blockchain *BlockchainV2) CreatePeer(createPeerOptions *CreatePeerOptions)
 		return
 	}
 
-	pathSegments := []string{"ak/api/v2/kubernetes/components/fabric-peer"}
-	pathParameters := []string{}
-
 	builder := core.NewRequestBuilder(core.POST)
-	_, err = builder.ConstructHTTPURL(blockchain.Service.Options.URL, pathSegments, pathParameters)
+	builder = builder.WithContext(ctx)
+	builder.EnableGzipCompression = blockchain.GetEnableGzipCompression()
+	_, err = builder.ResolveRequestURL(blockchain.Service.Options.URL, `/ak/api/v2/kubernetes/components/fabric-peer`, nil)
 	if err != nil {
 		return
 	}
@@ -692,6 +793,11 @@ func (blockchain *BlockchainV2) CreatePeer(createPeerOptions *CreatePeerOptions)
 // Import an existing peer into your IBP console. It is recommended to only import components that were created by this
 // or another IBP console.
 func (blockchain *BlockchainV2) ImportPeer(importPeerOptions *ImportPeerOptions) (result *PeerResponse, response *core.DetailedResponse, err error) {
+	return blockchain.ImportPeerWithContext(context.Background(), importPeerOptions)
+}
+
+// ImportPeerWithContext is an alternate form of the ImportPeer method which supports a Context parameter
+func (blockchain *BlockchainV2) ImportPeerWithContext(ctx context.Context, importPeerOptions *ImportPeerOptions) (result *PeerResponse, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(importPeerOptions, "importPeerOptions cannot be nil")
 	if err != nil {
 		return
@@ -701,11 +807,10 @@ func (blockchain *BlockchainV2) ImportPeer(importPeerOptions *ImportPeerOptions)
 		return
 	}
 
-	pathSegments := []string{"ak/api/v2/components/fabric-peer"}
-	pathParameters := []string{}
-
 	builder := core.NewRequestBuilder(core.POST)
-	_, err = builder.ConstructHTTPURL(blockchain.Service.Options.URL, pathSegments, pathParameters)
+	builder = builder.WithContext(ctx)
+	builder.EnableGzipCompression = blockchain.GetEnableGzipCompression()
+	_, err = builder.ResolveRequestURL(blockchain.Service.Options.URL, `/ak/api/v2/components/fabric-peer`, nil)
 	if err != nil {
 		return
 	}
@@ -777,6 +882,11 @@ func (blockchain *BlockchainV2) ImportPeer(importPeerOptions *ImportPeerOptions)
 // Modify local metadata fields of a peer. For example, the "display_name" field. This API will **not** change any
 // Kubernetes deployment attributes for the peer.
 func (blockchain *BlockchainV2) EditPeer(editPeerOptions *EditPeerOptions) (result *PeerResponse, response *core.DetailedResponse, err error) {
+	return blockchain.EditPeerWithContext(context.Background(), editPeerOptions)
+}
+
+// EditPeerWithContext is an alternate form of the EditPeer method which supports a Context parameter
+func (blockchain *BlockchainV2) EditPeerWithContext(ctx context.Context, editPeerOptions *EditPeerOptions) (result *PeerResponse, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(editPeerOptions, "editPeerOptions cannot be nil")
 	if err != nil {
 		return
@@ -786,11 +896,14 @@ func (blockchain *BlockchainV2) EditPeer(editPeerOptions *EditPeerOptions) (resu
 		return
 	}
 
-	pathSegments := []string{"ak/api/v2/components/fabric-peer"}
-	pathParameters := []string{*editPeerOptions.ID}
+	pathParamsMap := map[string]string{
+		"id": *editPeerOptions.ID,
+	}
 
 	builder := core.NewRequestBuilder(core.PUT)
-	_, err = builder.ConstructHTTPURL(blockchain.Service.Options.URL, pathSegments, pathParameters)
+	builder = builder.WithContext(ctx)
+	builder.EnableGzipCompression = blockchain.GetEnableGzipCompression()
+	_, err = builder.ResolveRequestURL(blockchain.Service.Options.URL, `/ak/api/v2/components/fabric-peer/{id}`, pathParamsMap)
 	if err != nil {
 		return
 	}
@@ -855,6 +968,11 @@ func (blockchain *BlockchainV2) EditPeer(editPeerOptions *EditPeerOptions) (resu
 // UpdatePeer : Update a peer
 // Update Kubernetes deployment attributes of a Hyperledger Fabric Peer node.
 func (blockchain *BlockchainV2) UpdatePeer(updatePeerOptions *UpdatePeerOptions) (result *PeerResponse, response *core.DetailedResponse, err error) {
+	return blockchain.UpdatePeerWithContext(context.Background(), updatePeerOptions)
+}
+
+// UpdatePeerWithContext is an alternate form of the UpdatePeer method which supports a Context parameter
+func (blockchain *BlockchainV2) UpdatePeerWithContext(ctx context.Context, updatePeerOptions *UpdatePeerOptions) (result *PeerResponse, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(updatePeerOptions, "updatePeerOptions cannot be nil")
 	if err != nil {
 		return
@@ -864,11 +982,14 @@ func (blockchain *BlockchainV2) UpdatePeer(updatePeerOptions *UpdatePeerOptions)
 		return
 	}
 
-	pathSegments := []string{"ak/api/v2/kubernetes/components/fabric-peer"}
-	pathParameters := []string{*updatePeerOptions.ID}
+	pathParamsMap := map[string]string{
+		"id": *updatePeerOptions.ID,
+	}
 
 	builder := core.NewRequestBuilder(core.PUT)
-	_, err = builder.ConstructHTTPURL(blockchain.Service.Options.URL, pathSegments, pathParameters)
+	builder = builder.WithContext(ctx)
+	builder.EnableGzipCompression = blockchain.GetEnableGzipCompression()
+	_, err = builder.ResolveRequestURL(blockchain.Service.Options.URL, `/ak/api/v2/kubernetes/components/fabric-peer/{id}`, pathParamsMap)
 	if err != nil {
 		return
 	}
@@ -925,6 +1046,11 @@ func (blockchain *BlockchainV2) UpdatePeer(updatePeerOptions *UpdatePeerOptions)
 // Create a Hyperledger Ordering Service (OS) in your Kubernetes cluster. Currently, only raft ordering nodes are
 // supported.
 func (blockchain *BlockchainV2) CreateOrderer(createOrdererOptions *CreateOrdererOptions) (result *OrdererResponse, response *core.DetailedResponse, err error) {
+	return blockchain.CreateOrdererWithContext(context.Background(), createOrdererOptions)
+}
+
+// CreateOrdererWithContext is an alternate form of the CreateOrderer method which supports a Context parameter
+func (blockchain *BlockchainV2) CreateOrdererWithContext(ctx context.Context, createOrdererOptions *CreateOrdererOptions) (result *OrdererResponse, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(createOrdererOptions, "createOrdererOptions cannot be nil")
 	if err != nil {
 		return
@@ -934,11 +1060,10 @@ func (blockchain *BlockchainV2) CreateOrderer(createOrdererOptions *CreateOrdere
 		return
 	}
 
-	pathSegments := []string{"ak/api/v2/kubernetes/components/fabric-orderer"}
-	pathParameters := []string{}
-
 	builder := core.NewRequestBuilder(core.POST)
-	_, err = builder.ConstructHTTPURL(blockchain.Service.Options.URL, pathSegments, pathParameters)
+	builder = builder.WithContext(ctx)
+	builder.EnableGzipCompression = blockchain.GetEnableGzipCompression()
+	_, err = builder.ResolveRequestURL(blockchain.Service.Options.URL, `/ak/api/v2/kubernetes/components/fabric-orderer`, nil)
 	if err != nil {
 		return
 	}
@@ -1031,6 +1156,11 @@ func (blockchain *BlockchainV2) CreateOrderer(createOrdererOptions *CreateOrdere
 // Import an existing Ordering Service (OS) to your IBP console. It is recommended to only import components that were
 // created by this or another IBP console.
 func (blockchain *BlockchainV2) ImportOrderer(importOrdererOptions *ImportOrdererOptions) (result *OrdererResponse, response *core.DetailedResponse, err error) {
+	return blockchain.ImportOrdererWithContext(context.Background(), importOrdererOptions)
+}
+
+// ImportOrdererWithContext is an alternate form of the ImportOrderer method which supports a Context parameter
+func (blockchain *BlockchainV2) ImportOrdererWithContext(ctx context.Context, importOrdererOptions *ImportOrdererOptions) (result *OrdererResponse, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(importOrdererOptions, "importOrdererOptions cannot be nil")
 	if err != nil {
 		return
@@ -1040,11 +1170,10 @@ func (blockchain *BlockchainV2) ImportOrderer(importOrdererOptions *ImportOrdere
 		return
 	}
 
-	pathSegments := []string{"ak/api/v2/components/fabric-orderer"}
-	pathParameters := []string{}
-
 	builder := core.NewRequestBuilder(core.POST)
-	_, err = builder.ConstructHTTPURL(blockchain.Service.Options.URL, pathSegments, pathParameters)
+	builder = builder.WithContext(ctx)
+	builder.EnableGzipCompression = blockchain.GetEnableGzipCompression()
+	_, err = builder.ResolveRequestURL(blockchain.Service.Options.URL, `/ak/api/v2/components/fabric-orderer`, nil)
 	if err != nil {
 		return
 	}
@@ -1131,6 +1260,11 @@ func (blockchain *BlockchainV2) ImportOrderer(importOrdererOptions *ImportOrdere
 // Modify local metadata fields of a single node in an Ordering Service (OS). For example, the "display_name" field.
 // This API will **not** change any Kubernetes deployment attributes for the node.
 func (blockchain *BlockchainV2) EditOrderer(editOrdererOptions *EditOrdererOptions) (result *OrdererResponse, response *core.DetailedResponse, err error) {
+	return blockchain.EditOrdererWithContext(context.Background(), editOrdererOptions)
+}
+
+// EditOrdererWithContext is an alternate form of the EditOrderer method which supports a Context parameter
+func (blockchain *BlockchainV2) EditOrdererWithContext(ctx context.Context, editOrdererOptions *EditOrdererOptions) (result *OrdererResponse, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(editOrdererOptions, "editOrdererOptions cannot be nil")
 	if err != nil {
 		return
@@ -1140,11 +1274,14 @@ func (blockchain *BlockchainV2) EditOrderer(editOrdererOptions *EditOrdererOptio
 		return
 	}
 
-	pathSegments := []string{"ak/api/v2/components/fabric-orderer"}
-	pathParameters := []string{*editOrdererOptions.ID}
+	pathParamsMap := map[string]string{
+		"id": *editOrdererOptions.ID,
+	}
 
 	builder := core.NewRequestBuilder(core.PUT)
-	_, err = builder.ConstructHTTPURL(blockchain.Service.Options.URL, pathSegments, pathParameters)
+	builder = builder.WithContext(ctx)
+	builder.EnableGzipCompression = blockchain.GetEnableGzipCompression()
+	_, err = builder.ResolveRequestURL(blockchain.Service.Options.URL, `/ak/api/v2/components/fabric-orderer/{id}`, pathParamsMap)
 	if err != nil {
 		return
 	}
@@ -1218,6 +1355,11 @@ func (blockchain *BlockchainV2) EditOrderer(editOrdererOptions *EditOrdererOptio
 // UpdateOrderer : Update an orderer node
 // Update Kubernetes deployment attributes of a Hyperledger Fabric Ordering node.
 func (blockchain *BlockchainV2) UpdateOrderer(updateOrdererOptions *UpdateOrdererOptions) (result *OrdererResponse, response *core.DetailedResponse, err error) {
+	return blockchain.UpdateOrdererWithContext(context.Background(), updateOrdererOptions)
+}
+
+// UpdateOrdererWithContext is an alternate form of the UpdateOrderer method which supports a Context parameter
+func (blockchain *BlockchainV2) UpdateOrdererWithContext(ctx context.Context, updateOrdererOptions *UpdateOrdererOptions) (result *OrdererResponse, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(updateOrdererOptions, "updateOrdererOptions cannot be nil")
 	if err != nil {
 		return
@@ -1227,11 +1369,14 @@ func (blockchain *BlockchainV2) UpdateOrderer(updateOrdererOptions *UpdateOrdere
 		return
 	}
 
-	pathSegments := []string{"ak/api/v2/kubernetes/components/fabric-orderer"}
-	pathParameters := []string{*updateOrdererOptions.ID}
+	pathParamsMap := map[string]string{
+		"id": *updateOrdererOptions.ID,
+	}
 
 	builder := core.NewRequestBuilder(core.PUT)
-	_, err = builder.ConstructHTTPURL(blockchain.Service.Options.URL, pathSegments, pathParameters)
+	builder = builder.WithContext(ctx)
+	builder.EnableGzipCompression = blockchain.GetEnableGzipCompression()
+	_, err = builder.ResolveRequestURL(blockchain.Service.Options.URL, `/ak/api/v2/kubernetes/components/fabric-orderer/{id}`, pathParamsMap)
 	if err != nil {
 		return
 	}
@@ -1315,6 +1460,11 @@ func (blockchain *BlockchainV2) UpdateOrderer(updateOrdererOptions *UpdateOrdere
 //   10. Use the [Edit data about an orderer](#edit-data-about-an-orderer) API to change the pre-created node's field
 // `consenter_proposal_fin` to `true`. This changes the status icon on the IBP console.
 func (blockchain *BlockchainV2) SubmitBlock(submitBlockOptions *SubmitBlockOptions) (result *GenericComponentResponse, response *core.DetailedResponse, err error) {
+	return blockchain.SubmitBlockWithContext(context.Background(), submitBlockOptions)
+}
+
+// SubmitBlockWithContext is an alternate form of the SubmitBlock method which supports a Context parameter
+func (blockchain *BlockchainV2) SubmitBlockWithContext(ctx context.Context, submitBlockOptions *SubmitBlockOptions) (result *GenericComponentResponse, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(submitBlockOptions, "submitBlockOptions cannot be nil")
 	if err != nil {
 		return
@@ -1324,11 +1474,14 @@ func (blockchain *BlockchainV2) SubmitBlock(submitBlockOptions *SubmitBlockOptio
 		return
 	}
 
-	pathSegments := []string{"ak/api/v2/kubernetes/components", "config"}
-	pathParameters := []string{*submitBlockOptions.ID}
+	pathParamsMap := map[string]string{
+		"id": *submitBlockOptions.ID,
+	}
 
 	builder := core.NewRequestBuilder(core.PUT)
-	_, err = builder.ConstructHTTPURL(blockchain.Service.Options.URL, pathSegments, pathParameters)
+	builder = builder.WithContext(ctx)
+	builder.EnableGzipCompression = blockchain.GetEnableGzipCompression()
+	_, err = builder.ResolveRequestURL(blockchain.Service.Options.URL, `/ak/api/v2/kubernetes/components/{id}/config`, pathParamsMap)
 	if err != nil {
 		return
 	}
@@ -1376,6 +1529,11 @@ func (blockchain *BlockchainV2) SubmitBlock(submitBlockOptions *SubmitBlockOptio
 // Create or import a Membership Service Provider (MSP) definition into your IBP console. This definition represents an
 // organization that controls a peer or OS (Ordering Service).
 func (blockchain *BlockchainV2) ImportMsp(importMspOptions *ImportMspOptions) (result *MspResponse, response *core.DetailedResponse, err error) {
+	return blockchain.ImportMspWithContext(context.Background(), importMspOptions)
+}
+
+// ImportMspWithContext is an alternate form of the ImportMsp method which supports a Context parameter
+func (blockchain *BlockchainV2) ImportMspWithContext(ctx context.Context, importMspOptions *ImportMspOptions) (result *MspResponse, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(importMspOptions, "importMspOptions cannot be nil")
 	if err != nil {
 		return
@@ -1385,11 +1543,10 @@ func (blockchain *BlockchainV2) ImportMsp(importMspOptions *ImportMspOptions) (r
 		return
 	}
 
-	pathSegments := []string{"ak/api/v2/components/msp"}
-	pathParameters := []string{}
-
 	builder := core.NewRequestBuilder(core.POST)
-	_, err = builder.ConstructHTTPURL(blockchain.Service.Options.URL, pathSegments, pathParameters)
+	builder = builder.WithContext(ctx)
+	builder.EnableGzipCompression = blockchain.GetEnableGzipCompression()
+	_, err = builder.ResolveRequestURL(blockchain.Service.Options.URL, `/ak/api/v2/components/msp`, nil)
 	if err != nil {
 		return
 	}
@@ -1452,6 +1609,11 @@ func (blockchain *BlockchainV2) ImportMsp(importMspOptions *ImportMspOptions) (r
 // Modify local metadata fields of a Membership Service Provider (MSP) definition. For example, the "display_name"
 // property.
 func (blockchain *BlockchainV2) EditMsp(editMspOptions *EditMspOptions) (result *MspResponse, response *core.DetailedResponse, err error) {
+	return blockchain.EditMspWithContext(context.Background(), editMspOptions)
+}
+
+// EditMspWithContext is an alternate form of the EditMsp method which supports a Context parameter
+func (blockchain *BlockchainV2) EditMspWithContext(ctx context.Context, editMspOptions *EditMspOptions) (result *MspResponse, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(editMspOptions, "editMspOptions cannot be nil")
 	if err != nil {
 		return
@@ -1461,11 +1623,14 @@ func (blockchain *BlockchainV2) EditMsp(editMspOptions *EditMspOptions) (result
 		return
 	}
 
-	pathSegments := []string{"ak/api/v2/components/msp"}
-	pathParameters := []string{*editMspOptions.ID}
+	pathParamsMap := map[string]string{
+		"id": *editMspOptions.ID,
+	}
 
 	builder := core.NewRequestBuilder(core.PUT)
-	_, err = builder.ConstructHTTPURL(blockchain.Service.Options.URL, pathSegments, pathParameters)
+	builder = builder.WithContext(ctx)
+	builder.EnableGzipCompression = blockchain.GetEnableGzipCompression()
+	_, err = builder.ResolveRequestURL(blockchain.Service.Options.URL, `/ak/api/v2/components/msp/{id}`, pathParamsMap)
 	if err != nil {
 		return
 	}
@@ -1527,6 +1692,11 @@ func (blockchain *BlockchainV2) EditMsp(editMspOptions *EditMspOptions) (result
 // GetMspCertificate : Get MSP's public certificates
 // External IBP consoles can use this API to get the public certificate for your given MSP id.
 func (blockchain *BlockchainV2) GetMspCertificate(getMspCertificateOptions *GetMspCertificateOptions) (result *GetMSPCertificateResponse, response *core.DetailedResponse, err error) {
+	return blockchain.GetMspCertificateWithContext(context.Background(), getMspCertificateOptions)
+}
+
+// GetMspCertificateWithContext is an alternate form of the GetMspCertificate method which supports a Context parameter
+func (blockchain *BlockchainV2) GetMspCertificateWithContext(ctx context.Context, getMspCertificateOptions *GetMspCertificateOptions) (result *GetMSPCertificateResponse, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(getMspCertificateOptions, "getMspCertificateOptions cannot be nil")
 	if err != nil {
 		return
@@ -1536,11 +1706,14 @@ func (blockchain *BlockchainV2) GetMspCertificate(getMspCertificateOptions *GetM
 		return
 	}
 
-	pathSegments := []string{"ak/api/v2/components/msps"}
-	pathParameters := []string{*getMspCertificateOptions.MspID}
+	pathParamsMap := map[string]string{
+		"msp_id": *getMspCertificateOptions.MspID,
+	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	_, err = builder.ConstructHTTPURL(blockchain.Service.Options.URL, pathSegments, pathParameters)
+	builder = builder.WithContext(ctx)
+	builder.EnableGzipCompression = blockchain.GetEnableGzipCompression()
+	_, err = builder.ResolveRequestURL(blockchain.Service.Options.URL, `/ak/api/v2/components/msps/{msp_id}`, pathParamsMap)
 	if err != nil {
 		return
 	}
@@ -1585,6 +1758,11 @@ func (blockchain *BlockchainV2) GetMspCertificate(getMspCertificateOptions *GetM
 //
 // **This API will not work on *imported* components.**.
 func (blockchain *BlockchainV2) EditAdminCerts(editAdminCertsOptions *EditAdminCertsOptions) (result *EditAdminCertsResponse, response *core.DetailedResponse, err error) {
+	return blockchain.EditAdminCertsWithContext(context.Background(), editAdminCertsOptions)
+}
+
+// EditAdminCertsWithContext is an alternate form of the EditAdminCerts method which supports a Context parameter
+func (blockchain *BlockchainV2) EditAdminCertsWithContext(ctx context.Context, editAdminCertsOptions *EditAdminCertsOptions) (result *EditAdminCertsResponse, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(editAdminCertsOptions, "editAdminCertsOptions cannot be nil")
 	if err != nil {
 		return
@@ -1594,11 +1772,14 @@ func (blockchain *BlockchainV2) EditAdminCerts(editAdminCertsOptions *EditAdminC
 		return
 	}
 
-	pathSegments := []string{"ak/api/v2/kubernetes/components", "certs"}
-	pathParameters := []string{*editAdminCertsOptions.ID}
+	pathParamsMap := map[string]string{
+		"id": *editAdminCertsOptions.ID,
+	}
 
 	builder := core.NewRequestBuilder(core.PUT)
-	_, err = builder.ConstructHTTPURL(blockchain.Service.Options.URL, pathSegments, pathParameters)
+	builder = builder.WithContext(ctx)
+	builder.EnableGzipCompression = blockchain.GetEnableGzipCompression()
+	_, err = builder.ResolveRequestURL(blockchain.Service.Options.URL, `/ak/api/v2/kubernetes/components/{id}/certs`, pathParamsMap)
 	if err != nil {
 		return
 	}
@@ -1649,16 +1830,20 @@ func (blockchain *BlockchainV2) EditAdminCerts(editAdminCertsOptions *EditAdminC
 // Get the IBP console's data on all components (peers, CAs, orderers, and MSPs). The component might be imported or
 // created.
 func (blockchain *BlockchainV2) ListComponents(listComponentsOptions *ListComponentsOptions) (result *GetMultiComponentsResponse, response *core.DetailedResponse, err error) {
+	return blockchain.ListComponentsWithContext(context.Background(), listComponentsOptions)
+}
+
+// ListComponentsWithContext is an alternate form of the ListComponents method which supports a Context parameter
+func (blockchain *BlockchainV2) ListComponentsWithContext(ctx context.Context, listComponentsOptions *ListComponentsOptions) (result *GetMultiComponentsResponse, response *core.DetailedResponse, err error) {
 	err = core.ValidateStruct(listComponentsOptions, "listComponentsOptions")
 	if err != nil {
 		return
 	}
 
-	pathSegments := []string{"ak/api/v2/components"}
-	pathParameters := []string{}
-
 	builder := core.NewRequestBuilder(core.GET)
-	_, err = builder.ConstructHTTPURL(blockchain.Service.Options.URL, pathSegments, pathParameters)
+	builder = builder.WithContext(ctx)
+	builder.EnableGzipCompression = blockchain.GetEnableGzipCompression()
+	_, err = builder.ResolveRequestURL(blockchain.Service.Options.URL, `/ak/api/v2/components`, nil)
 	if err != nil {
 		return
 	}
@@ -1708,6 +1893,11 @@ func (blockchain *BlockchainV2) ListComponents(listComponentsOptions *ListCompon
 // GetComponentsByType : Get components of a type
 // Get the IBP console's data on components that are a specific type. The component might be imported or created.
 func (blockchain *BlockchainV2) GetComponentsByType(getComponentsByTypeOptions *GetComponentsByTypeOptions) (result *GetMultiComponentsResponse, response *core.DetailedResponse, err error) {
+	return blockchain.GetComponentsByTypeWithContext(context.Background(), getComponentsByTypeOptions)
+}
+
+// GetComponentsByTypeWithContext is an alternate form of the GetComponentsByType method which supports a Context parameter
+func (blockchain *BlockchainV2) GetComponentsByTypeWithContext(ctx context.Context, getComponentsByTypeOptions *GetComponentsByTypeOptions) (result *GetMultiComponentsResponse, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(getComponentsByTypeOptions, "getComponentsByTypeOptions cannot be nil")
 	if err != nil {
 		return
@@ -1717,11 +1907,14 @@ func (blockchain *BlockchainV2) GetComponentsByType(getComponentsByTypeOptions *
 		return
 	}
 
-	pathSegments := []string{}
-	pathParameters := []string{*getComponentsByTypeOptions.ComponentType}
+	pathParamsMap := map[string]string{
+		"type": *getComponentsByTypeOptions.ComponentType,
+	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	_, err = builder.ConstructHTTPURL(blockchain.Service.Options.URL, pathSegments, pathParameters)
+	builder = builder.WithContext(ctx)
+	builder.EnableGzipCompression = blockchain.GetEnableGzipCompression()
+	_, err = builder.ResolveRequestURL(blockchain.Service.Options.URL, `/ak/api/v2/components/types/{type}`, pathParamsMap)
 	if err != nil {
 		return
 	}
@@ -1769,6 +1962,11 @@ func (blockchain *BlockchainV2) GetComponentsByType(getComponentsByTypeOptions *
 // Get the IBP console's data on components that have a specific tag. The component might be imported or created. Tags
 // are not case-sensitive.
 func (blockchain *BlockchainV2) GetComponentByTag(getComponentByTagOptions *GetComponentByTagOptions) (result *GetMultiComponentsResponse, response *core.DetailedResponse, err error) {
+	return blockchain.GetComponentByTagWithContext(context.Background(), getComponentByTagOptions)
+}
+
+// GetComponentByTagWithContext is an alternate form of the GetComponentByTag method which supports a Context parameter
+func (blockchain *BlockchainV2) GetComponentByTagWithContext(ctx context.Context, getComponentByTagOptions *GetComponentByTagOptions) (result *GetMultiComponentsResponse, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(getComponentByTagOptions, "getComponentByTagOptions cannot be nil")
 	if err != nil {
 		return
@@ -1778,11 +1976,14 @@ func (blockchain *BlockchainV2) GetComponentByTag(getComponentByTagOptions *GetC
 		return
 	}
 
-	pathSegments := []string{"ak/api/v2/components/tags"}
-	pathParameters := []string{*getComponentByTagOptions.Tag}
+	pathParamsMap := map[string]string{
+		"tag": *getComponentByTagOptions.Tag,
+	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	_, err = builder.ConstructHTTPURL(blockchain.Service.Options.URL, pathSegments, pathParameters)
+	builder = builder.WithContext(ctx)
+	builder.EnableGzipCompression = blockchain.GetEnableGzipCompression()
+	_, err = builder.ResolveRequestURL(blockchain.Service.Options.URL, `/ak/api/v2/components/tags/{tag}`, pathParamsMap)
 	if err != nil {
 		return
 	}
@@ -1834,6 +2035,11 @@ func (blockchain *BlockchainV2) GetComponentByTag(getComponentByTagOptions *GetC
 // Instead use the [Delete components with tag](#delete-component-s-with-tag) API to delete the Kubernetes deployment
 // and the IBP console data at once.
 func (blockchain *BlockchainV2) RemoveComponentsByTag(removeComponentsByTagOptions *RemoveComponentsByTagOptions) (result *RemoveMultiComponentsResponse, response *core.DetailedResponse, err error) {
+	return blockchain.RemoveComponentsByTagWithContext(context.Background(), removeComponentsByTagOptions)
+}
+
+// RemoveComponentsByTagWithContext is an alternate form of the RemoveComponentsByTag method which supports a Context parameter
+func (blockchain *BlockchainV2) RemoveComponentsByTagWithContext(ctx context.Context, removeComponentsByTagOptions *RemoveComponentsByTagOptions) (result *RemoveMultiComponentsResponse, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(removeComponentsByTagOptions, "removeComponentsByTagOptions cannot be nil")
 	if err != nil {
 		return
@@ -1843,11 +2049,14 @@ func (blockchain *BlockchainV2) RemoveComponentsByTag(removeComponentsByTagOptio
 		return
 	}
 
-	pathSegments := []string{"ak/api/v2/components/tags"}
-	pathParameters := []string{*removeComponentsByTagOptions.Tag}
+	pathParamsMap := map[string]string{
+		"tag": *removeComponentsByTagOptions.Tag,
+	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	_, err = builder.ConstructHTTPURL(blockchain.Service.Options.URL, pathSegments, pathParameters)
+	builder = builder.WithContext(ctx)
+	builder.EnableGzipCompression = blockchain.GetEnableGzipCompression()
+	_, err = builder.ResolveRequestURL(blockchain.Service.Options.URL, `/ak/api/v2/components/tags/{tag}`, pathParamsMap)
 	if err != nil {
 		return
 	}
@@ -1891,6 +2100,11 @@ func (blockchain *BlockchainV2) RemoveComponentsByTag(removeComponentsByTagOptio
 // from the Kubernetes cluster where they reside. The Kubernetes delete must succeed before the component will be
 // removed from the IBP console.
 func (blockchain *BlockchainV2) DeleteComponentsByTag(deleteComponentsByTagOptions *DeleteComponentsByTagOptions) (result *DeleteMultiComponentsResponse, response *core.DetailedResponse, err error) {
+	return blockchain.DeleteComponentsByTagWithContext(context.Background(), deleteComponentsByTagOptions)
+}
+
+// DeleteComponentsByTagWithContext is an alternate form of the DeleteComponentsByTag method which supports a Context parameter
+func (blockchain *BlockchainV2) DeleteComponentsByTagWithContext(ctx context.Context, deleteComponentsByTagOptions *DeleteComponentsByTagOptions) (result *DeleteMultiComponentsResponse, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(deleteComponentsByTagOptions, "deleteComponentsByTagOptions cannot be nil")
 	if err != nil {
 		return
@@ -1900,11 +2114,14 @@ func (blockchain *BlockchainV2) DeleteComponentsByTag(deleteComponentsByTagOptio
 		return
 	}
 
-	pathSegments := []string{"ak/api/v2/kubernetes/components/tags"}
-	pathParameters := []string{*deleteComponentsByTagOptions.Tag}
+	pathParamsMap := map[string]string{
+		"tag": *deleteComponentsByTagOptions.Tag,
+	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	_, err = builder.ConstructHTTPURL(blockchain.Service.Options.URL, pathSegments, pathParameters)
+	builder = builder.WithContext(ctx)
+	builder.EnableGzipCompression = blockchain.GetEnableGzipCompression()
+	_, err = builder.ResolveRequestURL(blockchain.Service.Options.URL, `/ak/api/v2/kubernetes/components/tags/{tag}`, pathParamsMap)
 	if err != nil {
 		return
 	}
@@ -1943,16 +2160,20 @@ func (blockchain *BlockchainV2) DeleteComponentsByTag(deleteComponentsByTagOptio
 // and created components (peers, CAs, orderers, MSPs, and signature collection transactions). This api attempts to
 // effectively reset the IBP console to its initial (empty) state (except for logs & notifications, those will remain).
 func (blockchain *BlockchainV2) DeleteAllComponents(deleteAllComponentsOptions *DeleteAllComponentsOptions) (result *DeleteMultiComponentsResponse, response *core.DetailedResponse, err error) {
+	return blockchain.DeleteAllComponentsWithContext(context.Background(), deleteAllComponentsOptions)
+}
+
+// DeleteAllComponentsWithContext is an alternate form of the DeleteAllComponents method which supports a Context parameter
+func (blockchain *BlockchainV2) DeleteAllComponentsWithContext(ctx context.Context, deleteAllComponentsOptions *DeleteAllComponentsOptions) (result *DeleteMultiComponentsResponse, response *core.DetailedResponse, err error) {
 	err = core.ValidateStruct(deleteAllComponentsOptions, "deleteAllComponentsOptions")
 	if err != nil {
 		return
 	}
 
-	pathSegments := []string{"ak/api/v2/kubernetes/components/purge"}
-	pathParameters := []string{}
-
 	builder := core.NewRequestBuilder(core.DELETE)
-	_, err = builder.ConstructHTTPURL(blockchain.Service.Options.URL, pathSegments, pathParameters)
+	builder = builder.WithContext(ctx)
+	builder.EnableGzipCompression = blockchain.GetEnableGzipCompression()
+	_, err = builder.ResolveRequestURL(blockchain.Service.Options.URL, `/ak/api/v2/kubernetes/components/purge`, nil)
 	if err != nil {
 		return
 	}
@@ -1990,16 +2211,20 @@ func (blockchain *BlockchainV2) DeleteAllComponents(deleteAllComponentsOptions *
 // Retrieve all public (non-sensitive) settings for the IBP console. Use this API for debugging purposes. It shows what
 // behavior to expect and confirms whether the desired settings are active.
 func (blockchain *BlockchainV2) GetSettings(getSettingsOptions *GetSettingsOptions) (result *GetPublicSettingsResponse, response *core.DetailedResponse, err error) {
+	return blockchain.GetSettingsWithContext(context.Background(), getSettingsOptions)
+}
+
+// GetSettingsWithContext is an alternate form of the GetSettings method which supports a Context parameter
+func (blockchain *BlockchainV2) GetSettingsWithContext(ctx context.Context, getSettingsOptions *GetSettingsOptions) (result *GetPublicSettingsResponse, response *core.DetailedResponse, err error) {
 	err = core.ValidateStruct(getSettingsOptions, "getSettingsOptions")
 	if err != nil {
 		return
 	}
 
-	pathSegments := []string{"ak/api/v2/settings"}
-	pathParameters := []string{}
-
 	builder := core.NewRequestBuilder(core.GET)
-	_, err = builder.ConstructHTTPURL(blockchain.Service.Options.URL, pathSegments, pathParameters)
+	builder = builder.WithContext(ctx)
+	builder.EnableGzipCompression = blockchain.GetEnableGzipCompression()
+	_, err = builder.ResolveRequestURL(blockchain.Service.Options.URL, `/ak/api/v2/settings`, nil)
 	if err != nil {
 		return
 	}
@@ -2037,6 +2262,11 @@ func (blockchain *BlockchainV2) GetSettings(getSettingsOptions *GetSettingsOptio
 // Edit a few IBP console settings (such as the rate limit and timeout settings). **Some edits will trigger an automatic
 // server restart.**.
 func (blockchain *BlockchainV2) EditSettings(editSettingsOptions *EditSettingsOptions) (result *GetPublicSettingsResponse, response *core.DetailedResponse, err error) {
+	return blockchain.EditSettingsWithContext(context.Background(), editSettingsOptions)
+}
+
+// EditSettingsWithContext is an alternate form of the EditSettings method which supports a Context parameter
+func (blockchain *BlockchainV2) EditSettingsWithContext(ctx context.Context, editSettingsOptions *EditSettingsOptions) (result *GetPublicSettingsResponse, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(editSettingsOptions, "editSettingsOptions cannot be nil")
 	if err != nil {
 		return
@@ -2046,11 +2276,10 @@ func (blockchain *BlockchainV2) EditSettings(editSettingsOptions *EditSettingsOp
 		return
 	}
 
-	pathSegments := []string{"ak/api/v2/settings"}
-	pathParameters := []string{}
-
 	builder := core.NewRequestBuilder(core.PUT)
-	_, err = builder.ConstructHTTPURL(blockchain.Service.Options.URL, pathSegments, pathParameters)
+	builder = builder.WithContext(ctx)
+	builder.EnableGzipCompression = blockchain.GetEnableGzipCompression()
+	_, err = builder.ResolveRequestURL(blockchain.Service.Options.URL, `/ak/api/v2/settings`, nil)
 	if err != nil {
 		return
 	}
@@ -2128,16 +2357,20 @@ func (blockchain *BlockchainV2) EditSettings(editSettingsOptions *EditSettingsOp
 // Get list of supported Fabric versions by each component type. These are the Fabric versions your IBP console can use
 // when creating or upgrading components.
 func (blockchain *BlockchainV2) GetFabVersions(getFabVersionsOptions *GetFabVersionsOptions) (result *GetFabricVersionsResponse, response *core.DetailedResponse, err error) {
+	return blockchain.GetFabVersionsWithContext(context.Background(), getFabVersionsOptions)
+}
+
+// GetFabVersionsWithContext is an alternate form of the GetFabVersions method which supports a Context parameter
+func (blockchain *BlockchainV2) GetFabVersionsWithContext(ctx context.Context, getFabVersionsOptions *GetFabVersionsOptions) (result *GetFabricVersionsResponse, response *core.DetailedResponse, err error) {
 	err = core.ValidateStruct(getFabVersionsOptions, "getFabVersionsOptions")
 	if err != nil {
 		return
 	}
 
-	pathSegments := []string{"ak/api/v2/kubernetes/fabric/versions"}
-	pathParameters := []string{}
-
 	builder := core.NewRequestBuilder(core.GET)
-	_, err = builder.ConstructHTTPURL(blockchain.Service.Options.URL, pathSegments, pathParameters)
+	builder = builder.WithContext(ctx)
+	builder.EnableGzipCompression = blockchain.GetEnableGzipCompression()
+	_, err = builder.ResolveRequestURL(blockchain.Service.Options.URL, `/ak/api/v2/kubernetes/fabric/versions`, nil)
 	if err != nil {
 		return
 	}
@@ -2179,16 +2412,20 @@ func (blockchain *BlockchainV2) GetFabVersions(getFabVersionsOptions *GetFabVers
 // See statistics of the IBP console process such as memory usage, CPU usage, up time, cache, and operating system
 // stats.
 func (blockchain *BlockchainV2) GetHealth(getHealthOptions *GetHealthOptions) (result *GetAthenaHealthStatsResponse, response *core.DetailedResponse, err error) {
+	return blockchain.GetHealthWithContext(context.Background(), getHealthOptions)
+}
+
+// GetHealthWithContext is an alternate form of the GetHealth method which supports a Context parameter
+func (blockchain *BlockchainV2) GetHealthWithContext(ctx context.Context, getHealthOptions *GetHealthOptions) (result *GetAthenaHealthStatsResponse, response *core.DetailedResponse, err error) {
 	err = core.ValidateStruct(getHealthOptions, "getHealthOptions")
 	if err != nil {
 		return
 	}
 
-	pathSegments := []string{"ak/api/v2/health"}
-	pathParameters := []string{}
-
 	builder := core.NewRequestBuilder(core.GET)
-	_, err = builder.ConstructHTTPURL(blockchain.Service.Options.URL, pathSegments, pathParameters)
+	builder = builder.WithContext(ctx)
+	builder.EnableGzipCompression = blockchain.GetEnableGzipCompression()
+	_, err = builder.ResolveRequestURL(blockchain.Service.Options.URL, `/ak/api/v2/health`, nil)
 	if err != nil {
 		return
 	}
@@ -2226,16 +2463,20 @@ func (blockchain *BlockchainV2) GetHealth(getHealthOptions *GetHealthOptions) (r
 // Retrieve all notifications. This API supports pagination through the query parameters. Notifications are generated
 // from actions such as creating a component, deleting a component, server restart, and so on.
 func (blockchain *BlockchainV2) ListNotifications(listNotificationsOptions *ListNotificationsOptions) (result *GetNotificationsResponse, response *core.DetailedResponse, err error) {
+	return blockchain.ListNotificationsWithContext(context.Background(), listNotificationsOptions)
+}
+
+// ListNotificationsWithContext is an alternate form of the ListNotifications method which supports a Context parameter
+func (blockchain *BlockchainV2) ListNotificationsWithContext(ctx context.Context, listNotificationsOptions *ListNotificationsOptions) (result *GetNotificationsResponse, response *core.DetailedResponse, err error) {
 	err = core.ValidateStruct(listNotificationsOptions, "listNotificationsOptions")
 	if err != nil {
 		return
 	}
 
-	pathSegments := []string{"ak/api/v2/notifications"}
-	pathParameters := []string{}
-
 	builder := core.NewRequestBuilder(core.GET)
-	_, err = builder.ConstructHTTPURL(blockchain.Service.Options.URL, pathSegments, pathParameters)
+	builder = builder.WithContext(ctx)
+	builder.EnableGzipCompression = blockchain.GetEnableGzipCompression()
+	_, err = builder.ResolveRequestURL(blockchain.Service.Options.URL, `/ak/api/v2/notifications`, nil)
 	if err != nil {
 		return
 	}
@@ -2284,6 +2525,11 @@ func (blockchain *BlockchainV2) ListNotifications(listNotificationsOptions *List
 // approvals. This request is not distributed to external IBP consoles, thus the signature collection transaction is
 // only deleted locally.
 func (blockchain *BlockchainV2) DeleteSigTx(deleteSigTxOptions *DeleteSigTxOptions) (result *DeleteSignatureCollectionResponse, response *core.DetailedResponse, err error) {
+	return blockchain.DeleteSigTxWithContext(context.Background(), deleteSigTxOptions)
+}
+
+// DeleteSigTxWithContext is an alternate form of the DeleteSigTx method which supports a Context parameter
+func (blockchain *BlockchainV2) DeleteSigTxWithContext(ctx context.Context, deleteSigTxOptions *DeleteSigTxOptions) (result *DeleteSignatureCollectionResponse, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(deleteSigTxOptions, "deleteSigTxOptions cannot be nil")
 	if err != nil {
 		return
@@ -2293,11 +2539,14 @@ func (blockchain *BlockchainV2) DeleteSigTx(deleteSigTxOptions *DeleteSigTxOptio
 		return
 	}
 
-	pathSegments := []string{"ak/api/v2/signature_collections"}
-	pathParameters := []string{*deleteSigTxOptions.ID}
+	pathParamsMap := map[string]string{
+		"id": *deleteSigTxOptions.ID,
+	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	_, err = builder.ConstructHTTPURL(blockchain.Service.Options.URL, pathSegments, pathParameters)
+	builder = builder.WithContext(ctx)
+	builder.EnableGzipCompression = blockchain.GetEnableGzipCompression()
+	_, err = builder.ResolveRequestURL(blockchain.Service.Options.URL, `/ak/api/v2/signature_collections/{id}`, pathParamsMap)
 	if err != nil {
 		return
 	}
@@ -2335,6 +2584,11 @@ func (blockchain *BlockchainV2) DeleteSigTx(deleteSigTxOptions *DeleteSigTxOptio
 // Archive 1 or more notifications. Archived notifications will no longer appear in the default [Get all
 // notifications](#get-all-notifications) API.
 func (blockchain *BlockchainV2) ArchiveNotifications(archiveNotificationsOptions *ArchiveNotificationsOptions) (result *ArchiveResponse, response *core.DetailedResponse, err error) {
+	return blockchain.ArchiveNotificationsWithContext(context.Background(), archiveNotificationsOptions)
+}
+
+// ArchiveNotificationsWithContext is an alternate form of the ArchiveNotifications method which supports a Context parameter
+func (blockchain *BlockchainV2) ArchiveNotificationsWithContext(ctx context.Context, archiveNotificationsOptions *ArchiveNotificationsOptions) (result *ArchiveResponse, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(archiveNotificationsOptions, "archiveNotificationsOptions cannot be nil")
 	if err != nil {
 		return
@@ -2344,11 +2598,10 @@ func (blockchain *BlockchainV2) ArchiveNotifications(archiveNotificationsOptions
 		return
 	}
 
-	pathSegments := []string{"ak/api/v2/notifications/bulk"}
-	pathParameters := []string{}
-
 	builder := core.NewRequestBuilder(core.POST)
-	_, err = builder.ConstructHTTPURL(blockchain.Service.Options.URL, pathSegments, pathParameters)
+	builder = builder.WithContext(ctx)
+	builder.EnableGzipCompression = blockchain.GetEnableGzipCompression()
+	_, err = builder.ResolveRequestURL(blockchain.Service.Options.URL, `/ak/api/v2/notifications/bulk`, nil)
 	if err != nil {
 		return
 	}
@@ -2396,16 +2649,20 @@ func (blockchain *BlockchainV2) ArchiveNotifications(archiveNotificationsOptions
 // Restart IBP console processes. This causes a small outage (10 - 30 seconds) which is possibly disruptive to active
 // user sessions.
 func (blockchain *BlockchainV2) Restart(restartOptions *RestartOptions) (result *RestartAthenaResponse, response *core.DetailedResponse, err error) {
+	return blockchain.RestartWithContext(context.Background(), restartOptions)
+}
+
+// RestartWithContext is an alternate form of the Restart method which supports a Context parameter
+func (blockchain *BlockchainV2) RestartWithContext(ctx context.Context, restartOptions *RestartOptions) (result *RestartAthenaResponse, response *core.DetailedResponse, err error) {
 	err = core.ValidateStruct(restartOptions, "restartOptions")
 	if err != nil {
 		return
 	}
 
-	pathSegments := []string{"ak/api/v2/restart"}
-	pathParameters := []string{}
-
 	builder := core.NewRequestBuilder(core.POST)
-	_, err = builder.ConstructHTTPURL(blockchain.Service.Options.URL, pathSegments, pathParameters)
+	builder = builder.WithContext(ctx)
+	builder.EnableGzipCompression = blockchain.GetEnableGzipCompression()
+	_, err = builder.ResolveRequestURL(blockchain.Service.Options.URL, `/ak/api/v2/restart`, nil)
 	if err != nil {
 		return
 	}
@@ -2445,16 +2702,20 @@ func (blockchain *BlockchainV2) Restart(restartOptions *RestartOptions) (result
 // take effect immediately. Otherwise, permission or role changes will take effect during the user's next login or
 // session expiration.
 func (blockchain *BlockchainV2) DeleteAllSessions(deleteAllSessionsOptions *DeleteAllSessionsOptions) (result *DeleteAllSessionsResponse, response *core.DetailedResponse, err error) {
+	return blockchain.DeleteAllSessionsWithContext(context.Background(), deleteAllSessionsOptions)
+}
+
+// DeleteAllSessionsWithContext is an alternate form of the DeleteAllSessions method which supports a Context parameter
+func (blockchain *BlockchainV2) DeleteAllSessionsWithContext(ctx context.Context, deleteAllSessionsOptions *DeleteAllSessionsOptions) (result *DeleteAllSessionsResponse, response *core.DetailedResponse, err error) {
 	err = core.ValidateStruct(deleteAllSessionsOptions, "deleteAllSessionsOptions")
 	if err != nil {
 		return
 	}
 
-	pathSegments := []string{"ak/api/v2/sessions"}
-	pathParameters := []string{}
-
 	builder := core.NewRequestBuilder(core.DELETE)
-	_, err = builder.ConstructHTTPURL(blockchain.Service.Options.URL, pathSegments, pathParameters)
+	builder = builder.WithContext(ctx)
+	builder.EnableGzipCompression = blockchain.GetEnableGzipCompression()
+	_, err = builder.ResolveRequestURL(blockchain.Service.Options.URL, `/ak/api/v2/sessions`, nil)
 	if err != nil {
 		return
 	}
@@ -2491,16 +2752,20 @@ func (blockchain *BlockchainV2) DeleteAllSessions(deleteAllSessionsOptions *Dele
 // DeleteAllNotifications : Delete all notifications
 // Delete all notifications. This API is intended for administration.
 func (blockchain *BlockchainV2) DeleteAllNotifications(deleteAllNotificationsOptions *DeleteAllNotificationsOptions) (result *DeleteAllNotificationsResponse, response *core.DetailedResponse, err error) {
+	return blockchain.DeleteAllNotificationsWithContext(context.Background(), deleteAllNotificationsOptions)
+}
+
+// DeleteAllNotificationsWithContext is an alternate form of the DeleteAllNotifications method which supports a Context parameter
+func (blockchain *BlockchainV2) DeleteAllNotificationsWithContext(ctx context.Context, deleteAllNotificationsOptions *DeleteAllNotificationsOptions) (result *DeleteAllNotificationsResponse, response *core.DetailedResponse, err error) {
 	err = core.ValidateStruct(deleteAllNotificationsOptions, "deleteAllNotificationsOptions")
 	if err != nil {
 		return
 	}
 
-	pathSegments := []string{"ak/api/v2/notifications/purge"}
-	pathParameters := []string{}
-
 	builder := core.NewRequestBuilder(core.DELETE)
-	_, err = builder.ConstructHTTPURL(blockchain.Service.Options.URL, pathSegments, pathParameters)
+	builder = builder.WithContext(ctx)
+	builder.EnableGzipCompression = blockchain.GetEnableGzipCompression()
+	_, err = builder.ResolveRequestURL(blockchain.Service.Options.URL, `/ak/api/v2/notifications/purge`, nil)
 	if err != nil {
 		return
 	}
@@ -2537,16 +2802,20 @@ func (blockchain *BlockchainV2) DeleteAllNotifications(deleteAllNotificationsOpt
 // ClearCaches : Clear IBP console caches
 // Clear the in-memory caches across all IBP console server processes. No effect on caches that are currently disabled.
 func (blockchain *BlockchainV2) ClearCaches(clearCachesOptions *ClearCachesOptions) (result *CacheFlushResponse, response *core.DetailedResponse, err error) {
+	return blockchain.ClearCachesWithContext(context.Background(), clearCachesOptions)
+}
+
+// ClearCachesWithContext is an alternate form of the ClearCaches method which supports a Context parameter
+func (blockchain *BlockchainV2) ClearCachesWithContext(ctx context.Context, clearCachesOptions *ClearCachesOptions) (result *CacheFlushResponse, response *core.DetailedResponse, err error) {
 	err = core.ValidateStruct(clearCachesOptions, "clearCachesOptions")
 	if err != nil {
 		return
 	}
 
-	pathSegments := []string{"ak/api/v2/cache"}
-	pathParameters := []string{}
-
 	builder := core.NewRequestBuilder(core.DELETE)
-	_, err = builder.ConstructHTTPURL(blockchain.Service.Options.URL, pathSegments, pathParameters)
+	builder = builder.WithContext(ctx)
+	builder.EnableGzipCompression = blockchain.GetEnableGzipCompression()
+	_, err = builder.ResolveRequestURL(blockchain.Service.Options.URL, `/ak/api/v2/cache`, nil)
 	if err != nil {
 		return
 	}
@@ -2599,6 +2868,11 @@ func (blockchain *BlockchainV2) ClearCaches(clearCachesOptions *ClearCachesOptio
 // into the Postman collection examples. This is **not** available for an IBP SaaS instance on IBM Cloud. To use this
 // strategy set `auth_type` to `basic`.
 func (blockchain *BlockchainV2) GetPostman(getPostmanOptions *GetPostmanOptions) (response *core.DetailedResponse, err error) {
+	return blockchain.GetPostmanWithContext(context.Background(), getPostmanOptions)
+}
+
+// GetPostmanWithContext is an alternate form of the GetPostman method which supports a Context parameter
+func (blockchain *BlockchainV2) GetPostmanWithContext(ctx context.Context, getPostmanOptions *GetPostmanOptions) (response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(getPostmanOptions, "getPostmanOptions cannot be nil")
 	if err != nil {
 		return
@@ -2608,11 +2882,10 @@ func (blockchain *BlockchainV2) GetPostman(getPostmanOptions *GetPostmanOptions)
 		return
 	}
 
-	pathSegments := []string{"ak/api/v2/postman"}
-	pathParameters := []string{}
-
 	builder := core.NewRequestBuilder(core.GET)
-	_, err = builder.ConstructHTTPURL(blockchain.Service.Options.URL, pathSegments, pathParameters)
+	builder = builder.WithContext(ctx)
+	builder.EnableGzipCompression = blockchain.GetEnableGzipCompression()
+	_, err = builder.ResolveRequestURL(blockchain.Service.Options.URL, `/ak/api/v2/postman`, nil)
 	if err != nil {
 		return
 	}
@@ -2656,16 +2929,20 @@ func (blockchain *BlockchainV2) GetPostman(getPostmanOptions *GetPostmanOptions)
 // console. This is the same file that was used to generate the APIs on this page. This file documents APIs offered by
 // the IBP console.
 func (blockchain *BlockchainV2) GetSwagger(getSwaggerOptions *GetSwaggerOptions) (result *string, response *core.DetailedResponse, err error) {
+	return blockchain.GetSwaggerWithContext(context.Background(), getSwaggerOptions)
+}
+
+// GetSwaggerWithContext is an alternate form of the GetSwagger method which supports a Context parameter
+func (blockchain *BlockchainV2) GetSwaggerWithContext(ctx context.Context, getSwaggerOptions *GetSwaggerOptions) (result *string, response *core.DetailedResponse, err error) {
 	err = core.ValidateStruct(getSwaggerOptions, "getSwaggerOptions")
 	if err != nil {
 		return
 	}
 
-	pathSegments := []string{"ak/api/v2/openapi"}
-	pathParameters := []string{}
-
 	builder := core.NewRequestBuilder(core.GET)
-	_, err = builder.ConstructHTTPURL(blockchain.Service.Options.URL, pathSegments, pathParameters)
+	builder = builder.WithContext(ctx)
+	builder.EnableGzipCompression = blockchain.GetEnableGzipCompression()
+	_, err = builder.ResolveRequestURL(blockchain.Service.Options.URL, `/ak/api/v2/openapi`, nil)
 	if err != nil {
 		return
 	}
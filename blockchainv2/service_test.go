@@ -0,0 +1,92 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package blockchainv2_test
+
+import (
+	"compress/gzip"
+	"context"
+	"fmt"
+	"github.com/IBM-Blockchain/ibp-go-sdk/blockchainv2"
+	"github.com/IBM/go-sdk-core/v4/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+)
+
+var _ = Describe(`Service options`, func() {
+	var testServer *httptest.Server
+	var lastRequest *http.Request
+	var lastBody []byte
+
+	newService := func() *blockchainv2.BlockchainV2 {
+		testService, testServiceErr := blockchainv2.NewBlockchainV2(&blockchainv2.BlockchainV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(testServiceErr).To(BeNil())
+		return testService
+	}
+
+	BeforeEach(func() {
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			lastRequest = req
+			body := req.Body
+			if req.Header.Get("Content-Encoding") == "gzip" {
+				reader, err := gzip.NewReader(req.Body)
+				Expect(err).To(BeNil())
+				body = reader
+			}
+			lastBody, _ = ioutil.ReadAll(body)
+			res.Header().Set("Content-type", "application/json")
+			res.WriteHeader(200)
+			fmt.Fprintf(res, `{"message": "ok"}`)
+		}))
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Sends the default headers with every request`, func() {
+		testService := newService()
+		testService.SetDefaultHeaders(http.Header{"X-Default": []string{"default-value"}})
+		_, _, err := testService.GetHealthWithContext(context.Background(), testService.NewGetHealthOptions())
+		Expect(err).To(BeNil())
+		Expect(lastRequest.Header.Get("X-Default")).To(Equal("default-value"))
+	})
+	It(`Compresses request bodies when gzip is enabled`, func() {
+		testService := newService()
+		Expect(testService.GetEnableGzipCompression()).To(BeFalse())
+		testService.SetEnableGzipCompression(true)
+		Expect(testService.GetEnableGzipCompression()).To(BeTrue())
+
+		_, _, err := testService.EditSettings(testService.NewEditSettingsOptions().SetMaxReqPerMin(25))
+		Expect(err).To(BeNil())
+		Expect(lastRequest.Header.Get("Content-Encoding")).To(Equal("gzip"))
+		Expect(string(lastBody)).To(ContainSubstring(`"max_req_per_min":25`))
+	})
+	It(`Keeps the settings of a clone independent`, func() {
+		testService := newService()
+		clone := testService.Clone()
+		clone.SetEnableGzipCompression(true)
+		Expect(testService.GetEnableGzipCompression()).To(BeFalse())
+		Expect(clone.SetServiceURL("https://other/api")).To(BeNil())
+		Expect(testService.GetServiceURL()).To(Equal(testServer.URL))
+	})
+})
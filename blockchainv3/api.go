@@ -0,0 +1,617 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by genapi. DO NOT EDIT.
+
+package blockchainv3
+
+import (
+	"context"
+	"github.com/IBM/go-sdk-core/v4/core"
+)
+
+// BlockchainV3API : The operations of BlockchainV3, e.g. to be replaced by a fake in tests.
+type BlockchainV3API interface {
+	// GetComponent : Get component data
+	GetComponent(getComponentOptions *GetComponentOptions) (result *GenericComponentResponse, response *core.DetailedResponse, err error)
+
+	// GetComponentWithContext is an alternate form of the GetComponent method which supports a Context parameter
+	GetComponentWithContext(ctx context.Context, getComponentOptions *GetComponentOptions) (result *GenericComponentResponse, response *core.DetailedResponse, err error)
+
+	// RemoveComponent : Remove imported component
+	RemoveComponent(removeComponentOptions *RemoveComponentOptions) (result *DeleteComponentResponse, response *core.DetailedResponse, err error)
+
+	// RemoveComponentWithContext is an alternate form of the RemoveComponent method which supports a Context parameter
+	RemoveComponentWithContext(ctx context.Context, removeComponentOptions *RemoveComponentOptions) (result *DeleteComponentResponse, response *core.DetailedResponse, err error)
+
+	// DeleteComponent : Delete component
+	DeleteComponent(deleteComponentOptions *DeleteComponentOptions) (result *DeleteComponentResponse, response *core.DetailedResponse, err error)
+
+	// DeleteComponentWithContext is an alternate form of the DeleteComponent method which supports a Context parameter
+	DeleteComponentWithContext(ctx context.Context, deleteComponentOptions *DeleteComponentOptions) (result *DeleteComponentResponse, response *core.DetailedResponse, err error)
+
+	// CreateCa : Create a CA
+	CreateCa(createCaOptions *CreateCaOptions) (result *CaResponse, response *core.DetailedResponse, err error)
+
+	// CreateCaWithContext is an alternate form of the CreateCa method which supports a Context parameter
+	CreateCaWithContext(ctx context.Context, createCaOptions *CreateCaOptions) (result *CaResponse, response *core.DetailedResponse, err error)
+
+	// ImportCa : Import a CA
+	ImportCa(importCaOptions *ImportCaOptions) (result *CaResponse, response *core.DetailedResponse, err error)
+
+	// ImportCaWithContext is an alternate form of the ImportCa method which supports a Context parameter
+	ImportCaWithContext(ctx context.Context, importCaOptions *ImportCaOptions) (result *CaResponse, response *core.DetailedResponse, err error)
+
+	// UpdateCa : Update a CA
+	UpdateCa(updateCaOptions *UpdateCaOptions) (result *CaResponse, response *core.DetailedResponse, err error)
+
+	// UpdateCaWithContext is an alternate form of the UpdateCa method which supports a Context parameter
+	UpdateCaWithContext(ctx context.Context, updateCaOptions *UpdateCaOptions) (result *CaResponse, response *core.DetailedResponse, err error)
+
+	// EditCa : Edit data about a CA
+	EditCa(editCaOptions *EditCaOptions) (result *CaResponse, response *core.DetailedResponse, err error)
+
+	// EditCaWithContext is an alternate form of the EditCa method which supports a Context parameter
+	EditCaWithContext(ctx context.Context, editCaOptions *EditCaOptions) (result *CaResponse, response *core.DetailedResponse, err error)
+
+	// CaAction : Submit action to a CA
+	CaAction(caActionOptions *CaActionOptions) (result *ActionsResponse, response *core.DetailedResponse, err error)
+
+	// CaActionWithContext is an alternate form of the CaAction method which supports a Context parameter
+	CaActionWithContext(ctx context.Context, caActionOptions *CaActionOptions) (result *ActionsResponse, response *core.DetailedResponse, err error)
+
+	// CreatePeer : Create a peer
+	CreatePeer(createPeerOptions *CreatePeerOptions) (result *PeerResponse, response *core.DetailedResponse, err error)
+
+	// CreatePeerWithContext is an alternate form of the CreatePeer method which supports a Context parameter
+	CreatePeerWithContext(ctx context.Context, createPeerOptions *CreatePeerOptions) (result *PeerResponse, response *core.DetailedResponse, err error)
+
+	// ImportPeer : Import a peer
+	ImportPeer(importPeerOptions *ImportPeerOptions) (result *PeerResponse, response *core.DetailedResponse, err error)
+
+	// ImportPeerWithContext is an alternate form of the ImportPeer method which supports a Context parameter
+	ImportPeerWithContext(ctx context.Context, importPeerOptions *ImportPeerOptions) (result *PeerResponse, response *core.DetailedResponse, err error)
+
+	// EditPeer : Edit data about a peer
+	EditPeer(editPeerOptions *EditPeerOptions) (result *PeerResponse, response *core.DetailedResponse, err error)
+
+	// EditPeerWithContext is an alternate form of the EditPeer method which supports a Context parameter
+	EditPeerWithContext(ctx context.Context, editPeerOptions *EditPeerOptions) (result *PeerResponse, response *core.DetailedResponse, err error)
+
+	// PeerAction : Submit action to a peer
+	PeerAction(peerActionOptions *PeerActionOptions) (result *ActionsResponse, response *core.DetailedResponse, err error)
+
+	// PeerActionWithContext is an alternate form of the PeerAction method which supports a Context parameter
+	PeerActionWithContext(ctx context.Context, peerActionOptions *PeerActionOptions) (result *ActionsResponse, response *core.DetailedResponse, err error)
+
+	// UpdatePeer : Update a peer
+	UpdatePeer(updatePeerOptions *UpdatePeerOptions) (result *PeerResponse, response *core.DetailedResponse, err error)
+
+	// UpdatePeerWithContext is an alternate form of the UpdatePeer method which supports a Context parameter
+	UpdatePeerWithContext(ctx context.Context, updatePeerOptions *UpdatePeerOptions) (result *PeerResponse, response *core.DetailedResponse, err error)
+
+	// CreateOrderer : Create an ordering service
+	CreateOrderer(createOrdererOptions *CreateOrdererOptions) (result *CreateOrdererResponse, response *core.DetailedResponse, err error)
+
+	// CreateOrdererWithContext is an alternate form of the CreateOrderer method which supports a Context parameter
+	CreateOrdererWithContext(ctx context.Context, createOrdererOptions *CreateOrdererOptions) (result *CreateOrdererResponse, response *core.DetailedResponse, err error)
+
+	// ImportOrderer : Import an ordering service
+	ImportOrderer(importOrdererOptions *ImportOrdererOptions) (result *OrdererResponse, response *core.DetailedResponse, err error)
+
+	// ImportOrdererWithContext is an alternate form of the ImportOrderer method which supports a Context parameter
+	ImportOrdererWithContext(ctx context.Context, importOrdererOptions *ImportOrdererOptions) (result *OrdererResponse, response *core.DetailedResponse, err error)
+
+	// EditOrderer : Edit data about an orderer
+	EditOrderer(editOrdererOptions *EditOrdererOptions) (result *OrdererResponse, response *core.DetailedResponse, err error)
+
+	// EditOrdererWithContext is an alternate form of the EditOrderer method which supports a Context parameter
+	EditOrdererWithContext(ctx context.Context, editOrdererOptions *EditOrdererOptions) (result *OrdererResponse, response *core.DetailedResponse, err error)
+
+	// OrdererAction : Submit action to an orderer
+	OrdererAction(ordererActionOptions *OrdererActionOptions) (result *ActionsResponse, response *core.DetailedResponse, err error)
+
+	// OrdererActionWithContext is an alternate form of the OrdererAction method which supports a Context parameter
+	OrdererActionWithContext(ctx context.Context, ordererActionOptions *OrdererActionOptions) (result *ActionsResponse, response *core.DetailedResponse, err error)
+
+	// UpdateOrderer : Update an orderer node
+	UpdateOrderer(updateOrdererOptions *UpdateOrdererOptions) (result *OrdererResponse, response *core.DetailedResponse, err error)
+
+	// UpdateOrdererWithContext is an alternate form of the UpdateOrderer method which supports a Context parameter
+	UpdateOrdererWithContext(ctx context.Context, updateOrdererOptions *UpdateOrdererOptions) (result *OrdererResponse, response *core.DetailedResponse, err error)
+
+	// SubmitBlock : Submit config block to orderer
+	SubmitBlock(submitBlockOptions *SubmitBlockOptions) (result *GenericComponentResponse, response *core.DetailedResponse, err error)
+
+	// SubmitBlockWithContext is an alternate form of the SubmitBlock method which supports a Context parameter
+	SubmitBlockWithContext(ctx context.Context, submitBlockOptions *SubmitBlockOptions) (result *GenericComponentResponse, response *core.DetailedResponse, err error)
+
+	// ImportMsp : Import an MSP
+	ImportMsp(importMspOptions *ImportMspOptions) (result *MspResponse, response *core.DetailedResponse, err error)
+
+	// ImportMspWithContext is an alternate form of the ImportMsp method which supports a Context parameter
+	ImportMspWithContext(ctx context.Context, importMspOptions *ImportMspOptions) (result *MspResponse, response *core.DetailedResponse, err error)
+
+	// EditMsp : Edit an MSP
+	EditMsp(editMspOptions *EditMspOptions) (result *MspResponse, response *core.DetailedResponse, err error)
+
+	// EditMspWithContext is an alternate form of the EditMsp method which supports a Context parameter
+	EditMspWithContext(ctx context.Context, editMspOptions *EditMspOptions) (result *MspResponse, response *core.DetailedResponse, err error)
+
+	// GetMspCertificate : Get MSP's public certificates
+	GetMspCertificate(getMspCertificateOptions *GetMspCertificateOptions) (result *GetMSPCertificateResponse, response *core.DetailedResponse, err error)
+
+	// GetMspCertificateWithContext is an alternate form of the GetMspCertificate method which supports a Context parameter
+	GetMspCertificateWithContext(ctx context.Context, getMspCertificateOptions *GetMspCertificateOptions) (result *GetMSPCertificateResponse, response *core.DetailedResponse, err error)
+
+	// EditAdminCerts : Edit admin certs on a component
+	EditAdminCerts(editAdminCertsOptions *EditAdminCertsOptions) (result *EditAdminCertsResponse, response *core.DetailedResponse, err error)
+
+	// EditAdminCertsWithContext is an alternate form of the EditAdminCerts method which supports a Context parameter
+	EditAdminCertsWithContext(ctx context.Context, editAdminCertsOptions *EditAdminCertsOptions) (result *EditAdminCertsResponse, response *core.DetailedResponse, err error)
+
+	// ListComponents : Get all components
+	ListComponents(listComponentsOptions *ListComponentsOptions) (result *GetMultiComponentsResponse, response *core.DetailedResponse, err error)
+
+	// ListComponentsWithContext is an alternate form of the ListComponents method which supports a Context parameter
+	ListComponentsWithContext(ctx context.Context, listComponentsOptions *ListComponentsOptions) (result *GetMultiComponentsResponse, response *core.DetailedResponse, err error)
+
+	// GetComponentsByType : Get components of a type
+	GetComponentsByType(getComponentsByTypeOptions *GetComponentsByTypeOptions) (result *GetMultiComponentsResponse, response *core.DetailedResponse, err error)
+
+	// GetComponentsByTypeWithContext is an alternate form of the GetComponentsByType method which supports a Context parameter
+	GetComponentsByTypeWithContext(ctx context.Context, getComponentsByTypeOptions *GetComponentsByTypeOptions) (result *GetMultiComponentsResponse, response *core.DetailedResponse, err error)
+
+	// GetComponentsByTag : Get components with tag
+	GetComponentsByTag(getComponentsByTagOptions *GetComponentsByTagOptions) (result *GetMultiComponentsResponse, response *core.DetailedResponse, err error)
+
+	// GetComponentsByTagWithContext is an alternate form of the GetComponentsByTag method which supports a Context parameter
+	GetComponentsByTagWithContext(ctx context.Context, getComponentsByTagOptions *GetComponentsByTagOptions) (result *GetMultiComponentsResponse, response *core.DetailedResponse, err error)
+
+	// RemoveComponentsByTag : Remove components with tag
+	RemoveComponentsByTag(removeComponentsByTagOptions *RemoveComponentsByTagOptions) (result *RemoveMultiComponentsResponse, response *core.DetailedResponse, err error)
+
+	// RemoveComponentsByTagWithContext is an alternate form of the RemoveComponentsByTag method which supports a Context parameter
+	RemoveComponentsByTagWithContext(ctx context.Context, removeComponentsByTagOptions *RemoveComponentsByTagOptions) (result *RemoveMultiComponentsResponse, response *core.DetailedResponse, err error)
+
+	// DeleteComponentsByTag : Delete components with tag
+	DeleteComponentsByTag(deleteComponentsByTagOptions *DeleteComponentsByTagOptions) (result *DeleteMultiComponentsResponse, response *core.DetailedResponse, err error)
+
+	// DeleteComponentsByTagWithContext is an alternate form of the DeleteComponentsByTag method which supports a Context parameter
+	DeleteComponentsByTagWithContext(ctx context.Context, deleteComponentsByTagOptions *DeleteComponentsByTagOptions) (result *DeleteMultiComponentsResponse, response *core.DetailedResponse, err error)
+
+	// DeleteAllComponents : Delete all components
+	DeleteAllComponents(deleteAllComponentsOptions *DeleteAllComponentsOptions) (result *DeleteMultiComponentsResponse, response *core.DetailedResponse, err error)
+
+	// DeleteAllComponentsWithContext is an alternate form of the DeleteAllComponents method which supports a Context parameter
+	DeleteAllComponentsWithContext(ctx context.Context, deleteAllComponentsOptions *DeleteAllComponentsOptions) (result *DeleteMultiComponentsResponse, response *core.DetailedResponse, err error)
+
+	// GetSettings : Get public IBP console settings
+	GetSettings(getSettingsOptions *GetSettingsOptions) (result *GetPublicSettingsResponse, response *core.DetailedResponse, err error)
+
+	// GetSettingsWithContext is an alternate form of the GetSettings method which supports a Context parameter
+	GetSettingsWithContext(ctx context.Context, getSettingsOptions *GetSettingsOptions) (result *GetPublicSettingsResponse, response *core.DetailedResponse, err error)
+
+	// EditSettings : Change IBP console settings
+	EditSettings(editSettingsOptions *EditSettingsOptions) (result *GetPublicSettingsResponse, response *core.DetailedResponse, err error)
+
+	// EditSettingsWithContext is an alternate form of the EditSettings method which supports a Context parameter
+	EditSettingsWithContext(ctx context.Context, editSettingsOptions *EditSettingsOptions) (result *GetPublicSettingsResponse, response *core.DetailedResponse, err error)
+
+	// GetFabVersions : Get supported Fabric versions
+	GetFabVersions(getFabVersionsOptions *GetFabVersionsOptions) (result *GetFabricVersionsResponse, response *core.DetailedResponse, err error)
+
+	// GetFabVersionsWithContext is an alternate form of the GetFabVersions method which supports a Context parameter
+	GetFabVersionsWithContext(ctx context.Context, getFabVersionsOptions *GetFabVersionsOptions) (result *GetFabricVersionsResponse, response *core.DetailedResponse, err error)
+
+	// GetHealth : Get IBP console health stats
+	GetHealth(getHealthOptions *GetHealthOptions) (result *GetAthenaHealthStatsResponse, response *core.DetailedResponse, err error)
+
+	// GetHealthWithContext is an alternate form of the GetHealth method which supports a Context parameter
+	GetHealthWithContext(ctx context.Context, getHealthOptions *GetHealthOptions) (result *GetAthenaHealthStatsResponse, response *core.DetailedResponse, err error)
+
+	// ListNotifications : Get all notifications
+	ListNotifications(listNotificationsOptions *ListNotificationsOptions) (result *GetNotificationsResponse, response *core.DetailedResponse, err error)
+
+	// ListNotificationsWithContext is an alternate form of the ListNotifications method which supports a Context parameter
+	ListNotificationsWithContext(ctx context.Context, listNotificationsOptions *ListNotificationsOptions) (result *GetNotificationsResponse, response *core.DetailedResponse, err error)
+
+	// DeleteSigTx : Delete a signature collection tx
+	DeleteSigTx(deleteSigTxOptions *DeleteSigTxOptions) (result *DeleteSignatureCollectionResponse, response *core.DetailedResponse, err error)
+
+	// DeleteSigTxWithContext is an alternate form of the DeleteSigTx method which supports a Context parameter
+	DeleteSigTxWithContext(ctx context.Context, deleteSigTxOptions *DeleteSigTxOptions) (result *DeleteSignatureCollectionResponse, response *core.DetailedResponse, err error)
+
+	// ArchiveNotifications : Archive notifications
+	ArchiveNotifications(archiveNotificationsOptions *ArchiveNotificationsOptions) (result *ArchiveResponse, response *core.DetailedResponse, err error)
+
+	// ArchiveNotificationsWithContext is an alternate form of the ArchiveNotifications method which supports a Context parameter
+	ArchiveNotificationsWithContext(ctx context.Context, archiveNotificationsOptions *ArchiveNotificationsOptions) (result *ArchiveResponse, response *core.DetailedResponse, err error)
+
+	// Restart : Restart the IBP console
+	Restart(restartOptions *RestartOptions) (result *RestartAthenaResponse, response *core.DetailedResponse, err error)
+
+	// RestartWithContext is an alternate form of the Restart method which supports a Context parameter
+	RestartWithContext(ctx context.Context, restartOptions *RestartOptions) (result *RestartAthenaResponse, response *core.DetailedResponse, err error)
+
+	// DeleteAllSessions : Delete all IBP console sessions
+	DeleteAllSessions(deleteAllSessionsOptions *DeleteAllSessionsOptions) (result *DeleteAllSessionsResponse, response *core.DetailedResponse, err error)
+
+	// DeleteAllSessionsWithContext is an alternate form of the DeleteAllSessions method which supports a Context parameter
+	DeleteAllSessionsWithContext(ctx context.Context, deleteAllSessionsOptions *DeleteAllSessionsOptions) (result *DeleteAllSessionsResponse, response *core.DetailedResponse, err error)
+
+	// DeleteAllNotifications : Delete all notifications
+	DeleteAllNotifications(deleteAllNotificationsOptions *DeleteAllNotificationsOptions) (result *DeleteAllNotificationsResponse, response *core.DetailedResponse, err error)
+
+	// DeleteAllNotificationsWithContext is an alternate form of the DeleteAllNotifications method which supports a Context parameter
+	DeleteAllNotificationsWithContext(ctx context.Context, deleteAllNotificationsOptions *DeleteAllNotificationsOptions) (result *DeleteAllNotificationsResponse, response *core.DetailedResponse, err error)
+
+	// ClearCaches : Clear IBP console caches
+	ClearCaches(clearCachesOptions *ClearCachesOptions) (result *CacheFlushResponse, response *core.DetailedResponse, err error)
+
+	// ClearCachesWithContext is an alternate form of the ClearCaches method which supports a Context parameter
+	ClearCachesWithContext(ctx context.Context, clearCachesOptions *ClearCachesOptions) (result *CacheFlushResponse, response *core.DetailedResponse, err error)
+
+	// GetPostman : Generate Postman collection
+	GetPostman(getPostmanOptions *GetPostmanOptions) (response *core.DetailedResponse, err error)
+
+	// GetPostmanWithContext is an alternate form of the GetPostman method which supports a Context parameter
+	GetPostmanWithContext(ctx context.Context, getPostmanOptions *GetPostmanOptions) (response *core.DetailedResponse, err error)
+
+	// GetSwagger : Download OpenAPI file
+	GetSwagger(getSwaggerOptions *GetSwaggerOptions) (result *string, response *core.DetailedResponse, err error)
+
+	// GetSwaggerWithContext is an alternate form of the GetSwagger method which supports a Context parameter
+	GetSwaggerWithContext(ctx context.Context, getSwaggerOptions *GetSwaggerOptions) (result *string, response *core.DetailedResponse, err error)
+
+	// NewArchiveNotificationsOptions : Instantiate ArchiveNotificationsOptions
+	NewArchiveNotificationsOptions(notificationIds []string) *ArchiveNotificationsOptions
+
+	// NewBccspPKCS11 : Instantiate BccspPKCS11 (Generic Model Constructor)
+	NewBccspPKCS11(label string, pin string) (model *BccspPKCS11, err error)
+
+	// NewBccspSW : Instantiate BccspSW (Generic Model Constructor)
+	NewBccspSW(hash string, security float64) (model *BccspSW, err error)
+
+	// NewCaActionOptions : Instantiate CaActionOptions
+	NewCaActionOptions(id string) *CaActionOptions
+
+	// NewClearCachesOptions : Instantiate ClearCachesOptions
+	NewClearCachesOptions() *ClearCachesOptions
+
+	// NewConfigCACfgIdentities : Instantiate ConfigCACfgIdentities (Generic Model Constructor)
+	NewConfigCACfgIdentities(passwordattempts float64) (model *ConfigCACfgIdentities, err error)
+
+	// NewConfigCACreate : Instantiate ConfigCACreate (Generic Model Constructor)
+	NewConfigCACreate(registry *ConfigCARegistry) (model *ConfigCACreate, err error)
+
+	// NewConfigCACsrKeyrequest : Instantiate ConfigCACsrKeyrequest (Generic Model Constructor)
+	NewConfigCACsrKeyrequest(algo string, size float64) (model *ConfigCACsrKeyrequest, err error)
+
+	// NewConfigCACsrNamesItem : Instantiate ConfigCACsrNamesItem (Generic Model Constructor)
+	NewConfigCACsrNamesItem(c string, sT string, o string) (model *ConfigCACsrNamesItem, err error)
+
+	// NewConfigCADbTlsClient : Instantiate ConfigCADbTlsClient (Generic Model Constructor)
+	NewConfigCADbTlsClient(certfile string, keyfile string) (model *ConfigCADbTlsClient, err error)
+
+	// NewConfigCAIntermediateEnrollment : Instantiate ConfigCAIntermediateEnrollment (Generic Model Constructor)
+	NewConfigCAIntermediateEnrollment(hosts string, profile string, label string) (model *ConfigCAIntermediateEnrollment, err error)
+
+	// NewConfigCAIntermediateParentserver : Instantiate ConfigCAIntermediateParentserver (Generic Model Constructor)
+	NewConfigCAIntermediateParentserver(url string, caname string) (model *ConfigCAIntermediateParentserver, err error)
+
+	// NewConfigCAIntermediateTls : Instantiate ConfigCAIntermediateTls (Generic Model Constructor)
+	NewConfigCAIntermediateTls(certfiles []string) (model *ConfigCAIntermediateTls, err error)
+
+	// NewConfigCAIntermediateTlsClient : Instantiate ConfigCAIntermediateTlsClient (Generic Model Constructor)
+	NewConfigCAIntermediateTlsClient(certfile string, keyfile string) (model *ConfigCAIntermediateTlsClient, err error)
+
+	// NewConfigCARegistryIdentitiesItem : Instantiate ConfigCARegistryIdentitiesItem (Generic Model Constructor)
+	NewConfigCARegistryIdentitiesItem(name string, pass string, typeVar string) (model *ConfigCARegistryIdentitiesItem, err error)
+
+	// NewConfigCATlsClientauth : Instantiate ConfigCATlsClientauth (Generic Model Constructor)
+	NewConfigCATlsClientauth(typeVar string, certfiles []string) (model *ConfigCATlsClientauth, err error)
+
+	// NewConfigCACfg : Instantiate ConfigCACfg (Generic Model Constructor)
+	NewConfigCACfg(identities *ConfigCACfgIdentities) (model *ConfigCACfg, err error)
+
+	// NewConfigCACors : Instantiate ConfigCACors (Generic Model Constructor)
+	NewConfigCACors(enabled bool, origins []string) (model *ConfigCACors, err error)
+
+	// NewConfigCACrl : Instantiate ConfigCACrl (Generic Model Constructor)
+	NewConfigCACrl(expiry string) (model *ConfigCACrl, err error)
+
+	// NewConfigCACsr : Instantiate ConfigCACsr (Generic Model Constructor)
+	NewConfigCACsr(cn string, names []ConfigCACsrNamesItem, ca *ConfigCACsrCa) (model *ConfigCACsr, err error)
+
+	// NewConfigCADb : Instantiate ConfigCADb (Generic Model Constructor)
+	NewConfigCADb(typeVar string, datasource string) (model *ConfigCADb, err error)
+
+	// NewConfigCAIdemix : Instantiate ConfigCAIdemix (Generic Model Constructor)
+	NewConfigCAIdemix(rhpoolsize float64, nonceexpiration string, noncesweepinterval string) (model *ConfigCAIdemix, err error)
+
+	// NewConfigCAIntermediate : Instantiate ConfigCAIntermediate (Generic Model Constructor)
+	NewConfigCAIntermediate(parentserver *ConfigCAIntermediateParentserver) (model *ConfigCAIntermediate, err error)
+
+	// NewConfigCARegistry : Instantiate ConfigCARegistry (Generic Model Constructor)
+	NewConfigCARegistry(maxenrollments float64, identities []ConfigCARegistryIdentitiesItem) (model *ConfigCARegistry, err error)
+
+	// NewConfigCATls : Instantiate ConfigCATls (Generic Model Constructor)
+	NewConfigCATls(keyfile string, certfile string) (model *ConfigCATls, err error)
+
+	// NewConfigPeerAdminService : Instantiate ConfigPeerAdminService (Generic Model Constructor)
+	NewConfigPeerAdminService(listenAddress string) (model *ConfigPeerAdminService, err error)
+
+	// NewConfigPeerAuthentication : Instantiate ConfigPeerAuthentication (Generic Model Constructor)
+	NewConfigPeerAuthentication(timewindow string) (model *ConfigPeerAuthentication, err error)
+
+	// NewConfigPeerClient : Instantiate ConfigPeerClient (Generic Model Constructor)
+	NewConfigPeerClient(connTimeout string) (model *ConfigPeerClient, err error)
+
+	// NewCreateCaBodyConfigOverride : Instantiate CreateCaBodyConfigOverride (Generic Model Constructor)
+	NewCreateCaBodyConfigOverride(ca *ConfigCACreate) (model *CreateCaBodyConfigOverride, err error)
+
+	// NewCreateCaBodyResources : Instantiate CreateCaBodyResources (Generic Model Constructor)
+	NewCreateCaBodyResources(ca *ResourceObject) (model *CreateCaBodyResources, err error)
+
+	// NewCreateCaBodyStorage : Instantiate CreateCaBodyStorage (Generic Model Constructor)
+	NewCreateCaBodyStorage(ca *StorageObject) (model *CreateCaBodyStorage, err error)
+
+	// NewCreateCaOptions : Instantiate CreateCaOptions
+	NewCreateCaOptions(displayName string, configOverride *CreateCaBodyConfigOverride) *CreateCaOptions
+
+	// NewCreateOrdererOptions : Instantiate CreateOrdererOptions
+	NewCreateOrdererOptions(ordererType string, mspID string, displayName string, crypto []CryptoObject) *CreateOrdererOptions
+
+	// NewCreateOrdererRaftBodyResources : Instantiate CreateOrdererRaftBodyResources (Generic Model Constructor)
+	NewCreateOrdererRaftBodyResources(orderer *ResourceObject) (model *CreateOrdererRaftBodyResources, err error)
+
+	// NewCreateOrdererRaftBodyStorage : Instantiate CreateOrdererRaftBodyStorage (Generic Model Constructor)
+	NewCreateOrdererRaftBodyStorage(orderer *StorageObject) (model *CreateOrdererRaftBodyStorage, err error)
+
+	// NewCreatePeerBodyStorage : Instantiate CreatePeerBodyStorage (Generic Model Constructor)
+	NewCreatePeerBodyStorage(peer *StorageObject) (model *CreatePeerBodyStorage, err error)
+
+	// NewCreatePeerOptions : Instantiate CreatePeerOptions
+	NewCreatePeerOptions(mspID string, displayName string, crypto *CryptoObject) *CreatePeerOptions
+
+	// NewCryptoObjectEnrollment : Instantiate CryptoObjectEnrollment (Generic Model Constructor)
+	NewCryptoObjectEnrollment(component *CryptoEnrollmentComponent, ca *CryptoObjectEnrollmentCa, tlsca *CryptoObjectEnrollmentTlsca) (model *CryptoObjectEnrollment, err error)
+
+	// NewCryptoObjectEnrollmentCa : Instantiate CryptoObjectEnrollmentCa (Generic Model Constructor)
+	NewCryptoObjectEnrollmentCa(host string, port float64, name string, tlsCert string, enrollID string, enrollSecret string) (model *CryptoObjectEnrollmentCa, err error)
+
+	// NewCryptoObjectEnrollmentTlsca : Instantiate CryptoObjectEnrollmentTlsca (Generic Model Constructor)
+	NewCryptoObjectEnrollmentTlsca(host string, port float64, name string, tlsCert string, enrollID string, enrollSecret string) (model *CryptoObjectEnrollmentTlsca, err error)
+
+	// NewCryptoObjectMsp : Instantiate CryptoObjectMsp (Generic Model Constructor)
+	NewCryptoObjectMsp(component *MspCryptoComp, ca *MspCryptoCa, tlsca *MspCryptoCa) (model *CryptoObjectMsp, err error)
+
+	// NewDeleteAllComponentsOptions : Instantiate DeleteAllComponentsOptions
+	NewDeleteAllComponentsOptions() *DeleteAllComponentsOptions
+
+	// NewDeleteAllNotificationsOptions : Instantiate DeleteAllNotificationsOptions
+	NewDeleteAllNotificationsOptions() *DeleteAllNotificationsOptions
+
+	// NewDeleteAllSessionsOptions : Instantiate DeleteAllSessionsOptions
+	NewDeleteAllSessionsOptions() *DeleteAllSessionsOptions
+
+	// NewDeleteComponentOptions : Instantiate DeleteComponentOptions
+	NewDeleteComponentOptions(id string) *DeleteComponentOptions
+
+	// NewDeleteComponentsByTagOptions : Instantiate DeleteComponentsByTagOptions
+	NewDeleteComponentsByTagOptions(tag string) *DeleteComponentsByTagOptions
+
+	// NewDeleteSigTxOptions : Instantiate DeleteSigTxOptions
+	NewDeleteSigTxOptions(id string) *DeleteSigTxOptions
+
+	// NewEditAdminCertsOptions : Instantiate EditAdminCertsOptions
+	NewEditAdminCertsOptions(id string) *EditAdminCertsOptions
+
+	// NewEditCaOptions : Instantiate EditCaOptions
+	NewEditCaOptions(id string) *EditCaOptions
+
+	// NewEditMspOptions : Instantiate EditMspOptions
+	NewEditMspOptions(id string) *EditMspOptions
+
+	// NewEditOrdererOptions : Instantiate EditOrdererOptions
+	NewEditOrdererOptions(id string) *EditOrdererOptions
+
+	// NewEditPeerOptions : Instantiate EditPeerOptions
+	NewEditPeerOptions(id string) *EditPeerOptions
+
+	// NewEditSettingsOptions : Instantiate EditSettingsOptions
+	NewEditSettingsOptions() *EditSettingsOptions
+
+	// NewGetComponentOptions : Instantiate GetComponentOptions
+	NewGetComponentOptions(id string) *GetComponentOptions
+
+	// NewGetComponentsByTagOptions : Instantiate GetComponentsByTagOptions
+	NewGetComponentsByTagOptions(tag string) *GetComponentsByTagOptions
+
+	// NewGetComponentsByTypeOptions : Instantiate GetComponentsByTypeOptions
+	NewGetComponentsByTypeOptions(typeVar string) *GetComponentsByTypeOptions
+
+	// NewGetFabVersionsOptions : Instantiate GetFabVersionsOptions
+	NewGetFabVersionsOptions() *GetFabVersionsOptions
+
+	// NewGetHealthOptions : Instantiate GetHealthOptions
+	NewGetHealthOptions() *GetHealthOptions
+
+	// NewGetMspCertificateOptions : Instantiate GetMspCertificateOptions
+	NewGetMspCertificateOptions(mspID string) *GetMspCertificateOptions
+
+	// NewGetPostmanOptions : Instantiate GetPostmanOptions
+	NewGetPostmanOptions(authType string) *GetPostmanOptions
+
+	// NewGetSettingsOptions : Instantiate GetSettingsOptions
+	NewGetSettingsOptions() *GetSettingsOptions
+
+	// NewGetSwaggerOptions : Instantiate GetSwaggerOptions
+	NewGetSwaggerOptions() *GetSwaggerOptions
+
+	// NewImportCaBodyMsp : Instantiate ImportCaBodyMsp (Generic Model Constructor)
+	NewImportCaBodyMsp(ca *ImportCaBodyMspCa, tlsca *ImportCaBodyMspTlsca, component *ImportCaBodyMspComponent) (model *ImportCaBodyMsp, err error)
+
+	// NewImportCaBodyMspCa : Instantiate ImportCaBodyMspCa (Generic Model Constructor)
+	NewImportCaBodyMspCa(name string) (model *ImportCaBodyMspCa, err error)
+
+	// NewImportCaBodyMspComponent : Instantiate ImportCaBodyMspComponent (Generic Model Constructor)
+	NewImportCaBodyMspComponent(tlsCert string) (model *ImportCaBodyMspComponent, err error)
+
+	// NewImportCaBodyMspTlsca : Instantiate ImportCaBodyMspTlsca (Generic Model Constructor)
+	NewImportCaBodyMspTlsca(name string) (model *ImportCaBodyMspTlsca, err error)
+
+	// NewImportCaOptions : Instantiate ImportCaOptions
+	NewImportCaOptions(displayName string, apiURL string, msp *ImportCaBodyMsp) *ImportCaOptions
+
+	// NewImportMspOptions : Instantiate ImportMspOptions
+	NewImportMspOptions(mspID string, displayName string, rootCerts []string) *ImportMspOptions
+
+	// NewImportOrdererOptions : Instantiate ImportOrdererOptions
+	NewImportOrdererOptions(clusterName string, displayName string, grpcwpURL string, msp *MspCryptoField, mspID string) *ImportOrdererOptions
+
+	// NewImportPeerOptions : Instantiate ImportPeerOptions
+	NewImportPeerOptions(displayName string, grpcwpURL string, msp *MspCryptoField, mspID string) *ImportPeerOptions
+
+	// NewListComponentsOptions : Instantiate ListComponentsOptions
+	NewListComponentsOptions() *ListComponentsOptions
+
+	// NewListNotificationsOptions : Instantiate ListNotificationsOptions
+	NewListNotificationsOptions() *ListNotificationsOptions
+
+	// NewMetrics : Instantiate Metrics (Generic Model Constructor)
+	NewMetrics(provider string) (model *Metrics, err error)
+
+	// NewMetricsStatsd : Instantiate MetricsStatsd (Generic Model Constructor)
+	NewMetricsStatsd(network string, address string, writeInterval string, prefix string) (model *MetricsStatsd, err error)
+
+	// NewMspCryptoCa : Instantiate MspCryptoCa (Generic Model Constructor)
+	NewMspCryptoCa(rootCerts []string) (model *MspCryptoCa, err error)
+
+	// NewMspCryptoComp : Instantiate MspCryptoComp (Generic Model Constructor)
+	NewMspCryptoComp(ekey string, ecert string, tlsKey string, tlsCert string) (model *MspCryptoComp, err error)
+
+	// NewMspCryptoFieldComponent : Instantiate MspCryptoFieldComponent (Generic Model Constructor)
+	NewMspCryptoFieldComponent(tlsCert string) (model *MspCryptoFieldComponent, err error)
+
+	// NewMspCryptoFieldTlsca : Instantiate MspCryptoFieldTlsca (Generic Model Constructor)
+	NewMspCryptoFieldTlsca(rootCerts []string) (model *MspCryptoFieldTlsca, err error)
+
+	// NewOrdererActionOptions : Instantiate OrdererActionOptions
+	NewOrdererActionOptions(id string) *OrdererActionOptions
+
+	// NewPeerActionOptions : Instantiate PeerActionOptions
+	NewPeerActionOptions(id string) *PeerActionOptions
+
+	// NewRemoveComponentOptions : Instantiate RemoveComponentOptions
+	NewRemoveComponentOptions(id string) *RemoveComponentOptions
+
+	// NewRemoveComponentsByTagOptions : Instantiate RemoveComponentsByTagOptions
+	NewRemoveComponentsByTagOptions(tag string) *RemoveComponentsByTagOptions
+
+	// NewResourceObject : Instantiate ResourceObject (Generic Model Constructor)
+	NewResourceObject(requests *ResourceRequests) (model *ResourceObject, err error)
+
+	// NewResourceObjectCouchDb : Instantiate ResourceObjectCouchDb (Generic Model Constructor)
+	NewResourceObjectCouchDb(requests *ResourceRequests) (model *ResourceObjectCouchDb, err error)
+
+	// NewResourceObjectFabV1 : Instantiate ResourceObjectFabV1 (Generic Model Constructor)
+	NewResourceObjectFabV1(requests *ResourceRequests) (model *ResourceObjectFabV1, err error)
+
+	// NewResourceObjectFabV2 : Instantiate ResourceObjectFabV2 (Generic Model Constructor)
+	NewResourceObjectFabV2(requests *ResourceRequests) (model *ResourceObjectFabV2, err error)
+
+	// NewRestartOptions : Instantiate RestartOptions
+	NewRestartOptions() *RestartOptions
+
+	// NewSubmitBlockOptions : Instantiate SubmitBlockOptions
+	NewSubmitBlockOptions(id string) *SubmitBlockOptions
+
+	// NewUpdateCaBodyConfigOverride : Instantiate UpdateCaBodyConfigOverride (Generic Model Constructor)
+	NewUpdateCaBodyConfigOverride(ca *ConfigCAUpdate) (model *UpdateCaBodyConfigOverride, err error)
+
+	// NewUpdateCaBodyResources : Instantiate UpdateCaBodyResources (Generic Model Constructor)
+	NewUpdateCaBodyResources(ca *ResourceObject) (model *UpdateCaBodyResources, err error)
+
+	// NewUpdateCaOptions : Instantiate UpdateCaOptions
+	NewUpdateCaOptions(id string) *UpdateCaOptions
+
+	// NewUpdateOrdererOptions : Instantiate UpdateOrdererOptions
+	NewUpdateOrdererOptions(id string) *UpdateOrdererOptions
+
+	// NewUpdatePeerOptions : Instantiate UpdatePeerOptions
+	NewUpdatePeerOptions(id string) *UpdatePeerOptions
+
+	// NewHsm : Instantiate Hsm (Generic Model Constructor)
+	NewHsm(pkcs11endpoint string) (model *Hsm, err error)
+
+	// NewMspCryptoField : Instantiate MspCryptoField (Generic Model Constructor)
+	NewMspCryptoField(tlsca *MspCryptoFieldTlsca, component *MspCryptoFieldComponent) (model *MspCryptoField, err error)
+
+	// WatchNotifications : Watch the console notifications
+	WatchNotifications(ctx context.Context, filter *NotificationFilter) <-chan *NotificationEvent
+
+	// ListSigTxs : Get all signature collection txs
+	ListSigTxs(listSigTxsOptions *ListSigTxsOptions) (result *GetSignatureCollectionsResponse, response *core.DetailedResponse, err error)
+
+	// ListSigTxsWithContext is an alternate form of the ListSigTxs method which supports a Context parameter
+	ListSigTxsWithContext(ctx context.Context, listSigTxsOptions *ListSigTxsOptions) (result *GetSignatureCollectionsResponse, response *core.DetailedResponse, err error)
+
+	// GetSigTx : Get a signature collection tx
+	GetSigTx(getSigTxOptions *GetSigTxOptions) (result *SignatureCollection, response *core.DetailedResponse, err error)
+
+	// GetSigTxWithContext is an alternate form of the GetSigTx method which supports a Context parameter
+	GetSigTxWithContext(ctx context.Context, getSigTxOptions *GetSigTxOptions) (result *SignatureCollection, response *core.DetailedResponse, err error)
+
+	// CreateSigTx : Create a signature collection tx
+	CreateSigTx(createSigTxOptions *CreateSigTxOptions) (result *SignatureCollection, response *core.DetailedResponse, err error)
+
+	// CreateSigTxWithContext is an alternate form of the CreateSigTx method which supports a Context parameter
+	CreateSigTxWithContext(ctx context.Context, createSigTxOptions *CreateSigTxOptions) (result *SignatureCollection, response *core.DetailedResponse, err error)
+
+	// ApproveSigTx : Approve a signature collection tx
+	ApproveSigTx(approveSigTxOptions *ApproveSigTxOptions) (result *SignatureCollection, response *core.DetailedResponse, err error)
+
+	// ApproveSigTxWithContext is an alternate form of the ApproveSigTx method which supports a Context parameter
+	ApproveSigTxWithContext(ctx context.Context, approveSigTxOptions *ApproveSigTxOptions) (result *SignatureCollection, response *core.DetailedResponse, err error)
+
+	// SubmitSigTx : Submit a signature collection tx
+	SubmitSigTx(submitSigTxOptions *SubmitSigTxOptions) (result *SignatureCollection, response *core.DetailedResponse, err error)
+
+	// SubmitSigTxWithContext is an alternate form of the SubmitSigTx method which supports a Context parameter
+	SubmitSigTxWithContext(ctx context.Context, submitSigTxOptions *SubmitSigTxOptions) (result *SignatureCollection, response *core.DetailedResponse, err error)
+
+	// NewListSigTxsOptions : Instantiate ListSigTxsOptions
+	NewListSigTxsOptions() *ListSigTxsOptions
+
+	// NewGetSigTxOptions : Instantiate GetSigTxOptions
+	NewGetSigTxOptions(id string) *GetSigTxOptions
+
+	// NewCreateSigTxOptions : Instantiate CreateSigTxOptions
+	NewCreateSigTxOptions(txID string, channel string, proposal string, orgs2sign []SignatureCollectionOrg) *CreateSigTxOptions
+
+	// NewApproveSigTxOptions : Instantiate ApproveSigTxOptions
+	NewApproveSigTxOptions(id string, mspID string, certificate string, signature string) *ApproveSigTxOptions
+
+	// NewSubmitSigTxOptions : Instantiate SubmitSigTxOptions
+	NewSubmitSigTxOptions(id string, mspID string, certificate string, signature string) *SubmitSigTxOptions
+
+	// WaitForComponentReady : Wait for a component to be ready
+	WaitForComponentReady(ctx context.Context, id string, options *WaitForComponentReadyOptions) error
+}
+
+var _ BlockchainV3API = (*BlockchainV3)(nil)
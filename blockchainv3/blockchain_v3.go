@@ -40,6 +40,9 @@ import (
 // See: http://swagger.io
 type BlockchainV3 struct {
 	Service *core.BaseService
+
+	// The middleware wrapping every operation, outermost first. See Use().
+	middleware []Middleware
 }
 
 // DefaultServiceName is the default key used to find external configuration information.
@@ -150,6 +153,7 @@ func (blockchain *BlockchainV3) GetEnableGzipCompression() bool {
 // If either parameter is specified as 0, then a default value is used instead.
 func (blockchain *BlockchainV3) EnableRetries(maxRetries int, maxRetryInterval time.Duration) {
 	blockchain.Service.EnableRetries(maxRetries, maxRetryInterval)
+	countRetries(blockchain.Service.Client)
 }
 
 // DisableRetries disables automatic retries for requests invoked for this service instance.
@@ -215,7 +219,7 @@ func (blockchain *BlockchainV3) GetComponentWithContext(ctx context.Context, get
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = blockchain.Service.Request(request, &rawResponse)
+	response, err = blockchain.request("GetComponent", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -278,7 +282,7 @@ func (blockchain *BlockchainV3) RemoveComponentWithContext(ctx context.Context,
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = blockchain.Service.Request(request, &rawResponse)
+	response, err = blockchain.request("RemoveComponent", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -341,7 +345,7 @@ func (blockchain *BlockchainV3) DeleteComponentWithContext(ctx context.Context,
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = blockchain.Service.Request(request, &rawResponse)
+	response, err = blockchain.request("DeleteComponent", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -435,7 +439,7 @@ func (blockchain *BlockchainV3) CreateCaWithContext(ctx context.Context, createC
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = blockchain.Service.Request(request, &rawResponse)
+	response, err = blockchain.request("CreateCa", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -521,7 +525,7 @@ func (blockchain *BlockchainV3) ImportCaWithContext(ctx context.Context, importC
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = blockchain.Service.Request(request, &rawResponse)
+	response, err = blockchain.request("ImportCa", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -601,7 +605,7 @@ func (blockchain *BlockchainV3) UpdateCaWithContext(ctx context.Context, updateC
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = blockchain.Service.Request(request, &rawResponse)
+	response, err = blockchain.request("UpdateCa", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -685,7 +689,7 @@ func (blockchain *BlockchainV3) EditCaWithContext(ctx context.Context, editCaOpt
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = blockchain.Service.Request(request, &rawResponse)
+	response, err = blockchain.request("EditCa", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -756,7 +760,7 @@ func (blockchain *BlockchainV3) CaActionWithContext(ctx context.Context, caActio
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = blockchain.Service.Request(request, &rawResponse)
+	response, err = blockchain.request("CaAction", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -856,7 +860,7 @@ func (blockchain *BlockchainV3) CreatePeerWithContext(ctx context.Context, creat
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = blockchain.Service.Request(request, &rawResponse)
+	response, err = blockchain.request("CreatePeer", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -945,7 +949,7 @@ func (blockchain *BlockchainV3) ImportPeerWithContext(ctx context.Context, impor
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = blockchain.Service.Request(request, &rawResponse)
+	response, err = blockchain.request("ImportPeer", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -1032,7 +1036,7 @@ func (blockchain *BlockchainV3) EditPeerWithContext(ctx context.Context, editPee
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = blockchain.Service.Request(request, &rawResponse)
+	response, err = blockchain.request("EditPeer", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -1109,7 +1113,7 @@ func (blockchain *BlockchainV3) PeerActionWithContext(ctx context.Context, peerA
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = blockchain.Service.Request(request, &rawResponse)
+	response, err = blockchain.request("PeerAction", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -1198,7 +1202,7 @@ func (blockchain *BlockchainV3) UpdatePeerWithContext(ctx context.Context, updat
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = blockchain.Service.Request(request, &rawResponse)
+	response, err = blockchain.request("UpdatePeer", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -1311,7 +1315,7 @@ func (blockchain *BlockchainV3) CreateOrdererWithContext(ctx context.Context, cr
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = blockchain.Service.Request(request, &rawResponse)
+	response, err = blockchain.request("CreateOrderer", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -1409,7 +1413,7 @@ func (blockchain *BlockchainV3) ImportOrdererWithContext(ctx context.Context, im
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = blockchain.Service.Request(request, &rawResponse)
+	response, err = blockchain.request("ImportOrderer", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -1505,7 +1509,7 @@ func (blockchain *BlockchainV3) EditOrdererWithContext(ctx context.Context, edit
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = blockchain.Service.Request(request, &rawResponse)
+	response, err = blockchain.request("EditOrderer", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -1579,7 +1583,7 @@ func (blockchain *BlockchainV3) OrdererActionWithContext(ctx context.Context, or
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = blockchain.Service.Request(request, &rawResponse)
+	response, err = blockchain.request("OrdererAction", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -1668,7 +1672,7 @@ func (blockchain *BlockchainV3) UpdateOrdererWithContext(ctx context.Context, up
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = blockchain.Service.Request(request, &rawResponse)
+	response, err = blockchain.request("UpdateOrderer", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -1764,7 +1768,7 @@ func (blockchain *BlockchainV3) SubmitBlockWithContext(ctx context.Context, subm
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = blockchain.Service.Request(request, &rawResponse)
+	response, err = blockchain.request("SubmitBlock", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -1844,7 +1848,7 @@ func (blockchain *BlockchainV3) ImportMspWithContext(ctx context.Context, import
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = blockchain.Service.Request(request, &rawResponse)
+	response, err = blockchain.request("ImportMsp", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -1928,7 +1932,7 @@ func (blockchain *BlockchainV3) EditMspWithContext(ctx context.Context, editMspO
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = blockchain.Service.Request(request, &rawResponse)
+	response, err = blockchain.request("EditMsp", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -1990,7 +1994,7 @@ func (blockchain *BlockchainV3) GetMspCertificateWithContext(ctx context.Context
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = blockchain.Service.Request(request, &rawResponse)
+	response, err = blockchain.request("GetMspCertificate", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -2065,7 +2069,7 @@ func (blockchain *BlockchainV3) EditAdminCertsWithContext(ctx context.Context, e
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = blockchain.Service.Request(request, &rawResponse)
+	response, err = blockchain.request("EditAdminCerts", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -2129,7 +2133,7 @@ func (blockchain *BlockchainV3) ListComponentsWithContext(ctx context.Context, l
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = blockchain.Service.Request(request, &rawResponse)
+	response, err = blockchain.request("ListComponents", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -2197,7 +2201,7 @@ func (blockchain *BlockchainV3) GetComponentsByTypeWithContext(ctx context.Conte
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = blockchain.Service.Request(request, &rawResponse)
+	response, err = blockchain.request("GetComponentsByType", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -2266,7 +2270,7 @@ func (blockchain *BlockchainV3) GetComponentsByTagWithContext(ctx context.Contex
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = blockchain.Service.Request(request, &rawResponse)
+	response, err = blockchain.request("GetComponentsByTag", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -2329,7 +2333,7 @@ func (blockchain *BlockchainV3) RemoveComponentsByTagWithContext(ctx context.Con
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = blockchain.Service.Request(request, &rawResponse)
+	response, err = blockchain.request("RemoveComponentsByTag", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -2394,7 +2398,7 @@ func (blockchain *BlockchainV3) DeleteComponentsByTagWithContext(ctx context.Con
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = blockchain.Service.Request(request, &rawResponse)
+	response, err = blockchain.request("DeleteComponentsByTag", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -2446,7 +2450,7 @@ func (blockchain *BlockchainV3) DeleteAllComponentsWithContext(ctx context.Conte
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = blockchain.Service.Request(request, &rawResponse)
+	response, err = blockchain.request("DeleteAllComponents", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -2497,7 +2501,7 @@ func (blockchain *BlockchainV3) GetSettingsWithContext(ctx context.Context, getS
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = blockchain.Service.Request(request, &rawResponse)
+	response, err = blockchain.request("GetSettings", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -2592,7 +2596,7 @@ func (blockchain *BlockchainV3) EditSettingsWithContext(ctx context.Context, edi
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = blockchain.Service.Request(request, &rawResponse)
+	response, err = blockchain.request("EditSettings", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -2647,7 +2651,7 @@ func (blockchain *BlockchainV3) GetFabVersionsWithContext(ctx context.Context, g
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = blockchain.Service.Request(request, &rawResponse)
+	response, err = blockchain.request("GetFabVersions", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -2698,7 +2702,7 @@ func (blockchain *BlockchainV3) GetHealthWithContext(ctx context.Context, getHea
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = blockchain.Service.Request(request, &rawResponse)
+	response, err = blockchain.request("GetHealth", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -2759,7 +2763,7 @@ func (blockchain *BlockchainV3) ListNotificationsWithContext(ctx context.Context
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = blockchain.Service.Request(request, &rawResponse)
+	response, err = blockchain.request("ListNotifications", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -2819,7 +2823,7 @@ func (blockchain *BlockchainV3) DeleteSigTxWithContext(ctx context.Context, dele
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = blockchain.Service.Request(request, &rawResponse)
+	response, err = blockchain.request("DeleteSigTx", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -2884,7 +2888,7 @@ func (blockchain *BlockchainV3) ArchiveNotificationsWithContext(ctx context.Cont
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = blockchain.Service.Request(request, &rawResponse)
+	response, err = blockchain.request("ArchiveNotifications", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -2935,7 +2939,7 @@ func (blockchain *BlockchainV3) RestartWithContext(ctx context.Context, restartO
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = blockchain.Service.Request(request, &rawResponse)
+	response, err = blockchain.request("Restart", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -2988,7 +2992,7 @@ func (blockchain *BlockchainV3) DeleteAllSessionsWithContext(ctx context.Context
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = blockchain.Service.Request(request, &rawResponse)
+	response, err = blockchain.request("DeleteAllSessions", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -3038,7 +3042,7 @@ func (blockchain *BlockchainV3) DeleteAllNotificationsWithContext(ctx context.Co
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = blockchain.Service.Request(request, &rawResponse)
+	response, err = blockchain.request("DeleteAllNotifications", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -3088,7 +3092,7 @@ func (blockchain *BlockchainV3) ClearCachesWithContext(ctx context.Context, clea
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = blockchain.Service.Request(request, &rawResponse)
+	response, err = blockchain.request("ClearCaches", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -3171,7 +3175,7 @@ func (blockchain *BlockchainV3) GetPostmanWithContext(ctx context.Context, getPo
 		return
 	}
 
-	response, err = blockchain.Service.Request(request, nil)
+	response, err = blockchain.request("GetPostman", request, nil)
 
 	return
 }
@@ -3214,7 +3218,7 @@ func (blockchain *BlockchainV3) GetSwaggerWithContext(ctx context.Context, getSw
 		return
 	}
 
-	response, err = blockchain.Service.Request(request, &result)
+	response, err = blockchain.request("GetSwagger", request, &result)
 
 	return
 }
@@ -0,0 +1,273 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package blockchainv3
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"github.com/IBM/go-sdk-core/v4/core"
+	"net/url"
+	"strconv"
+)
+
+// Validate returns an error unless exactly one of Enrollment or Msp is set and the set one has all its required fields.
+func (cryptoObject *CryptoObject) Validate() error {
+	if (cryptoObject.Enrollment == nil) == (cryptoObject.Msp == nil) {
+		return errors.New("a crypto object must set exactly one of enrollment or msp")
+	}
+	return core.ValidateStruct(cryptoObject, "crypto object")
+}
+
+// ValidateCrypto validates the crypto object of the peer. The generated CreatePeer sends it as is: see CreateValidatedPeer.
+func (options *CreatePeerOptions) ValidateCrypto() error {
+	if options.Crypto == nil {
+		return errors.New("crypto is required")
+	}
+	return options.Crypto.Validate()
+}
+
+// ValidateCrypto validates the crypto object of every orderer node. The generated CreateOrderer sends them as is: see
+// CreateValidatedOrderer.
+func (options *CreateOrdererOptions) ValidateCrypto() error {
+	for i := range options.Crypto {
+		if err := options.Crypto[i].Validate(); err != nil {
+			return fmt.Errorf("crypto[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// CreateValidatedPeer calls CreatePeer once the crypto object of the peer is validated, so that a crypto object that
+// was not made by a CryptoObjectBuilder is rejected before the request is sent.
+func CreateValidatedPeer(ctx context.Context, service BlockchainV3API, options *CreatePeerOptions) (*PeerResponse, *core.DetailedResponse, error) {
+	if err := options.ValidateCrypto(); err != nil {
+		return nil, nil, err
+	}
+	return service.CreatePeerWithContext(ctx, options)
+}
+
+// CreateValidatedOrderer calls CreateOrderer once the crypto objects of the orderer nodes are validated, so that a crypto
+// object that was not made by a CryptoObjectBuilder is rejected before the request is sent.
+func CreateValidatedOrderer(ctx context.Context, service BlockchainV3API, options *CreateOrdererOptions) (*CreateOrdererResponse, *core.DetailedResponse, error) {
+	if err := options.ValidateCrypto(); err != nil {
+		return nil, nil, err
+	}
+	return service.CreateOrdererWithContext(ctx, options)
+}
+
+// CryptoObjectBuilder : Builds the CryptoObject of a peer or an orderer node from the CA the node's identities come from.
+// Set either the enrollment ID and secret the node enrolls with, which yields the `enrollment` variant, or the node's
+// already enrolled identity, which yields the `msp` variant.
+type CryptoObjectBuilder struct {
+	service BlockchainV3API
+	ca      *GenericComponentResponse
+	caID    string
+
+	enrollID, enrollSecret       string
+	tlsEnrollID, tlsEnrollSecret string
+	csrHosts                     []string
+	adminCerts                   []string
+
+	ekey, ecert, tlsKey, tlsCert string
+	rootCerts, tlsRootCerts      []string
+	hasMspIdentity               bool
+}
+
+// NewCryptoObjectBuilder : Instantiate a CryptoObjectBuilder
+func (blockchain *BlockchainV3) NewCryptoObjectBuilder() *CryptoObjectBuilder {
+	return NewCryptoObjectBuilderFor(blockchain)
+}
+
+// NewCryptoObjectBuilderFor : Instantiate a CryptoObjectBuilder that fetches the CA component from any BlockchainV3API,
+// such as a fake console.
+func NewCryptoObjectBuilderFor(service BlockchainV3API) *CryptoObjectBuilder {
+	return &CryptoObjectBuilder{service: service}
+}
+
+// FromCA sets the CA component the node's identities come from.
+func (builder *CryptoObjectBuilder) FromCA(ca *GenericComponentResponse) *CryptoObjectBuilder {
+	builder.ca, builder.caID = ca, ""
+	return builder
+}
+
+// FromCAID sets the ID of the CA component the node's identities come from. The component is fetched when the crypto
+// object is built.
+func (builder *CryptoObjectBuilder) FromCAID(id string) *CryptoObjectBuilder {
+	builder.ca, builder.caID = nil, id
+	return builder
+}
+
+// SetEnrollment sets the enroll ID and secret the node enrolls with, against both the CA and the TLS CA.
+func (builder *CryptoObjectBuilder) SetEnrollment(enrollID, enrollSecret string) *CryptoObjectBuilder {
+	builder.enrollID, builder.enrollSecret = enrollID, enrollSecret
+	return builder
+}
+
+// SetTLSEnrollment sets a different enroll ID and secret for the TLS CA.
+func (builder *CryptoObjectBuilder) SetTLSEnrollment(enrollID, enrollSecret string) *CryptoObjectBuilder {
+	builder.tlsEnrollID, builder.tlsEnrollSecret = enrollID, enrollSecret
+	return builder
+}
+
+// SetCsrHosts sets the additional hosts of the node's TLS certificate.
+func (builder *CryptoObjectBuilder) SetCsrHosts(hosts ...string) *CryptoObjectBuilder {
+	builder.csrHosts = hosts
+	return builder
+}
+
+// AddAdminCerts adds base 64 encoded PEM admin certificates of the node.
+func (builder *CryptoObjectBuilder) AddAdminCerts(certs ...string) *CryptoObjectBuilder {
+	builder.adminCerts = append(builder.adminCerts, certs...)
+	return builder
+}
+
+// AddAdminCertPEM adds a PEM admin certificate of the node, e.g. the enrollment certificate of an organization admin
+// returned by a fabric-ca client.
+func (builder *CryptoObjectBuilder) AddAdminCertPEM(cert []byte) *CryptoObjectBuilder {
+	return builder.AddAdminCerts(base64.StdEncoding.EncodeToString(cert))
+}
+
+// SetMspIdentity sets the node's already enrolled identity: its enrollment key and certificate and its TLS key and
+// certificate, as base 64 encoded PEM.
+func (builder *CryptoObjectBuilder) SetMspIdentity(ekey, ecert, tlsKey, tlsCert string) *CryptoObjectBuilder {
+	builder.ekey, builder.ecert, builder.tlsKey, builder.tlsCert = ekey, ecert, tlsKey, tlsCert
+	builder.hasMspIdentity = true
+	return builder
+}
+
+// SetRootCerts sets the base 64 encoded PEM root certificates of the CA and the TLS CA for the msp variant. They
+// default to the root certificates of the CA component.
+func (builder *CryptoObjectBuilder) SetRootCerts(rootCerts, tlsRootCerts []string) *CryptoObjectBuilder {
+	builder.rootCerts, builder.tlsRootCerts = rootCerts, tlsRootCerts
+	return builder
+}
+
+// Build returns the crypto object.
+func (builder *CryptoObjectBuilder) Build() (*CryptoObject, error) {
+	return builder.BuildWithContext(context.Background())
+}
+
+// BuildWithContext returns the crypto object, fetching the CA component first if only its ID was set.
+func (builder *CryptoObjectBuilder) BuildWithContext(ctx context.Context) (cryptoObject *CryptoObject, err error) {
+	hasEnrollment := builder.enrollID != "" || builder.enrollSecret != ""
+	if hasEnrollment == builder.hasMspIdentity {
+		return nil, errors.New("set exactly one of the enrollment or the MSP identity of the crypto object")
+	}
+
+	ca := builder.ca
+	if ca == nil && builder.caID != "" {
+		ca, _, err = builder.service.GetComponentWithContext(ctx, builder.service.NewGetComponentOptions(builder.caID))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	cryptoObject = new(CryptoObject)
+	if hasEnrollment {
+		cryptoObject.Enrollment, err = builder.enrollment(ca)
+	} else {
+		cryptoObject.Msp, err = builder.msp(ca)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err = cryptoObject.Validate(); err != nil {
+		return nil, err
+	}
+	return cryptoObject, nil
+}
+
+func (builder *CryptoObjectBuilder) enrollment(ca *GenericComponentResponse) (*CryptoObjectEnrollment, error) {
+	if ca == nil {
+		return nil, errors.New("the enrollment variant of a crypto object needs a CA")
+	}
+	if ca.ApiURL == nil {
+		return nil, errors.New("the CA has no API URL")
+	}
+	apiURL, err := url.Parse(*ca.ApiURL)
+	if err != nil {
+		return nil, err
+	}
+	port, err := strconv.ParseFloat(apiURL.Port(), 64)
+	if err != nil {
+		return nil, fmt.Errorf("the CA has no port in its API URL %q", *ca.ApiURL)
+	}
+	var caName, tlsCaName, tlsCert *string
+	if msp := ca.Msp; msp != nil {
+		if msp.Ca != nil {
+			caName = msp.Ca.Name
+		}
+		if msp.Tlsca != nil {
+			tlsCaName = msp.Tlsca.Name
+		}
+		if msp.Component != nil {
+			tlsCert = msp.Component.TlsCert
+		}
+	}
+	tlsEnrollID, tlsEnrollSecret := builder.enrollID, builder.enrollSecret
+	if builder.tlsEnrollID != "" {
+		tlsEnrollID, tlsEnrollSecret = builder.tlsEnrollID, builder.tlsEnrollSecret
+	}
+	return &CryptoObjectEnrollment{
+		Component: &CryptoEnrollmentComponent{Admincerts: builder.adminCerts},
+		Ca: &CryptoObjectEnrollmentCa{
+			Host:         core.StringPtr(apiURL.Hostname()),
+			Port:         core.Float64Ptr(port),
+			Name:         caName,
+			TlsCert:      tlsCert,
+			EnrollID:     core.StringPtr(builder.enrollID),
+			EnrollSecret: core.StringPtr(builder.enrollSecret),
+		},
+		Tlsca: &CryptoObjectEnrollmentTlsca{
+			Host:         core.StringPtr(apiURL.Hostname()),
+			Port:         core.Float64Ptr(port),
+			Name:         tlsCaName,
+			TlsCert:      tlsCert,
+			EnrollID:     core.StringPtr(tlsEnrollID),
+			EnrollSecret: core.StringPtr(tlsEnrollSecret),
+			CsrHosts:     builder.csrHosts,
+		},
+	}, nil
+}
+
+func (builder *CryptoObjectBuilder) msp(ca *GenericComponentResponse) (*CryptoObjectMsp, error) {
+	rootCerts, tlsRootCerts := builder.rootCerts, builder.tlsRootCerts
+	if ca != nil && ca.Msp != nil {
+		if rootCerts == nil && ca.Msp.Ca != nil {
+			rootCerts = ca.Msp.Ca.RootCerts
+		}
+		if tlsRootCerts == nil && ca.Msp.Tlsca != nil {
+			tlsRootCerts = ca.Msp.Tlsca.RootCerts
+		}
+	}
+	if len(rootCerts) == 0 || len(tlsRootCerts) == 0 {
+		return nil, errors.New("the msp variant of a crypto object needs the root certificates of the CA and the TLS CA")
+	}
+	return &CryptoObjectMsp{
+		Component: &MspCryptoComp{
+			Ekey:       core.StringPtr(builder.ekey),
+			Ecert:      core.StringPtr(builder.ecert),
+			AdminCerts: builder.adminCerts,
+			TlsKey:     core.StringPtr(builder.tlsKey),
+			TlsCert:    core.StringPtr(builder.tlsCert),
+		},
+		Ca:    &MspCryptoCa{RootCerts: rootCerts},
+		Tlsca: &MspCryptoCa{RootCerts: tlsRootCerts},
+	}, nil
+}
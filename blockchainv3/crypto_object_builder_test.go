@@ -0,0 +1,138 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package blockchainv3_test
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"github.com/IBM-Blockchain/ibp-go-sdk/blockchainv3"
+	"github.com/IBM-Blockchain/ibp-go-sdk/internal/testutil"
+	"github.com/IBM/go-sdk-core/v4/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"net/http"
+	"net/http/httptest"
+)
+
+var _ = Describe(`CryptoObjectBuilder`, func() {
+	const caJSON = `{"id": "org1ca", "type": "fabric-ca", "api_url": "https://ca.example.com:7054",
+		"msp": {"ca": {"name": "ca", "root_certs": ["cm9vdA=="]}, "tlsca": {"name": "tlsca", "root_certs": ["dGxz"]},
+		"component": {"tls_cert": "dGxzY2VydA=="}}}`
+
+	var testServer *httptest.Server
+	var service *blockchainv3.BlockchainV3
+	var ca *blockchainv3.GenericComponentResponse
+
+	BeforeEach(func() {
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			Expect(req.URL.Path).To(Equal("/ak/api/v3/components/org1ca"))
+			res.Header().Set("Content-type", "application/json")
+			res.WriteHeader(200)
+			fmt.Fprint(res, caJSON)
+		}))
+		var err error
+		service, err = blockchainv3.NewBlockchainV3(&blockchainv3.BlockchainV3Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+		ca, _, err = service.GetComponent(service.NewGetComponentOptions("org1ca"))
+		Expect(err).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Builds the enrollment variant from a CA component`, func() {
+		cryptoObject, err := service.NewCryptoObjectBuilder().
+			FromCA(ca).
+			SetEnrollment("peer1", "peer1pw").
+			SetCsrHosts("peer1.example.com").
+			AddAdminCertPEM([]byte("admin")).
+			Build()
+		Expect(err).To(BeNil())
+		Expect(cryptoObject.Msp).To(BeNil())
+		enrollment := cryptoObject.Enrollment
+		Expect(enrollment.Component.Admincerts).To(Equal([]string{base64.StdEncoding.EncodeToString([]byte("admin"))}))
+		Expect(*enrollment.Ca.Host).To(Equal("ca.example.com"))
+		Expect(*enrollment.Ca.Port).To(Equal(7054.0))
+		Expect(*enrollment.Ca.Name).To(Equal("ca"))
+		Expect(*enrollment.Ca.TlsCert).To(Equal("dGxzY2VydA=="))
+		Expect(*enrollment.Ca.EnrollID).To(Equal("peer1"))
+		Expect(*enrollment.Tlsca.Name).To(Equal("tlsca"))
+		Expect(*enrollment.Tlsca.EnrollSecret).To(Equal("peer1pw"))
+		Expect(enrollment.Tlsca.CsrHosts).To(Equal([]string{"peer1.example.com"}))
+	})
+	It(`Fetches the CA component by ID and supports a separate TLS enrollment`, func() {
+		cryptoObject, err := service.NewCryptoObjectBuilder().
+			FromCAID("org1ca").
+			SetEnrollment("peer1", "peer1pw").
+			SetTLSEnrollment("peer1tls", "peer1tlspw").
+			Build()
+		Expect(err).To(BeNil())
+		Expect(*cryptoObject.Enrollment.Ca.EnrollID).To(Equal("peer1"))
+		Expect(*cryptoObject.Enrollment.Tlsca.EnrollID).To(Equal("peer1tls"))
+	})
+	It(`Builds the msp variant with the CA's root certificates`, func() {
+		cryptoObject, err := service.NewCryptoObjectBuilder().
+			FromCA(ca).
+			SetMspIdentity("ZWtleQ==", "ZWNlcnQ=", "dGxza2V5", "dGxzY2VydA==").
+			AddAdminCerts("YWRtaW4=").
+			Build()
+		Expect(err).To(BeNil())
+		Expect(cryptoObject.Enrollment).To(BeNil())
+		Expect(*cryptoObject.Msp.Component.Ecert).To(Equal("ZWNlcnQ="))
+		Expect(cryptoObject.Msp.Component.AdminCerts).To(Equal([]string{"YWRtaW4="}))
+		Expect(cryptoObject.Msp.Ca.RootCerts).To(Equal([]string{"cm9vdA=="}))
+		Expect(cryptoObject.Msp.Tlsca.RootCerts).To(Equal([]string{"dGxz"}))
+	})
+	It(`Requires exactly one variant`, func() {
+		_, err := service.NewCryptoObjectBuilder().FromCA(ca).Build()
+		Expect(err).ToNot(BeNil())
+		_, err = service.NewCryptoObjectBuilder().
+			FromCA(ca).
+			SetEnrollment("peer1", "peer1pw").
+			SetMspIdentity("ZWtleQ==", "ZWNlcnQ=", "dGxza2V5", "dGxzY2VydA==").
+			Build()
+		Expect(err).ToNot(BeNil())
+	})
+	It(`Requires the CA's names and TLS certificate for the enrollment variant`, func() {
+		ca.Msp.Ca.Name = nil
+		_, err := service.NewCryptoObjectBuilder().FromCA(ca).SetEnrollment("peer1", "peer1pw").Build()
+		Expect(err).ToNot(BeNil())
+	})
+	It(`Validates hand built crypto objects`, func() {
+		Expect((&blockchainv3.CryptoObject{}).Validate()).ToNot(Succeed())
+		Expect((&blockchainv3.CryptoObject{
+			Enrollment: &blockchainv3.CryptoObjectEnrollment{},
+			Msp:        &blockchainv3.CryptoObjectMsp{},
+		}).Validate()).ToNot(Succeed())
+		Expect((&blockchainv3.CryptoObject{Enrollment: &blockchainv3.CryptoObjectEnrollment{}}).Validate()).ToNot(Succeed())
+	})
+	It(`Rejects invalid crypto objects in CreateValidatedPeer and CreateValidatedOrderer before sending the request`, func() {
+		both, err := service.NewCryptoObjectBuilder().FromCA(ca).SetEnrollment("peer1", "peer1pw").Build()
+		Expect(err).To(BeNil())
+		both.Msp = testutil.CryptoObject().Msp
+		_, _, err = blockchainv3.CreateValidatedPeer(context.Background(), service, service.NewCreatePeerOptions("org1msp", "Peer1", both))
+		Expect(err).To(MatchError("a crypto object must set exactly one of enrollment or msp"))
+		_, _, err = blockchainv3.CreateValidatedOrderer(context.Background(), service, service.NewCreateOrdererOptions(
+			blockchainv3.CreateOrdererOptions_OrdererType_Raft, "osmsp", "OS", []blockchainv3.CryptoObject{*both}))
+		Expect(err).To(MatchError("crypto[0]: a crypto object must set exactly one of enrollment or msp"))
+	})
+})
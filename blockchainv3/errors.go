@@ -0,0 +1,225 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package blockchainv3
+
+import (
+	"fmt"
+	"github.com/IBM/go-sdk-core/v4/core"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ServiceError : An error returned by the IBP console for an unsuccessful operation.
+// Every error produced by a BlockchainV3 operation after the request reached the console is a *ServiceError, or one of
+// the more specific types below which wrap it. Use errors.As() to inspect them.
+type ServiceError struct {
+	// The operation that failed (e.g. "GetComponent").
+	Operation string
+
+	// The HTTP status code of the response.
+	StatusCode int
+
+	// The primary error message returned by the console.
+	Message string
+
+	// Every message returned by the console (the "msgs" field), if any.
+	Messages []string
+
+	// The reason returned by the console, if any.
+	Reason string
+
+	// The raw response, for callers that need headers or the undecoded body.
+	Response *core.DetailedResponse
+
+	err error
+}
+
+// Error returns the error message generated by the core SDK.
+func (e *ServiceError) Error() string {
+	return e.err.Error()
+}
+
+// Unwrap returns the error generated by the core SDK.
+func (e *ServiceError) Unwrap() error {
+	return e.err
+}
+
+// NotFoundError : The requested resource does not exist (HTTP 404).
+type NotFoundError struct {
+	*ServiceError
+}
+
+// Unwrap returns the underlying *ServiceError.
+func (e *NotFoundError) Unwrap() error {
+	return e.ServiceError
+}
+
+// ConflictError : The request conflicts with the current state of a resource (HTTP 409).
+type ConflictError struct {
+	*ServiceError
+}
+
+// Unwrap returns the underlying *ServiceError.
+func (e *ConflictError) Unwrap() error {
+	return e.ServiceError
+}
+
+// AuthorizationError : The caller is not authenticated or not allowed to perform the operation (HTTP 401 or 403).
+type AuthorizationError struct {
+	*ServiceError
+}
+
+// Unwrap returns the underlying *ServiceError.
+func (e *AuthorizationError) Unwrap() error {
+	return e.ServiceError
+}
+
+// RateLimitError : The console rejected the request because the caller sent too many requests (HTTP 429).
+type RateLimitError struct {
+	*ServiceError
+
+	// How long the console asked the caller to wait before retrying. Zero when the response did not say.
+	RetryAfter time.Duration
+}
+
+// Unwrap returns the underlying *ServiceError.
+func (e *RateLimitError) Unwrap() error {
+	return e.ServiceError
+}
+
+// ValidationError : The console rejected the request body or parameters (HTTP 400).
+type ValidationError struct {
+	*ServiceError
+
+	// The fields the console complained about, in the order they were reported.
+	Fields []FieldError
+}
+
+// Unwrap returns the underlying *ServiceError.
+func (e *ValidationError) Unwrap() error {
+	return e.ServiceError
+}
+
+// FieldError : A single validation failure reported by the console.
+type FieldError struct {
+	// The name of the offending field, or empty if the message did not name one.
+	Field string
+
+	// The console's message for this field.
+	Reason string
+}
+
+// fieldNameRegexp matches the quoted parameter name in console validation messages such as
+// "Expected parameter 'display_name' to exist.".
+var fieldNameRegexp = regexp.MustCompile(`'([^']+)'`)
+
+// newServiceError converts an error returned by core.BaseService.Request() into the matching error type.
+// Errors that happened before a response was received (e.g. connection failures) are returned unchanged.
+func newServiceError(operationID string, response *core.DetailedResponse, err error) error {
+	if err == nil || response == nil || response.StatusCode == 0 {
+		return err
+	}
+
+	serviceErr := &ServiceError{
+		Operation:  operationID,
+		StatusCode: response.StatusCode,
+		Message:    err.Error(),
+		Response:   response,
+		err:        err,
+	}
+	if body, ok := response.Result.(map[string]interface{}); ok {
+		decodeErrorBody(serviceErr, body)
+	} else if len(response.RawResult) > 0 {
+		serviceErr.Message = strings.TrimSpace(string(response.RawResult))
+	}
+
+	switch response.StatusCode {
+	case http.StatusBadRequest:
+		validationErr := &ValidationError{ServiceError: serviceErr}
+		for _, msg := range serviceErr.Messages {
+			fieldErr := FieldError{Reason: msg}
+			if match := fieldNameRegexp.FindStringSubmatch(msg); match != nil {
+				fieldErr.Field = match[1]
+			}
+			validationErr.Fields = append(validationErr.Fields, fieldErr)
+		}
+		return validationErr
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return &AuthorizationError{ServiceError: serviceErr}
+	case http.StatusNotFound:
+		return &NotFoundError{ServiceError: serviceErr}
+	case http.StatusConflict:
+		return &ConflictError{ServiceError: serviceErr}
+	case http.StatusTooManyRequests:
+		return &RateLimitError{
+			ServiceError: serviceErr,
+			RetryAfter:   parseRetryAfter(response.GetHeaders().Get("Retry-After")),
+		}
+	}
+	return serviceErr
+}
+
+// decodeErrorBody copies the fields of a console error payload onto serviceErr.
+// The console reports errors as {"statusCode": 404, "msg": "...", "reason": "..."} or, for validation failures,
+// {"statusCode": 400, "msgs": ["...", "..."]}.
+func decodeErrorBody(serviceErr *ServiceError, body map[string]interface{}) {
+	if msgs, ok := body["msgs"].([]interface{}); ok {
+		for _, msg := range msgs {
+			serviceErr.Messages = append(serviceErr.Messages, fmt.Sprint(msg))
+		}
+	}
+	if len(serviceErr.Messages) > 0 {
+		serviceErr.Message = serviceErr.Messages[0]
+	}
+	for _, key := range []string{"msg", "message", "error"} {
+		if msg, ok := body[key].(string); ok && msg != "" {
+			serviceErr.Message = msg
+			break
+		}
+	}
+	if len(serviceErr.Messages) == 0 {
+		serviceErr.Messages = []string{serviceErr.Message}
+	}
+	if reason, ok := body["reason"].(string); ok {
+		serviceErr.Reason = reason
+	}
+}
+
+// parseRetryAfter parses a Retry-After header given either in seconds or as an HTTP date.
+func parseRetryAfter(value string) time.Duration {
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		if wait := time.Until(date); wait > 0 {
+			return wait
+		}
+	}
+	return 0
+}
+
+// NewServiceError converts an error returned by core.BaseService.Request() into the matching error type, the same way
+// the BlockchainV3 operations do. It lets clients of other API versions surface the same error types.
+func NewServiceError(operationID string, response *core.DetailedResponse, err error) error {
+	return newServiceError(operationID, response, err)
+}
@@ -0,0 +1,131 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package blockchainv3_test
+
+import (
+	"errors"
+	"fmt"
+	"github.com/IBM-Blockchain/ibp-go-sdk/blockchainv3"
+	"github.com/IBM/go-sdk-core/v4/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"net/http"
+	"net/http/httptest"
+	"time"
+)
+
+var _ = Describe(`BlockchainV3 errors`, func() {
+	var testServer *httptest.Server
+	var blockchainService *blockchainv3.BlockchainV3
+
+	// serve makes the mock console answer every request with the given status, headers and body
+	serve := func(status int, headers map[string]string, body string) {
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			res.Header().Set("Content-type", "application/json")
+			for name, value := range headers {
+				res.Header().Set(name, value)
+			}
+			res.WriteHeader(status)
+			fmt.Fprint(res, body)
+		}))
+		var serviceErr error
+		blockchainService, serviceErr = blockchainv3.NewBlockchainV3(&blockchainv3.BlockchainV3Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	}
+
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Decodes a 404 into a *NotFoundError`, func() {
+		serve(404, nil, `{"statusCode": 404, "msg": "component id does not exist", "reason": "not found"}`)
+		result, response, err := blockchainService.GetComponent(blockchainService.NewGetComponentOptions("testString"))
+		Expect(result).To(BeNil())
+		Expect(response.StatusCode).To(Equal(404))
+
+		var notFound *blockchainv3.NotFoundError
+		Expect(errors.As(err, &notFound)).To(BeTrue())
+		Expect(notFound.Operation).To(Equal("GetComponent"))
+		Expect(notFound.StatusCode).To(Equal(404))
+		Expect(notFound.Message).To(Equal("component id does not exist"))
+		Expect(notFound.Reason).To(Equal("not found"))
+
+		var serviceErr *blockchainv3.ServiceError
+		Expect(errors.As(err, &serviceErr)).To(BeTrue())
+		Expect(serviceErr.Response).To(Equal(response))
+	})
+	It(`Decodes a 409 into a *ConflictError`, func() {
+		serve(409, nil, `{"statusCode": 409, "msg": "component already exists"}`)
+		_, _, err := blockchainService.DeleteComponent(blockchainService.NewDeleteComponentOptions("testString"))
+		var conflict *blockchainv3.ConflictError
+		Expect(errors.As(err, &conflict)).To(BeTrue())
+		Expect(conflict.Operation).To(Equal("DeleteComponent"))
+	})
+	It(`Decodes a 401 and a 403 into an *AuthorizationError`, func() {
+		for _, status := range []int{401, 403} {
+			serve(status, nil, `{"statusCode": 401, "msg": "unauthorized"}`)
+			_, _, err := blockchainService.GetSettings(blockchainService.NewGetSettingsOptions())
+			var authErr *blockchainv3.AuthorizationError
+			Expect(errors.As(err, &authErr)).To(BeTrue())
+			Expect(authErr.StatusCode).To(Equal(status))
+			testServer.Close()
+		}
+		serve(200, nil, `{}`)
+	})
+	It(`Decodes a 429 into a *RateLimitError with the Retry-After delay`, func() {
+		serve(429, map[string]string{"Retry-After": "7"}, `{"statusCode": 429, "msg": "too many requests"}`)
+		_, _, err := blockchainService.ListComponents(blockchainService.NewListComponentsOptions())
+		var rateLimit *blockchainv3.RateLimitError
+		Expect(errors.As(err, &rateLimit)).To(BeTrue())
+		Expect(rateLimit.RetryAfter).To(Equal(7 * time.Second))
+	})
+	It(`Decodes a 400 into a *ValidationError with field reasons`, func() {
+		serve(400, nil, `{"statusCode": 400, "msgs": ["Expected parameter 'display_name' to exist.", "something else is wrong"]}`)
+		_, _, err := blockchainService.ImportMsp(blockchainService.NewImportMspOptions("org1", "My Org", []string{"testString"}))
+		var validation *blockchainv3.ValidationError
+		Expect(errors.As(err, &validation)).To(BeTrue())
+		Expect(validation.Message).To(Equal("Expected parameter 'display_name' to exist."))
+		Expect(validation.Fields).To(Equal([]blockchainv3.FieldError{
+			{Field: "display_name", Reason: "Expected parameter 'display_name' to exist."},
+			{Reason: "something else is wrong"},
+		}))
+	})
+	It(`Returns a plain *ServiceError for other statuses and keeps the core error message`, func() {
+		serve(500, map[string]string{"Content-type": "text/plain"}, `internal failure`)
+		_, _, err := blockchainService.GetHealth(blockchainService.NewGetHealthOptions())
+		var serviceErr *blockchainv3.ServiceError
+		Expect(errors.As(err, &serviceErr)).To(BeTrue())
+		Expect(serviceErr.Error()).To(Equal("Internal Server Error"))
+		Expect(serviceErr.Message).To(Equal("internal failure"))
+
+		var notFound *blockchainv3.NotFoundError
+		Expect(errors.As(err, &notFound)).To(BeFalse())
+	})
+	It(`Leaves errors without a response untouched`, func() {
+		serve(200, nil, `{}`)
+		testServer.Close()
+		_, response, err := blockchainService.GetHealth(blockchainService.NewGetHealthOptions())
+		Expect(err).ToNot(BeNil())
+		Expect(response).To(BeNil())
+		var serviceErr *blockchainv3.ServiceError
+		Expect(errors.As(err, &serviceErr)).To(BeFalse())
+	})
+})
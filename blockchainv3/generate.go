@@ -0,0 +1,21 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package blockchainv3
+
+// The BlockchainV3API interface (api.go) and the generated methods of the blockchainv3fake Console are derived from the
+// methods of BlockchainV3. Regenerate them whenever the client is regenerated.
+//go:generate go run ./internal/genapi -fake ../blockchainv3fake
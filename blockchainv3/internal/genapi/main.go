@@ -0,0 +1,278 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Command genapi generates the BlockchainV3API interface from the methods of BlockchainV3, and the methods of the
+// blockchainv3fake Console that are not written by hand: the operations without a context, the option and model
+// constructors, and stubs for the operations the fake does not implement.
+//
+// It is run by go generate in the blockchainv3 directory:
+//
+//	go run ./internal/genapi -fake ../blockchainv3fake
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+const (
+	apiFile  = "api.go"
+	fakeFile = "fake_generated.go"
+)
+
+// excluded are the methods of BlockchainV3 that configure the client rather than call the console.
+var excluded = map[string]bool{
+	"Clone":                    true,
+	"SetServiceURL":            true,
+	"GetServiceURL":            true,
+	"SetDefaultHeaders":        true,
+	"SetEnableGzipCompression": true,
+	"GetEnableGzipCompression": true,
+	"EnableRetries":            true,
+	"DisableRetries":           true,
+	"NewCryptoObjectBuilder":   true,
+	"Use":                      true,
+	"EnableRateLimiting":       true,
+}
+
+type method struct {
+	name string
+	doc  string
+	decl *ast.FuncDecl
+}
+
+func main() {
+	dir := flag.String("dir", ".", "the blockchainv3 package directory")
+	fakeDir := flag.String("fake", "", "the blockchainv3fake package directory, if its methods should be generated too")
+	flag.Parse()
+
+	fset := token.NewFileSet()
+	methods, types := parseMethods(fset, *dir, "BlockchainV3", apiFile)
+	header := licenseHeader(*dir)
+
+	if err := write(filepath.Join(*dir, apiFile), generateAPI(fset, header, methods)); err != nil {
+		log.Fatal(err)
+	}
+	if *fakeDir != "" {
+		handWritten, _ := parseMethods(token.NewFileSet(), *fakeDir, "Console", fakeFile)
+		if err := write(filepath.Join(*fakeDir, fakeFile), generateFake(fset, header, methods, handWritten, types)); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
+
+// parseMethods returns the exported methods of the named type in the non-test files of dir, except in skipFile, in
+// source order. It also returns the names of the types declared in dir.
+func parseMethods(fset *token.FileSet, dir, typeName, skipFile string) ([]method, map[string]bool) {
+	pkgs, err := parser.ParseDir(fset, dir, func(info os.FileInfo) bool {
+		return !strings.HasSuffix(info.Name(), "_test.go") && info.Name() != skipFile
+	}, parser.ParseComments)
+	if err != nil {
+		log.Fatal(err)
+	}
+	var methods []method
+	types := map[string]bool{}
+	for _, pkg := range pkgs {
+		var files []string
+		for name := range pkg.Files {
+			files = append(files, name)
+		}
+		sort.Strings(files)
+		for _, name := range files {
+			for _, decl := range pkg.Files[name].Decls {
+				switch decl := decl.(type) {
+				case *ast.GenDecl:
+					for _, spec := range decl.Specs {
+						if spec, ok := spec.(*ast.TypeSpec); ok {
+							types[spec.Name.Name] = true
+						}
+					}
+				case *ast.FuncDecl:
+					if decl.Recv == nil || !decl.Name.IsExported() || excluded[decl.Name.Name] {
+						continue
+					}
+					star, ok := decl.Recv.List[0].Type.(*ast.StarExpr)
+					if !ok || star.X.(*ast.Ident).Name != typeName {
+						continue
+					}
+					methods = append(methods, method{name: decl.Name.Name, doc: summary(decl.Doc), decl: decl})
+				}
+			}
+		}
+	}
+	return methods, types
+}
+
+// summary returns the first line of a doc comment.
+func summary(doc *ast.CommentGroup) string {
+	if doc == nil {
+		return ""
+	}
+	return strings.SplitN(strings.TrimSpace(doc.Text()), "\n", 2)[0]
+}
+
+// licenseHeader returns the comment at the top of the generated client.
+func licenseHeader(dir string) string {
+	src, err := ioutil.ReadFile(filepath.Join(dir, "blockchain_v3.go"))
+	if err != nil {
+		log.Fatal(err)
+	}
+	end := bytes.Index(src, []byte("*/"))
+	return string(src[:end+2]) + "\n\n// Code generated by genapi. DO NOT EDIT.\n\n"
+}
+
+func generateAPI(fset *token.FileSet, header string, methods []method) []byte {
+	var buf bytes.Buffer
+	buf.WriteString(header)
+	buf.WriteString("package blockchainv3\n\nimport (\n\t\"context\"\n\t\"github.com/IBM/go-sdk-core/v4/core\"\n)\n\n")
+	buf.WriteString("// BlockchainV3API : The operations of BlockchainV3, e.g. to be replaced by a fake in tests.\n")
+	buf.WriteString("type BlockchainV3API interface {\n")
+	for i, m := range methods {
+		if i > 0 {
+			buf.WriteString("\n")
+		}
+		if m.doc != "" {
+			fmt.Fprintf(&buf, "\t// %s\n", m.doc)
+		}
+		fmt.Fprintf(&buf, "\t%s%s\n", m.name, signature(fset, m.decl.Type, nil))
+	}
+	buf.WriteString("}\n\nvar _ BlockchainV3API = (*BlockchainV3)(nil)\n")
+	return buf.Bytes()
+}
+
+func generateFake(fset *token.FileSet, header string, methods, handWritten []method, types map[string]bool) []byte {
+	defined := map[string]bool{}
+	for _, m := range handWritten {
+		defined[m.name] = true
+	}
+	withContext := map[string]bool{}
+	for _, m := range methods {
+		if strings.HasSuffix(m.name, "WithContext") {
+			withContext[strings.TrimSuffix(m.name, "WithContext")] = true
+		}
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(header)
+	buf.WriteString("package blockchainv3fake\n\nimport (\n\t\"context\"\n\t\"github.com/IBM-Blockchain/ibp-go-sdk/blockchainv3\"\n\t\"github.com/IBM/go-sdk-core/v4/core\"\n)\n\n")
+	buf.WriteString("// constructors builds options and models; BlockchainV3's constructors do not use the client.\n")
+	buf.WriteString("var constructors = new(blockchainv3.BlockchainV3)\n")
+	for _, m := range methods {
+		if defined[m.name] {
+			continue
+		}
+		sig := signature(fset, m.decl.Type, types)
+		params := paramNames(m.decl.Type)
+		buf.WriteString("\n")
+		if m.doc != "" {
+			fmt.Fprintf(&buf, "// %s\n", m.doc)
+		}
+		switch {
+		case m.decl.Recv.List[0].Names == nil:
+			// An option or model constructor.
+			fmt.Fprintf(&buf, "func (*Console) %s%s {\n\treturn constructors.%s(%s)\n}\n", m.name, sig, m.name, strings.Join(params, ", "))
+		case withContext[m.name]:
+			fmt.Fprintf(&buf, "func (console *Console) %s%s {\n\treturn console.%sWithContext(context.Background(), %s)\n}\n",
+				m.name, sig, m.name, strings.Join(params, ", "))
+		case strings.HasSuffix(m.name, "WithContext"):
+			fmt.Fprintf(&buf, "// Not implemented by the fake.\nfunc (console *Console) %s%s {\n\terr = notImplemented(%q)\n\treturn\n}\n",
+				m.name, sig, strings.TrimSuffix(m.name, "WithContext"))
+		default:
+			log.Fatalf("the fake does not implement %s", m.name)
+		}
+	}
+	return buf.Bytes()
+}
+
+// signature prints the parameters and results of a function type, qualifying the types declared in blockchainv3 when
+// types is not nil.
+func signature(fset *token.FileSet, fn *ast.FuncType, types map[string]bool) string {
+	if types != nil {
+		fn = qualify(fn, types).(*ast.FuncType)
+	}
+	var buf bytes.Buffer
+	if err := printer.Fprint(&buf, fset, fn); err != nil {
+		log.Fatal(err)
+	}
+	return strings.TrimPrefix(buf.String(), "func")
+}
+
+// qualify returns a copy of a type expression with the blockchainv3 types prefixed by the package name.
+func qualify(expr ast.Node, types map[string]bool) ast.Node {
+	switch expr := expr.(type) {
+	case *ast.Ident:
+		if types[expr.Name] {
+			return &ast.SelectorExpr{X: ast.NewIdent("blockchainv3"), Sel: ast.NewIdent(expr.Name)}
+		}
+		return expr
+	case *ast.StarExpr:
+		return &ast.StarExpr{X: qualify(expr.X, types).(ast.Expr)}
+	case *ast.ArrayType:
+		return &ast.ArrayType{Len: expr.Len, Elt: qualify(expr.Elt, types).(ast.Expr)}
+	case *ast.MapType:
+		return &ast.MapType{Key: qualify(expr.Key, types).(ast.Expr), Value: qualify(expr.Value, types).(ast.Expr)}
+	case *ast.Ellipsis:
+		return &ast.Ellipsis{Elt: qualify(expr.Elt, types).(ast.Expr)}
+	case *ast.FuncType:
+		return &ast.FuncType{Params: qualifyFields(expr.Params, types), Results: qualifyFields(expr.Results, types)}
+	}
+	return expr
+}
+
+func qualifyFields(fields *ast.FieldList, types map[string]bool) *ast.FieldList {
+	if fields == nil {
+		return nil
+	}
+	qualified := &ast.FieldList{}
+	for _, field := range fields.List {
+		qualified.List = append(qualified.List, &ast.Field{Names: field.Names, Type: qualify(field.Type, types).(ast.Expr)})
+	}
+	return qualified
+}
+
+func paramNames(fn *ast.FuncType) []string {
+	var names []string
+	for _, field := range fn.Params.List {
+		for _, name := range field.Names {
+			if _, variadic := field.Type.(*ast.Ellipsis); variadic {
+				names = append(names, name.Name+"...")
+			} else {
+				names = append(names, name.Name)
+			}
+		}
+	}
+	return names
+}
+
+func write(path string, src []byte) error {
+	formatted, err := format.Source(src)
+	if err != nil {
+		return fmt.Errorf("format %s: %v", path, err)
+	}
+	return ioutil.WriteFile(path, formatted, 0644)
+}
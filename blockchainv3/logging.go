@@ -0,0 +1,197 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package blockchainv3
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/IBM/go-sdk-core/v4/core"
+	"io/ioutil"
+	"time"
+)
+
+// Logger : A structured logger: each method takes a message and alternating keys and values. *slog.Logger implements
+// it; use ZapLogger() to adapt a *zap.SugaredLogger.
+type Logger interface {
+	Debug(msg string, keysAndValues ...interface{})
+	Info(msg string, keysAndValues ...interface{})
+	Warn(msg string, keysAndValues ...interface{})
+	Error(msg string, keysAndValues ...interface{})
+}
+
+// SugaredLogger : The structured logging methods of zap's *zap.SugaredLogger.
+type SugaredLogger interface {
+	Debugw(msg string, keysAndValues ...interface{})
+	Infow(msg string, keysAndValues ...interface{})
+	Warnw(msg string, keysAndValues ...interface{})
+	Errorw(msg string, keysAndValues ...interface{})
+}
+
+// ZapLogger adapts a *zap.SugaredLogger to the Logger interface.
+func ZapLogger(logger SugaredLogger) Logger {
+	return zapLogger{logger}
+}
+
+type zapLogger struct {
+	logger SugaredLogger
+}
+
+func (l zapLogger) Debug(msg string, keysAndValues ...interface{}) {
+	l.logger.Debugw(msg, keysAndValues...)
+}
+func (l zapLogger) Info(msg string, keysAndValues ...interface{}) {
+	l.logger.Infow(msg, keysAndValues...)
+}
+func (l zapLogger) Warn(msg string, keysAndValues ...interface{}) {
+	l.logger.Warnw(msg, keysAndValues...)
+}
+func (l zapLogger) Error(msg string, keysAndValues ...interface{}) {
+	l.logger.Errorw(msg, keysAndValues...)
+}
+
+// Redacted replaces the value of secret fields in logged bodies.
+const Redacted = "[REDACTED]"
+
+// secretFields are the JSON fields of the models that hold secrets: enrollment secrets, CA identity passwords,
+// private keys (including the CA and TLS keys of the CA configuration), HSM PINs, database connection strings and the
+// credentials of the Get Postman collection API.
+var secretFields = []string{"enroll_secret", "pass", "password", "ekey", "tls_key", "keyfile", "Pin", "datasource", "api_key", "token"}
+
+// LoggingOptions : The Logging options.
+type LoggingOptions struct {
+	// Also log the request and response bodies at debug level, with their secret fields redacted.
+	Bodies bool
+
+	// JSON field names to redact besides the known secret fields of the models.
+	SecretFields []string
+}
+
+// Logging returns a middleware that logs each operation with its ID, HTTP method, path, status, duration and retry
+// count: at info level when it succeeds and at error level when it fails. The query string is not logged, and the
+// known secret fields of the models are redacted from the bodies logged with LoggingOptions.Bodies.
+func Logging(logger Logger, options *LoggingOptions) Middleware {
+	opts := LoggingOptions{}
+	if options != nil {
+		opts = *options
+	}
+	secrets := map[string]bool{}
+	for _, field := range append(secretFields, opts.SecretFields...) {
+		secrets[field] = true
+	}
+
+	return func(next Handler) Handler {
+		return func(operation *Operation) (*core.DetailedResponse, error) {
+			keysAndValues := []interface{}{
+				"operation", operation.ID,
+				"method", operation.Request.Method,
+				"path", operation.Request.URL.Path,
+			}
+			if opts.Bodies {
+				if body := requestBody(operation); body != nil {
+					logger.Debug("console request", append(keysAndValues, "body", redactBody(body, secrets))...)
+				}
+			}
+
+			start := time.Now()
+			response, err := next(operation)
+			keysAndValues = append(keysAndValues, "duration", time.Since(start), "retries", operation.Retries)
+			if response != nil {
+				keysAndValues = append(keysAndValues, "status", response.StatusCode)
+				if opts.Bodies {
+					if body := responseBody(operation, response); body != nil {
+						logger.Debug("console response", append(keysAndValues, "body", redactBody(body, secrets))...)
+					}
+				}
+			}
+			if err != nil {
+				logger.Error("console operation failed", append(keysAndValues, "error", err.Error())...)
+			} else {
+				logger.Info("console operation", keysAndValues...)
+			}
+			return response, err
+		}
+	}
+}
+
+// requestBody returns a copy of the request body, or nil if there is none or it cannot be read again (e.g. because it
+// is compressed on the fly).
+func requestBody(operation *Operation) []byte {
+	if operation.Request.Body == nil || operation.Request.GetBody == nil {
+		return nil
+	}
+	body, err := operation.Request.GetBody()
+	if err != nil {
+		return nil
+	}
+	defer body.Close()
+	data, err := ioutil.ReadAll(body)
+	if err != nil || len(data) == 0 {
+		return nil
+	}
+	return data
+}
+
+// responseBody returns the decoded response body, or the error body of an unsuccessful response.
+func responseBody(operation *Operation, response *core.DetailedResponse) []byte {
+	var body interface{}
+	if raw, ok := operation.Result.(*map[string]json.RawMessage); ok && raw != nil && *raw != nil {
+		body = *raw
+	} else if errBody, ok := response.Result.(map[string]interface{}); ok {
+		body = errBody
+	} else if len(response.RawResult) > 0 {
+		return response.RawResult
+	} else {
+		return nil
+	}
+	data, err := json.Marshal(body)
+	if err != nil {
+		return nil
+	}
+	return data
+}
+
+// redactBody returns a JSON body with the values of the secret fields replaced. Bodies that are not JSON are not logged.
+func redactBody(data []byte, secrets map[string]bool) string {
+	var body interface{}
+	if err := json.Unmarshal(data, &body); err != nil {
+		return fmt.Sprintf("<%d bytes>", len(data))
+	}
+	redacted, err := json.Marshal(redact(body, secrets))
+	if err != nil {
+		return fmt.Sprintf("<%d bytes>", len(data))
+	}
+	return string(redacted)
+}
+
+// redact replaces the secret fields of a decoded JSON value.
+func redact(value interface{}, secrets map[string]bool) interface{} {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		for key, field := range value {
+			if secrets[key] && field != nil {
+				value[key] = Redacted
+			} else {
+				value[key] = redact(field, secrets)
+			}
+		}
+	case []interface{}:
+		for i, item := range value {
+			value[i] = redact(item, secrets)
+		}
+	}
+	return value
+}
@@ -0,0 +1,183 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package blockchainv3_test
+
+import (
+	"fmt"
+	"github.com/IBM-Blockchain/ibp-go-sdk/blockchainv3"
+	"github.com/IBM/go-sdk-core/v4/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"net/http"
+	"net/http/httptest"
+	"time"
+)
+
+// logEntry is a message recorded by recordingLogger.
+type logEntry struct {
+	level  string
+	msg    string
+	fields map[string]interface{}
+}
+
+// recordingLogger is a Logger (and a zap-style SugaredLogger) that records its entries.
+type recordingLogger struct {
+	entries []logEntry
+}
+
+func (l *recordingLogger) log(level string, msg string, keysAndValues []interface{}) {
+	fields := map[string]interface{}{}
+	for i := 0; i+1 < len(keysAndValues); i += 2 {
+		fields[fmt.Sprint(keysAndValues[i])] = keysAndValues[i+1]
+	}
+	l.entries = append(l.entries, logEntry{level: level, msg: msg, fields: fields})
+}
+
+func (l *recordingLogger) Debug(msg string, kv ...interface{})  { l.log("debug", msg, kv) }
+func (l *recordingLogger) Info(msg string, kv ...interface{})   { l.log("info", msg, kv) }
+func (l *recordingLogger) Warn(msg string, kv ...interface{})   { l.log("warn", msg, kv) }
+func (l *recordingLogger) Error(msg string, kv ...interface{})  { l.log("error", msg, kv) }
+func (l *recordingLogger) Debugw(msg string, kv ...interface{}) { l.log("debugw", msg, kv) }
+func (l *recordingLogger) Infow(msg string, kv ...interface{})  { l.log("infow", msg, kv) }
+func (l *recordingLogger) Warnw(msg string, kv ...interface{})  { l.log("warnw", msg, kv) }
+func (l *recordingLogger) Errorw(msg string, kv ...interface{}) { l.log("errorw", msg, kv) }
+
+var _ = Describe(`BlockchainV3 logging`, func() {
+	var testServer *httptest.Server
+	var blockchainService *blockchainv3.BlockchainV3
+	var logger *recordingLogger
+
+	BeforeEach(func() {
+		logger = &recordingLogger{}
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			res.Header().Set("Content-type", "application/json")
+			if req.URL.Path == "/ak/api/v3/components/missing" {
+				res.WriteHeader(404)
+				fmt.Fprint(res, `{"statusCode": 404, "msg": "component id does not exist"}`)
+				return
+			}
+			res.WriteHeader(200)
+			fmt.Fprint(res, `{"id": "peer1", "msp": {"component": {"ekey": "c2VjcmV0a2V5", "ecert": "ZWNlcnQ="}}}`)
+		}))
+		var serviceErr error
+		blockchainService, serviceErr = blockchainv3.NewBlockchainV3(&blockchainv3.BlockchainV3Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Logs the operation, path, status, duration and retries of a successful operation`, func() {
+		blockchainService.Use(blockchainv3.Logging(logger, nil))
+		options := blockchainService.NewGetComponentOptions("peer1")
+		options.SetCache("skip")
+		_, _, err := blockchainService.GetComponent(options)
+		Expect(err).To(BeNil())
+
+		Expect(logger.entries).To(HaveLen(1))
+		entry := logger.entries[0]
+		Expect(entry.level).To(Equal("info"))
+		Expect(entry.fields).To(HaveKeyWithValue("operation", "GetComponent"))
+		Expect(entry.fields).To(HaveKeyWithValue("method", "GET"))
+		Expect(entry.fields).To(HaveKeyWithValue("path", "/ak/api/v3/components/peer1"))
+		Expect(entry.fields).To(HaveKeyWithValue("status", 200))
+		Expect(entry.fields).To(HaveKeyWithValue("retries", 0))
+		Expect(entry.fields["duration"]).To(BeAssignableToTypeOf(time.Duration(0)))
+		Expect(entry.fields).ToNot(HaveKey("body"))
+	})
+	It(`Logs failed operations at error level with the error`, func() {
+		blockchainService.Use(blockchainv3.Logging(logger, nil))
+		_, _, err := blockchainService.GetComponent(blockchainService.NewGetComponentOptions("missing"))
+		Expect(err).ToNot(BeNil())
+
+		Expect(logger.entries).To(HaveLen(1))
+		entry := logger.entries[0]
+		Expect(entry.level).To(Equal("error"))
+		Expect(entry.fields).To(HaveKeyWithValue("status", 404))
+		Expect(entry.fields).To(HaveKeyWithValue("error", err.Error()))
+	})
+	It(`Redacts secrets from the logged bodies`, func() {
+		blockchainService.Use(blockchainv3.Logging(logger, &blockchainv3.LoggingOptions{Bodies: true}))
+		options := blockchainService.NewUpdatePeerOptions("peer1")
+		options.SetCrypto(&blockchainv3.UpdatePeerBodyCrypto{
+			Enrollment: &blockchainv3.UpdateEnrollmentCryptoField{
+				Ca: &blockchainv3.UpdateEnrollmentCryptoFieldCa{
+					EnrollID:     core.StringPtr("admin"),
+					EnrollSecret: core.StringPtr("adminpw"),
+				},
+			},
+		})
+		_, _, err := blockchainService.UpdatePeer(options)
+		Expect(err).To(BeNil())
+
+		Expect(logger.entries).To(HaveLen(3))
+		Expect(logger.entries[0].level).To(Equal("debug"))
+		Expect(logger.entries[0].fields["body"]).To(MatchJSON(
+			`{"crypto": {"enrollment": {"ca": {"enroll_id": "admin", "enroll_secret": "[REDACTED]"}}}}`))
+		Expect(logger.entries[1].level).To(Equal("debug"))
+		Expect(logger.entries[1].fields).To(HaveKeyWithValue("status", 200))
+		Expect(logger.entries[1].fields["body"]).To(MatchJSON(
+			`{"id": "peer1", "msp": {"component": {"ekey": "[REDACTED]", "ecert": "ZWNlcnQ="}}}`))
+		Expect(logger.entries[2].level).To(Equal("info"))
+	})
+	It(`Redacts the CA private keys from the logged bodies`, func() {
+		blockchainService.Use(blockchainv3.Logging(logger, &blockchainv3.LoggingOptions{Bodies: true}))
+		options := blockchainService.NewCreateCaOptions("CA", &blockchainv3.CreateCaBodyConfigOverride{
+			Ca: &blockchainv3.ConfigCACreate{
+				Ca:  &blockchainv3.ConfigCACa{Keyfile: core.StringPtr("Y2FrZXk="), Certfile: core.StringPtr("Y2FjZXJ0")},
+				Tls: &blockchainv3.ConfigCATls{Keyfile: core.StringPtr("dGxza2V5"), Certfile: core.StringPtr("dGxzY2VydA==")},
+				Registry: &blockchainv3.ConfigCARegistry{
+					Maxenrollments: core.Float64Ptr(-1),
+					Identities:     []blockchainv3.ConfigCARegistryIdentitiesItem{},
+				},
+			},
+		})
+		_, _, err := blockchainService.CreateCa(options)
+		Expect(err).To(BeNil())
+
+		Expect(logger.entries[0].level).To(Equal("debug"))
+		Expect(logger.entries[0].fields["body"]).To(MatchJSON(`{"display_name": "CA", "config_override": {"ca": {
+			"ca": {"keyfile": "[REDACTED]", "certfile": "Y2FjZXJ0"},
+			"tls": {"keyfile": "[REDACTED]", "certfile": "dGxzY2VydA=="},
+			"registry": {"maxenrollments": -1, "identities": []}
+		}}}`))
+	})
+	It(`Redacts additional secret fields`, func() {
+		blockchainService.Use(blockchainv3.Logging(logger, &blockchainv3.LoggingOptions{
+			Bodies:       true,
+			SecretFields: []string{"ecert"},
+		}))
+		_, _, err := blockchainService.GetComponent(blockchainService.NewGetComponentOptions("peer1"))
+		Expect(err).To(BeNil())
+
+		Expect(logger.entries).To(HaveLen(2))
+		Expect(logger.entries[0].fields["body"]).To(MatchJSON(
+			`{"id": "peer1", "msp": {"component": {"ekey": "[REDACTED]", "ecert": "[REDACTED]"}}}`))
+	})
+	It(`Adapts a zap SugaredLogger`, func() {
+		blockchainService.Use(blockchainv3.Logging(blockchainv3.ZapLogger(logger), nil))
+		_, _, err := blockchainService.GetComponent(blockchainService.NewGetComponentOptions("missing"))
+		Expect(err).ToNot(BeNil())
+
+		Expect(logger.entries).To(HaveLen(1))
+		Expect(logger.entries[0].level).To(Equal("errorw"))
+		Expect(logger.entries[0].fields).To(HaveKeyWithValue("operation", "GetComponent"))
+	})
+})
@@ -0,0 +1,93 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package blockchainv3
+
+import (
+	"context"
+	"github.com/IBM/go-sdk-core/v4/core"
+	"github.com/hashicorp/go-retryablehttp"
+	"net/http"
+	"sync/atomic"
+)
+
+// Operation : The request of a BlockchainV3 operation, as seen by middleware.
+type Operation struct {
+	// The operation ID, e.g. "CreatePeer".
+	ID string
+
+	// The request to send. Middleware may replace it before calling the next handler, e.g. with a copy that carries
+	// extra headers.
+	Request *http.Request
+
+	// The value the response body is decoded into, or nil if the operation has no result.
+	Result interface{}
+
+	// How many times the request was retried, set once the next handler returns. Only the retries enabled with
+	// EnableRetries() are counted.
+	Retries int
+}
+
+// Handler : Sends the request of an operation and decodes the response.
+// The error is either one of the *ServiceError types, or an error that happened before a response was received.
+type Handler func(operation *Operation) (*core.DetailedResponse, error)
+
+// Middleware : Wraps the handler of every BlockchainV3 operation, e.g. for audit logging, metrics, request signing,
+// header injection or policy checks. A middleware may act before and after calling next, or return an error without
+// calling it to reject the operation.
+type Middleware func(next Handler) Handler
+
+// Use appends middleware to the chain that wraps every operation. The first middleware registered is the outermost: it
+// sees the request first and the response last. Retries enabled with EnableRetries() happen inside the chain, so each
+// operation passes through it once.
+// A clone keeps the middleware registered before Clone() was called; middleware registered later only applies to the
+// client it was registered on.
+func (blockchain *BlockchainV3) Use(middleware ...Middleware) {
+	chain := make([]Middleware, 0, len(blockchain.middleware)+len(middleware))
+	blockchain.middleware = append(append(chain, blockchain.middleware...), middleware...)
+}
+
+// request sends the request built by an operation through the middleware chain.
+func (blockchain *BlockchainV3) request(operationID string, req *http.Request, result interface{}) (*core.DetailedResponse, error) {
+	handler := blockchain.send
+	for i := len(blockchain.middleware) - 1; i >= 0; i-- {
+		handler = blockchain.middleware[i](handler)
+	}
+	return handler(&Operation{ID: operationID, Request: req, Result: result})
+}
+
+// send is the innermost handler: it sends the request and decodes any unsuccessful response into a *ServiceError.
+func (blockchain *BlockchainV3) send(operation *Operation) (*core.DetailedResponse, error) {
+	var retries int32
+	req := operation.Request.WithContext(context.WithValue(operation.Request.Context(), retriesKey{}, &retries))
+	response, err := blockchain.Service.Request(req, operation.Result)
+	operation.Retries = int(atomic.LoadInt32(&retries))
+	return response, newServiceError(operation.ID, response, err)
+}
+
+// retriesKey is the context key of the retry counter of a request.
+type retriesKey struct{}
+
+// countRetries makes the retrying client set up by EnableRetries() update the retry counter of each request it sends.
+func countRetries(client *http.Client) {
+	if roundTripper, ok := client.Transport.(*retryablehttp.RoundTripper); ok {
+		roundTripper.Client.RequestLogHook = func(_ retryablehttp.Logger, req *http.Request, attempt int) {
+			if retries, ok := req.Context().Value(retriesKey{}).(*int32); ok {
+				atomic.StoreInt32(retries, int32(attempt))
+			}
+		}
+	}
+}
@@ -0,0 +1,159 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package blockchainv3_test
+
+import (
+	"errors"
+	"fmt"
+	"github.com/IBM-Blockchain/ibp-go-sdk/blockchainv3"
+	"github.com/IBM/go-sdk-core/v4/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"net/http"
+	"net/http/httptest"
+)
+
+var _ = Describe(`BlockchainV3 middleware`, func() {
+	var testServer *httptest.Server
+	var blockchainService *blockchainv3.BlockchainV3
+	var requests []*http.Request
+
+	BeforeEach(func() {
+		requests = nil
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			requests = append(requests, req)
+			res.Header().Set("Content-type", "application/json")
+			if req.URL.Path == "/ak/api/v3/components/busy" && len(requests) < 3 {
+				res.Header().Set("Retry-After", "0")
+				res.WriteHeader(429)
+				fmt.Fprint(res, `{"statusCode": 429, "msg": "too many requests"}`)
+				return
+			}
+			if req.URL.Path == "/ak/api/v3/components/missing" {
+				res.WriteHeader(404)
+				fmt.Fprint(res, `{"statusCode": 404, "msg": "component id does not exist"}`)
+				return
+			}
+			res.WriteHeader(200)
+			fmt.Fprint(res, `{"id": "testString", "type": "fabric-peer"}`)
+		}))
+		var serviceErr error
+		blockchainService, serviceErr = blockchainv3.NewBlockchainV3(&blockchainv3.BlockchainV3Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	// record returns a middleware that appends its name to calls before and after the next handler
+	record := func(name string, calls *[]string) blockchainv3.Middleware {
+		return func(next blockchainv3.Handler) blockchainv3.Handler {
+			return func(operation *blockchainv3.Operation) (*core.DetailedResponse, error) {
+				*calls = append(*calls, name+" "+operation.ID)
+				response, err := next(operation)
+				*calls = append(*calls, fmt.Sprintf("%s %d", name, response.StatusCode))
+				return response, err
+			}
+		}
+	}
+
+	It(`Runs the middleware in the order it was registered`, func() {
+		var calls []string
+		blockchainService.Use(record("outer", &calls), record("inner", &calls))
+		result, _, err := blockchainService.GetComponent(blockchainService.NewGetComponentOptions("testString"))
+		Expect(err).To(BeNil())
+		Expect(*result.ID).To(Equal("testString"))
+		Expect(calls).To(Equal([]string{"outer GetComponent", "inner GetComponent", "inner 200", "outer 200"}))
+	})
+	It(`Shows the typed errors to the middleware`, func() {
+		var seen error
+		blockchainService.Use(func(next blockchainv3.Handler) blockchainv3.Handler {
+			return func(operation *blockchainv3.Operation) (*core.DetailedResponse, error) {
+				response, err := next(operation)
+				seen = err
+				return response, err
+			}
+		})
+		_, _, err := blockchainService.GetComponent(blockchainService.NewGetComponentOptions("missing"))
+		var notFound *blockchainv3.NotFoundError
+		Expect(errors.As(seen, &notFound)).To(BeTrue())
+		Expect(err).To(Equal(seen))
+	})
+	It(`Lets the middleware change or reject the request`, func() {
+		blockchainService.Use(func(next blockchainv3.Handler) blockchainv3.Handler {
+			return func(operation *blockchainv3.Operation) (*core.DetailedResponse, error) {
+				operation.Request.Header.Set("X-Audit-ID", "42")
+				return next(operation)
+			}
+		})
+		_, _, err := blockchainService.GetComponent(blockchainService.NewGetComponentOptions("testString"))
+		Expect(err).To(BeNil())
+		Expect(requests[0].Header.Get("X-Audit-ID")).To(Equal("42"))
+
+		denied := errors.New("deleting components is not allowed")
+		blockchainService.Use(func(next blockchainv3.Handler) blockchainv3.Handler {
+			return func(operation *blockchainv3.Operation) (*core.DetailedResponse, error) {
+				if operation.ID == "DeleteComponent" {
+					return nil, denied
+				}
+				return next(operation)
+			}
+		})
+		result, response, err := blockchainService.DeleteComponent(blockchainService.NewDeleteComponentOptions("testString"))
+		Expect(err).To(Equal(denied))
+		Expect(result).To(BeNil())
+		Expect(response).To(BeNil())
+		Expect(requests).To(HaveLen(1))
+	})
+	It(`Counts the retries of the operation`, func() {
+		var retries []int
+		blockchainService.Use(func(next blockchainv3.Handler) blockchainv3.Handler {
+			return func(operation *blockchainv3.Operation) (*core.DetailedResponse, error) {
+				response, err := next(operation)
+				retries = append(retries, operation.Retries)
+				return response, err
+			}
+		})
+		blockchainService.EnableRetries(3, 0)
+		_, _, err := blockchainService.GetComponent(blockchainService.NewGetComponentOptions("busy"))
+		Expect(err).To(BeNil())
+		Expect(requests).To(HaveLen(3))
+		_, _, err = blockchainService.GetComponent(blockchainService.NewGetComponentOptions("testString"))
+		Expect(err).To(BeNil())
+		Expect(retries).To(Equal([]int{2, 0}))
+	})
+	It(`Keeps the middleware of clones separate`, func() {
+		var calls []string
+		blockchainService.Use(record("original", &calls))
+		clone := blockchainService.Clone()
+		clone.Use(record("clone", &calls))
+
+		_, _, err := blockchainService.GetComponent(blockchainService.NewGetComponentOptions("testString"))
+		Expect(err).To(BeNil())
+		Expect(calls).To(Equal([]string{"original GetComponent", "original 200"}))
+
+		calls = nil
+		_, _, err = clone.GetComponent(clone.NewGetComponentOptions("testString"))
+		Expect(err).To(BeNil())
+		Expect(calls).To(Equal([]string{"original GetComponent", "clone GetComponent", "clone 200", "original 200"}))
+	})
+})
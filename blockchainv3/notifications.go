@@ -0,0 +1,263 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package blockchainv3
+
+import (
+	"context"
+	"github.com/IBM/go-sdk-core/v4/core"
+	"time"
+)
+
+// Default values of NotificationFilter.
+const (
+	DefaultNotificationPollInterval = 5 * time.Second
+	DefaultNotificationPageSize     = 100
+)
+
+// NotificationStatusPending is the status of the notifications of operations that have not finished yet.
+const NotificationStatusPending = "pending"
+
+// NotificationFilter : The WatchNotifications options.
+// Zero values are replaced by the matching Default* constant.
+type NotificationFilter struct {
+	// Only watch the notifications of this component.
+	ComponentID string
+
+	// Only deliver the notifications of these types (e.g. "notification", "webhook_tx") and with these statuses (e.g.
+	// "success", "error"). All of them by default.
+	Types    []string
+	Statuses []string
+
+	// Ignore the notifications created before this time. All the notifications the console holds are delivered by
+	// default.
+	Since time.Time
+
+	// Archive the notifications once they are delivered, unless they are still pending.
+	AutoArchive bool
+
+	// The delay between two polls.
+	PollInterval time.Duration
+
+	// How many notifications to request per page.
+	PageSize int
+}
+
+// NotificationEvent : A notification delivered by WatchNotifications, or a failed poll.
+type NotificationEvent struct {
+	Notification *NotificationData
+
+	// Whether the notification was delivered before with another status or message, e.g. when a pending operation
+	// finished.
+	Updated bool
+
+	// Why a poll, or the archiving of the delivered notifications, failed. The watch goes on.
+	Err error
+}
+
+// WatchNotifications : Watch the console notifications
+// Poll the console notifications and deliver the new ones, and the ones whose status or message changed, on the
+// returned channel, oldest first. Notifications are de-duplicated by ID, and each poll only reads the pages newer than
+// the newest notification delivered so far, or than the oldest pending one. The channel is closed when the context is
+// done.
+func (blockchain *BlockchainV3) WatchNotifications(ctx context.Context, filter *NotificationFilter) <-chan *NotificationEvent {
+	return PollNotifications(ctx, blockchain, filter)
+}
+
+// PollNotifications implements WatchNotifications for any BlockchainV3API, e.g. a fake.
+func PollNotifications(ctx context.Context, service BlockchainV3API, filter *NotificationFilter) <-chan *NotificationEvent {
+	watch := &notificationWatch{service: service, seen: map[string]*seenNotification{}}
+	if filter != nil {
+		watch.filter = *filter
+	}
+	if watch.filter.PollInterval <= 0 {
+		watch.filter.PollInterval = DefaultNotificationPollInterval
+	}
+	if watch.filter.PageSize <= 0 {
+		watch.filter.PageSize = DefaultNotificationPageSize
+	}
+	if !watch.filter.Since.IsZero() {
+		watch.since = float64(watch.filter.Since.UnixNano() / int64(time.Millisecond))
+	}
+
+	events := make(chan *NotificationEvent)
+	go func() {
+		defer close(events)
+		timer := time.NewTimer(0)
+		defer timer.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-timer.C:
+			}
+			if !watch.poll(ctx, events) {
+				return
+			}
+			timer.Reset(watch.filter.PollInterval)
+		}
+	}()
+	return events
+}
+
+// notificationWatch is the state of a WatchNotifications call.
+type notificationWatch struct {
+	service BlockchainV3API
+	filter  NotificationFilter
+
+	// The creation time of the oldest notification to consider, and of the newest one seen, in milliseconds.
+	since, highWater float64
+
+	// The notifications seen at or after the boundary, by ID.
+	seen map[string]*seenNotification
+}
+
+type seenNotification struct {
+	ts        float64
+	state     string
+	pending   bool
+	delivered bool
+}
+
+// boundary returns the creation time of the oldest notification the next poll must read: the newest one seen, or the
+// oldest pending one if it is older.
+func (watch *notificationWatch) boundary() float64 {
+	boundary := watch.highWater
+	if boundary == 0 {
+		boundary = watch.since
+	}
+	for _, seen := range watch.seen {
+		if seen.pending && seen.ts < boundary {
+			boundary = seen.ts
+		}
+	}
+	return boundary
+}
+
+// poll delivers the new and changed notifications. It returns false if the context is done.
+func (watch *notificationWatch) poll(ctx context.Context, events chan<- *NotificationEvent) bool {
+	boundary := watch.boundary()
+	notifications, err := watch.list(ctx, boundary)
+	if err != nil {
+		return send(ctx, events, &NotificationEvent{Err: err})
+	}
+
+	var archive []string
+	for i := len(notifications) - 1; i >= 0; i-- {
+		notification := &notifications[i]
+		id := core.StringNilMapper(notification.ID)
+		ts := 0.0
+		if notification.TsDisplay != nil {
+			ts = *notification.TsDisplay
+		}
+		previous, ok := watch.seen[id]
+		if id == "" || (!ok && ts < boundary) || ts < watch.since {
+			continue
+		}
+		status := core.StringNilMapper(notification.Status)
+		state := status + "\n" + core.StringNilMapper(notification.Message)
+		if ok && previous.state == state {
+			continue
+		}
+		seen := &seenNotification{ts: ts, state: state, pending: status == NotificationStatusPending}
+		seen.delivered = ok && previous.delivered
+		watch.seen[id] = seen
+		if ts > watch.highWater {
+			watch.highWater = ts
+		}
+		if !watch.matches(notification) {
+			continue
+		}
+		if !send(ctx, events, &NotificationEvent{Notification: notification, Updated: seen.delivered}) {
+			return false
+		}
+		seen.delivered = true
+		if watch.filter.AutoArchive && !seen.pending {
+			archive = append(archive, id)
+		}
+	}
+
+	if len(archive) > 0 {
+		_, _, err := watch.service.ArchiveNotificationsWithContext(ctx, watch.service.NewArchiveNotificationsOptions(archive))
+		if err != nil && !send(ctx, events, &NotificationEvent{Err: err}) {
+			return false
+		}
+	}
+
+	// The notifications older than the boundary are never read again.
+	boundary = watch.boundary()
+	for id, seen := range watch.seen {
+		if seen.ts < boundary {
+			delete(watch.seen, id)
+		}
+	}
+	return true
+}
+
+// list returns the notifications, newest first, down to the first page that reaches back before the boundary. It
+// reads every page when the boundary is zero.
+func (watch *notificationWatch) list(ctx context.Context, boundary float64) ([]NotificationData, error) {
+	var notifications []NotificationData
+	for skip := 0; ; skip += watch.filter.PageSize {
+		options := watch.service.NewListNotificationsOptions()
+		options.SetLimit(float64(watch.filter.PageSize))
+		options.SetSkip(float64(skip))
+		if watch.filter.ComponentID != "" {
+			options.SetComponentID(watch.filter.ComponentID)
+		}
+		page, _, err := watch.service.ListNotificationsWithContext(ctx, options)
+		if err != nil {
+			return nil, err
+		}
+		notifications = append(notifications, page.Notifications...)
+		if len(page.Notifications) < watch.filter.PageSize {
+			return notifications, nil
+		}
+		oldest := page.Notifications[len(page.Notifications)-1].TsDisplay
+		if boundary > 0 && oldest != nil && *oldest < boundary {
+			return notifications, nil
+		}
+	}
+}
+
+// matches returns true if the notification passes the type and status filters.
+func (watch *notificationWatch) matches(notification *NotificationData) bool {
+	return contains(watch.filter.Types, core.StringNilMapper(notification.Type)) &&
+		contains(watch.filter.Statuses, core.StringNilMapper(notification.Status))
+}
+
+// contains returns true if values is empty or contains value.
+func contains(values []string, value string) bool {
+	if len(values) == 0 {
+		return true
+	}
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// send delivers an event, unless the context is done first.
+func send(ctx context.Context, events chan<- *NotificationEvent, event *NotificationEvent) bool {
+	select {
+	case events <- event:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
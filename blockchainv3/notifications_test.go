@@ -0,0 +1,234 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package blockchainv3_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/IBM-Blockchain/ibp-go-sdk/blockchainv3"
+	"github.com/IBM/go-sdk-core/v4/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+)
+
+var _ = Describe(`WatchNotifications`, func() {
+	var consoleServer *httptest.Server
+	var mu sync.Mutex
+	var notifications []map[string]interface{}
+	var skips []int
+	var archived []string
+	var failing bool
+
+	add := func(id, status string, ts float64) {
+		mu.Lock()
+		defer mu.Unlock()
+		notifications = append(notifications, map[string]interface{}{
+			"id": id, "type": "notification", "status": status, "message": id + " " + status, "ts_display": ts,
+		})
+	}
+	setStatus := func(id, status string) {
+		mu.Lock()
+		defer mu.Unlock()
+		for _, notification := range notifications {
+			if notification["id"] == id {
+				notification["status"] = status
+			}
+		}
+	}
+	requestedSkips := func() []int {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]int(nil), skips...)
+	}
+
+	newService := func() *blockchainv3.BlockchainV3 {
+		blockchainService, serviceErr := blockchainv3.NewBlockchainV3(&blockchainv3.BlockchainV3Options{
+			URL:           consoleServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		blockchainService.DisableRetries()
+		return blockchainService
+	}
+
+	next := func(events <-chan *blockchainv3.NotificationEvent) *blockchainv3.NotificationEvent {
+		var event *blockchainv3.NotificationEvent
+		Eventually(events, time.Second).Should(Receive(&event))
+		return event
+	}
+
+	BeforeEach(func() {
+		notifications, skips, archived, failing = nil, nil, nil, false
+		consoleServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			mu.Lock()
+			defer mu.Unlock()
+			res.Header().Set("Content-type", "application/json")
+			if failing {
+				res.WriteHeader(500)
+				res.Write([]byte(`{"statusCode": 500, "msg": "database unavailable"}`))
+				return
+			}
+			switch req.URL.Path {
+			case "/ak/api/v3/notifications":
+				limit, _ := strconv.Atoi(req.URL.Query().Get("limit"))
+				skip, _ := strconv.Atoi(req.URL.Query().Get("skip"))
+				skips = append(skips, skip)
+				sorted := append([]map[string]interface{}(nil), notifications...)
+				sort.SliceStable(sorted, func(i, j int) bool {
+					return sorted[i]["ts_display"].(float64) > sorted[j]["ts_display"].(float64)
+				})
+				page := []map[string]interface{}{}
+				for i := skip; i < len(sorted) && i < skip+limit; i++ {
+					page = append(page, sorted[i])
+				}
+				json.NewEncoder(res).Encode(map[string]interface{}{"total": len(sorted), "returning": len(page), "notifications": page})
+			case "/ak/api/v3/notifications/bulk":
+				var body struct {
+					NotificationIds []string `json:"notification_ids"`
+				}
+				Expect(json.NewDecoder(req.Body).Decode(&body)).To(Succeed())
+				archived = append(archived, body.NotificationIds...)
+				archive := map[string]bool{}
+				for _, id := range body.NotificationIds {
+					archive[id] = true
+				}
+				var kept []map[string]interface{}
+				for _, notification := range notifications {
+					if !archive[notification["id"].(string)] {
+						kept = append(kept, notification)
+					}
+				}
+				notifications = kept
+				res.Write([]byte(`{"message": "ok", "details": "archived"}`))
+			default:
+				res.WriteHeader(404)
+			}
+		}))
+	})
+	AfterEach(func() {
+		consoleServer.Close()
+	})
+
+	It(`Delivers new and changed notifications once, oldest first`, func() {
+		add("a", "success", 1000)
+		add("b", "pending", 2000)
+		add("c", "success", 3000)
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		events := newService().WatchNotifications(ctx, &blockchainv3.NotificationFilter{
+			PollInterval: 10 * time.Millisecond,
+			PageSize:     2,
+		})
+
+		Expect(*next(events).Notification.ID).To(Equal("a"))
+		Expect(*next(events).Notification.ID).To(Equal("b"))
+		Expect(*next(events).Notification.ID).To(Equal("c"))
+		Consistently(events, 50*time.Millisecond).ShouldNot(Receive())
+
+		add("d", "error", 4000)
+		event := next(events)
+		Expect(*event.Notification.ID).To(Equal("d"))
+		Expect(event.Updated).To(BeFalse())
+
+		setStatus("b", "success")
+		event = next(events)
+		Expect(*event.Notification.ID).To(Equal("b"))
+		Expect(*event.Notification.Status).To(Equal("success"))
+		Expect(event.Updated).To(BeTrue())
+		Consistently(events, 50*time.Millisecond).ShouldNot(Receive())
+
+		// Once nothing is pending, a poll only reads the first page.
+		mu.Lock()
+		skips = nil
+		mu.Unlock()
+		Eventually(requestedSkips).Should(ContainElement(0))
+		Consistently(requestedSkips, 50*time.Millisecond).ShouldNot(ContainElement(2))
+
+		cancel()
+		Eventually(events).Should(BeClosed())
+	})
+	It(`Filters notifications and ignores the old ones`, func() {
+		add("old", "success", 1000)
+		add("pending", "pending", float64(time.Now().UnixNano()/int64(time.Millisecond)))
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		events := newService().WatchNotifications(ctx, &blockchainv3.NotificationFilter{
+			Statuses:     []string{"success", "error"},
+			Types:        []string{"notification"},
+			Since:        time.Now().Add(-time.Minute),
+			PollInterval: 10 * time.Millisecond,
+		})
+		Consistently(events, 50*time.Millisecond).ShouldNot(Receive())
+
+		setStatus("pending", "success")
+		event := next(events)
+		Expect(*event.Notification.ID).To(Equal("pending"))
+		Expect(event.Updated).To(BeFalse())
+	})
+	It(`Archives the delivered notifications that are not pending`, func() {
+		add("a", "success", 1000)
+		add("b", "pending", 2000)
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		events := newService().WatchNotifications(ctx, &blockchainv3.NotificationFilter{
+			AutoArchive:  true,
+			PollInterval: 10 * time.Millisecond,
+		})
+		Expect(*next(events).Notification.ID).To(Equal("a"))
+		Expect(*next(events).Notification.ID).To(Equal("b"))
+		Eventually(func() []string {
+			mu.Lock()
+			defer mu.Unlock()
+			return append([]string(nil), archived...)
+		}).Should(Equal([]string{"a"}))
+
+		setStatus("b", "error")
+		Expect(*next(events).Notification.ID).To(Equal("b"))
+		Eventually(func() int {
+			mu.Lock()
+			defer mu.Unlock()
+			return len(notifications)
+		}).Should(Equal(0))
+	})
+	It(`Reports failed polls and goes on`, func() {
+		failing = true
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		events := newService().WatchNotifications(ctx, &blockchainv3.NotificationFilter{PollInterval: 10 * time.Millisecond})
+		event := next(events)
+		Expect(event.Notification).To(BeNil())
+		var serviceErr *blockchainv3.ServiceError
+		Expect(errors.As(event.Err, &serviceErr)).To(BeTrue())
+		Expect(serviceErr.Message).To(Equal("database unavailable"))
+
+		mu.Lock()
+		failing = false
+		mu.Unlock()
+		add("a", "success", 1000)
+		for event = next(events); event.Err != nil; event = next(events) {
+		}
+		Expect(*event.Notification.ID).To(Equal("a"))
+	})
+})
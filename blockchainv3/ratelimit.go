@@ -0,0 +1,258 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package blockchainv3
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/IBM/go-sdk-core/v4/core"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// DefaultRateLimitRetries is how many times the rate limiter resends an operation the console rejected with HTTP 429,
+// unless RateLimitOptions.MaxRetries says otherwise.
+const DefaultRateLimitRetries = 3
+
+// RateLimitOptions : The rate limiter options.
+type RateLimitOptions struct {
+	// How many requests may be sent per minute. If zero, EnableRateLimiting() reads the limit from the console settings.
+	RequestsPerMinute float64
+
+	// How many requests may be sent at once after the client was idle. Defaults to 1, which spaces requests evenly.
+	Burst int
+
+	// How many times an operation rejected with HTTP 429 is resent after waiting for the time given by the Retry-After
+	// header. Zero means DefaultRateLimitRetries and a negative value disables resending.
+	MaxRetries int
+}
+
+// RateLimiter : A token bucket shared by every operation of the clients it is registered on, including their clones.
+// When the console rejects an operation with HTTP 429, the limiter holds back every operation until the time given by
+// the Retry-After header has passed.
+type RateLimiter struct {
+	mu         sync.Mutex
+	perSecond  float64
+	burst      float64
+	tokens     float64
+	last       time.Time
+	maxRetries int
+}
+
+// NewRateLimiter : Instantiate a RateLimiter. Register it with Use(limiter.Middleware()), or use EnableRateLimiting().
+func NewRateLimiter(options *RateLimitOptions) (*RateLimiter, error) {
+	if options == nil || options.RequestsPerMinute <= 0 {
+		return nil, fmt.Errorf("the requests per minute must be positive")
+	}
+	limiter := &RateLimiter{
+		burst:      float64(options.Burst),
+		maxRetries: options.MaxRetries,
+		last:       time.Now(),
+	}
+	if limiter.burst < 1 {
+		limiter.burst = 1
+	}
+	if limiter.maxRetries == 0 {
+		limiter.maxRetries = DefaultRateLimitRetries
+	}
+	limiter.tokens = limiter.burst
+	limiter.SetLimit(options.RequestsPerMinute)
+	return limiter, nil
+}
+
+// EnableRateLimiting registers a rate limiter with Use(). If options.RequestsPerMinute is zero, the limit is read from
+// the console settings (MAX_REQ_PER_MIN_AK, or MAX_REQ_PER_MIN if the console does not report it).
+// Clones made afterwards share the limiter, and so the budget, of this client.
+func (blockchain *BlockchainV3) EnableRateLimiting(options *RateLimitOptions) (*RateLimiter, error) {
+	opts := RateLimitOptions{}
+	if options != nil {
+		opts = *options
+	}
+	if opts.RequestsPerMinute <= 0 {
+		perMinute, err := settingsRateLimit(context.Background(), blockchain)
+		if err != nil {
+			return nil, err
+		}
+		opts.RequestsPerMinute = perMinute
+	}
+	limiter, err := NewRateLimiter(&opts)
+	if err != nil {
+		return nil, err
+	}
+	blockchain.Use(limiter.Middleware())
+	return limiter, nil
+}
+
+// Refresh sets the limit to the one currently configured in the console settings.
+func (limiter *RateLimiter) Refresh(ctx context.Context, blockchain *BlockchainV3) error {
+	perMinute, err := settingsRateLimit(ctx, blockchain)
+	if err != nil {
+		return err
+	}
+	limiter.SetLimit(perMinute)
+	return nil
+}
+
+// settingsRateLimit returns the requests per minute the console allows API key clients.
+// GetPublicSettingsResponse has no field for MAX_REQ_PER_MIN_AK, so the limit is read from the raw settings.
+func settingsRateLimit(ctx context.Context, blockchain *BlockchainV3) (float64, error) {
+	var settings map[string]json.RawMessage
+	clone := blockchain.Clone()
+	clone.Use(func(next Handler) Handler {
+		return func(operation *Operation) (*core.DetailedResponse, error) {
+			response, err := next(operation)
+			if raw, ok := operation.Result.(*map[string]json.RawMessage); ok && raw != nil {
+				settings = *raw
+			}
+			return response, err
+		}
+	})
+	if _, _, err := clone.GetSettingsWithContext(ctx, &GetSettingsOptions{}); err != nil {
+		return 0, err
+	}
+	for _, key := range []string{"MAX_REQ_PER_MIN_AK", "MAX_REQ_PER_MIN"} {
+		var perMinute float64
+		if value, ok := settings[key]; ok && json.Unmarshal(value, &perMinute) == nil && perMinute > 0 {
+			return perMinute, nil
+		}
+	}
+	return 0, fmt.Errorf("the console settings do not include MAX_REQ_PER_MIN_AK or MAX_REQ_PER_MIN")
+}
+
+// SetLimit changes how many requests may be sent per minute. Non-positive values are ignored.
+func (limiter *RateLimiter) SetLimit(requestsPerMinute float64) {
+	if requestsPerMinute <= 0 {
+		return
+	}
+	limiter.mu.Lock()
+	defer limiter.mu.Unlock()
+	limiter.refill(time.Now())
+	limiter.perSecond = requestsPerMinute / 60
+}
+
+// Limit returns how many requests may be sent per minute.
+func (limiter *RateLimiter) Limit() float64 {
+	limiter.mu.Lock()
+	defer limiter.mu.Unlock()
+	return limiter.perSecond * 60
+}
+
+// Wait blocks until a request may be sent, or until the context is done.
+func (limiter *RateLimiter) Wait(ctx context.Context) error {
+	limiter.mu.Lock()
+	now := time.Now()
+	limiter.refill(now)
+	limiter.tokens--
+	delay := limiter.last.Sub(now)
+	if limiter.tokens < 0 {
+		delay += time.Duration(-limiter.tokens / limiter.perSecond * float64(time.Second))
+	}
+	limiter.mu.Unlock()
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		limiter.mu.Lock()
+		limiter.tokens++
+		limiter.mu.Unlock()
+		return ctx.Err()
+	}
+}
+
+// Pause holds back every request for the given duration, e.g. the Retry-After time of a rejected request.
+func (limiter *RateLimiter) Pause(duration time.Duration) {
+	limiter.mu.Lock()
+	defer limiter.mu.Unlock()
+	now := time.Now()
+	limiter.refill(now)
+	if until := now.Add(duration); until.After(limiter.last) {
+		limiter.last = until
+		if limiter.tokens > 1 {
+			limiter.tokens = 1
+		}
+	}
+}
+
+// refill adds the tokens earned since the last refill. Nothing is earned while the limiter is paused.
+func (limiter *RateLimiter) refill(now time.Time) {
+	if !now.After(limiter.last) {
+		return
+	}
+	limiter.tokens += now.Sub(limiter.last).Seconds() * limiter.perSecond
+	if limiter.tokens > limiter.burst {
+		limiter.tokens = limiter.burst
+	}
+	limiter.last = now
+}
+
+// Middleware returns the middleware that applies the limiter to every operation.
+func (limiter *RateLimiter) Middleware() Middleware {
+	return func(next Handler) Handler {
+		return func(operation *Operation) (*core.DetailedResponse, error) {
+			for attempt := 0; ; attempt++ {
+				if err := limiter.Wait(operation.Request.Context()); err != nil {
+					return nil, err
+				}
+				response, err := next(operation)
+				var rateLimitErr *RateLimitError
+				if !errors.As(err, &rateLimitErr) {
+					return response, err
+				}
+
+				wait := rateLimitErr.RetryAfter
+				if wait <= 0 {
+					wait = time.Duration(float64(time.Second) / (limiter.Limit() / 60))
+				}
+				limiter.Pause(wait)
+				if attempt >= limiter.maxRetries {
+					return response, err
+				}
+				req, rewindErr := rewind(operation.Request)
+				if rewindErr != nil {
+					return response, err
+				}
+				operation.Request = req
+			}
+		}
+	}
+}
+
+// rewind returns a copy of a sent request that can be sent again.
+func rewind(req *http.Request) (*http.Request, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return req.Clone(req.Context()), nil
+	}
+	if req.GetBody == nil {
+		return nil, fmt.Errorf("the request body cannot be sent again")
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, err
+	}
+	clone := req.Clone(req.Context())
+	clone.Body = body
+	return clone, nil
+}
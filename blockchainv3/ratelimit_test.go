@@ -0,0 +1,146 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package blockchainv3_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/IBM-Blockchain/ibp-go-sdk/blockchainv3"
+	"github.com/IBM/go-sdk-core/v4/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+)
+
+var _ = Describe(`BlockchainV3 rate limiting`, func() {
+	var testServer *httptest.Server
+	var blockchainService *blockchainv3.BlockchainV3
+	var mu sync.Mutex
+	var times []time.Time
+	var bodies []string
+	var rejections int
+
+	BeforeEach(func() {
+		times, bodies, rejections = nil, nil, 0
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			mu.Lock()
+			defer mu.Unlock()
+			body, _ := ioutil.ReadAll(req.Body)
+			times = append(times, time.Now())
+			bodies = append(bodies, string(body))
+			res.Header().Set("Content-type", "application/json")
+			switch {
+			case req.URL.Path == "/ak/api/v3/settings":
+				fmt.Fprint(res, `{"MAX_REQ_PER_MIN": 25, "MAX_REQ_PER_MIN_AK": 1200}`)
+			case strings.HasSuffix(req.URL.Path, "/busy") && rejections < 1:
+				rejections++
+				res.Header().Set("Retry-After", "1")
+				res.WriteHeader(429)
+				fmt.Fprint(res, `{"statusCode": 429, "msg": "too many requests"}`)
+			case req.URL.Path == "/ak/api/v3/components/always_busy":
+				res.Header().Set("Retry-After", "0")
+				res.WriteHeader(429)
+				fmt.Fprint(res, `{"statusCode": 429, "msg": "too many requests"}`)
+			default:
+				fmt.Fprint(res, `{"id": "testString"}`)
+			}
+		}))
+		var serviceErr error
+		blockchainService, serviceErr = blockchainv3.NewBlockchainV3(&blockchainv3.BlockchainV3Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Reads the limit from the console settings`, func() {
+		limiter, err := blockchainService.EnableRateLimiting(nil)
+		Expect(err).To(BeNil())
+		Expect(limiter.Limit()).To(Equal(1200.0))
+	})
+	It(`Falls back to MAX_REQ_PER_MIN`, func() {
+		testServer.Config.Handler = http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			res.Header().Set("Content-type", "application/json")
+			fmt.Fprint(res, `{"MAX_REQ_PER_MIN": 25}`)
+		})
+		limiter, err := blockchainService.EnableRateLimiting(nil)
+		Expect(err).To(BeNil())
+		Expect(limiter.Limit()).To(Equal(25.0))
+	})
+	It(`Rejects a missing limit`, func() {
+		_, err := blockchainv3.NewRateLimiter(&blockchainv3.RateLimitOptions{})
+		Expect(err).ToNot(BeNil())
+	})
+	It(`Spaces requests and shares the budget with clones`, func() {
+		_, err := blockchainService.EnableRateLimiting(&blockchainv3.RateLimitOptions{RequestsPerMinute: 600})
+		Expect(err).To(BeNil())
+		clone := blockchainService.Clone()
+
+		var wg sync.WaitGroup
+		for _, service := range []*blockchainv3.BlockchainV3{blockchainService, clone, blockchainService, clone} {
+			wg.Add(1)
+			go func(service *blockchainv3.BlockchainV3) {
+				defer wg.Done()
+				defer GinkgoRecover()
+				_, _, err := service.GetComponent(service.NewGetComponentOptions("peer1"))
+				Expect(err).To(BeNil())
+			}(service)
+		}
+		wg.Wait()
+		Expect(times).To(HaveLen(4))
+		Expect(times[3].Sub(times[0])).To(BeNumerically(">=", 280*time.Millisecond))
+	})
+	It(`Waits for Retry-After and resends the operation`, func() {
+		_, err := blockchainService.EnableRateLimiting(&blockchainv3.RateLimitOptions{RequestsPerMinute: 6000, Burst: 10})
+		Expect(err).To(BeNil())
+		options := blockchainService.NewUpdatePeerOptions("busy")
+		options.SetReplicas(2)
+		_, response, err := blockchainService.UpdatePeer(options)
+		Expect(err).To(BeNil())
+		Expect(response.StatusCode).To(Equal(200))
+
+		Expect(times).To(HaveLen(2))
+		Expect(times[1].Sub(times[0])).To(BeNumerically(">=", 900*time.Millisecond))
+		Expect(bodies[1]).To(MatchJSON(`{"replicas": 2}`))
+		Expect(bodies[1]).To(Equal(bodies[0]))
+	})
+	It(`Gives up after MaxRetries`, func() {
+		_, err := blockchainService.EnableRateLimiting(&blockchainv3.RateLimitOptions{RequestsPerMinute: 6000, MaxRetries: 2})
+		Expect(err).To(BeNil())
+		_, _, err = blockchainService.GetComponent(blockchainService.NewGetComponentOptions("always_busy"))
+		var rateLimitErr *blockchainv3.RateLimitError
+		Expect(errors.As(err, &rateLimitErr)).To(BeTrue())
+		Expect(times).To(HaveLen(3))
+	})
+	It(`Stops waiting when the context is done`, func() {
+		limiter, err := blockchainv3.NewRateLimiter(&blockchainv3.RateLimitOptions{RequestsPerMinute: 1})
+		Expect(err).To(BeNil())
+		Expect(limiter.Wait(context.Background())).To(BeNil())
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		Expect(limiter.Wait(ctx)).To(Equal(context.DeadlineExceeded))
+	})
+})
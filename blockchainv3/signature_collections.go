@@ -0,0 +1,761 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package blockchainv3
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/IBM-Blockchain/ibp-go-sdk/common"
+	"github.com/IBM/go-sdk-core/v4/core"
+	"reflect"
+)
+
+// ListSigTxs : Get all signature collection txs
+// Retrieve all signature collection transactions the console holds. A signature collection gathers the signatures of
+// the organizations that must approve a channel config update before it is submitted to the ordering service.
+func (blockchain *BlockchainV3) ListSigTxs(listSigTxsOptions *ListSigTxsOptions) (result *GetSignatureCollectionsResponse, response *core.DetailedResponse, err error) {
+	return blockchain.ListSigTxsWithContext(context.Background(), listSigTxsOptions)
+}
+
+// ListSigTxsWithContext is an alternate form of the ListSigTxs method which supports a Context parameter
+func (blockchain *BlockchainV3) ListSigTxsWithContext(ctx context.Context, listSigTxsOptions *ListSigTxsOptions) (result *GetSignatureCollectionsResponse, response *core.DetailedResponse, err error) {
+	err = core.ValidateStruct(listSigTxsOptions, "listSigTxsOptions")
+	if err != nil {
+		return
+	}
+
+	builder := core.NewRequestBuilder(core.GET)
+	builder = builder.WithContext(ctx)
+	builder.EnableGzipCompression = blockchain.GetEnableGzipCompression()
+	_, err = builder.ResolveRequestURL(blockchain.Service.Options.URL, `/ak/api/v3/signature_collections`, nil)
+	if err != nil {
+		return
+	}
+
+	for headerName, headerValue := range listSigTxsOptions.Headers {
+		builder.AddHeader(headerName, headerValue)
+	}
+
+	sdkHeaders := common.GetSdkHeaders("blockchain", "V3", "ListSigTxs")
+	for headerName, headerValue := range sdkHeaders {
+		builder.AddHeader(headerName, headerValue)
+	}
+	builder.AddHeader("Accept", "application/json")
+
+	request, err := builder.Build()
+	if err != nil {
+		return
+	}
+
+	var rawResponse map[string]json.RawMessage
+	response, err = blockchain.request("ListSigTxs", request, &rawResponse)
+	if err != nil {
+		return
+	}
+	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalGetSignatureCollectionsResponse)
+	if err != nil {
+		return
+	}
+	response.Result = result
+
+	return
+}
+
+// GetSigTx : Get a signature collection tx
+// Retrieve a single signature collection transaction, including the signatures collected so far.
+func (blockchain *BlockchainV3) GetSigTx(getSigTxOptions *GetSigTxOptions) (result *SignatureCollection, response *core.DetailedResponse, err error) {
+	return blockchain.GetSigTxWithContext(context.Background(), getSigTxOptions)
+}
+
+// GetSigTxWithContext is an alternate form of the GetSigTx method which supports a Context parameter
+func (blockchain *BlockchainV3) GetSigTxWithContext(ctx context.Context, getSigTxOptions *GetSigTxOptions) (result *SignatureCollection, response *core.DetailedResponse, err error) {
+	err = core.ValidateNotNil(getSigTxOptions, "getSigTxOptions cannot be nil")
+	if err != nil {
+		return
+	}
+	err = core.ValidateStruct(getSigTxOptions, "getSigTxOptions")
+	if err != nil {
+		return
+	}
+
+	pathParamsMap := map[string]string{
+		"id": *getSigTxOptions.ID,
+	}
+
+	builder := core.NewRequestBuilder(core.GET)
+	builder = builder.WithContext(ctx)
+	builder.EnableGzipCompression = blockchain.GetEnableGzipCompression()
+	_, err = builder.ResolveRequestURL(blockchain.Service.Options.URL, `/ak/api/v3/signature_collections/{id}`, pathParamsMap)
+	if err != nil {
+		return
+	}
+
+	for headerName, headerValue := range getSigTxOptions.Headers {
+		builder.AddHeader(headerName, headerValue)
+	}
+
+	sdkHeaders := common.GetSdkHeaders("blockchain", "V3", "GetSigTx")
+	for headerName, headerValue := range sdkHeaders {
+		builder.AddHeader(headerName, headerValue)
+	}
+	builder.AddHeader("Accept", "application/json")
+
+	request, err := builder.Build()
+	if err != nil {
+		return
+	}
+
+	var rawResponse map[string]json.RawMessage
+	response, err = blockchain.request("GetSigTx", request, &rawResponse)
+	if err != nil {
+		return
+	}
+	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalSignatureCollection)
+	if err != nil {
+		return
+	}
+	response.Result = result
+
+	return
+}
+
+// CreateSigTx : Create a signature collection tx
+// Start collecting the signatures of a channel config update. The organizations listed in `orgs2sign` are asked to
+// approve it, after which it can be submitted to the `orderers`.
+func (blockchain *BlockchainV3) CreateSigTx(createSigTxOptions *CreateSigTxOptions) (result *SignatureCollection, response *core.DetailedResponse, err error) {
+	return blockchain.CreateSigTxWithContext(context.Background(), createSigTxOptions)
+}
+
+// CreateSigTxWithContext is an alternate form of the CreateSigTx method which supports a Context parameter
+func (blockchain *BlockchainV3) CreateSigTxWithContext(ctx context.Context, createSigTxOptions *CreateSigTxOptions) (result *SignatureCollection, response *core.DetailedResponse, err error) {
+	err = core.ValidateNotNil(createSigTxOptions, "createSigTxOptions cannot be nil")
+	if err != nil {
+		return
+	}
+	err = core.ValidateStruct(createSigTxOptions, "createSigTxOptions")
+	if err != nil {
+		return
+	}
+
+	builder := core.NewRequestBuilder(core.POST)
+	builder = builder.WithContext(ctx)
+	builder.EnableGzipCompression = blockchain.GetEnableGzipCompression()
+	_, err = builder.ResolveRequestURL(blockchain.Service.Options.URL, `/ak/api/v3/signature_collections`, nil)
+	if err != nil {
+		return
+	}
+
+	for headerName, headerValue := range createSigTxOptions.Headers {
+		builder.AddHeader(headerName, headerValue)
+	}
+
+	sdkHeaders := common.GetSdkHeaders("blockchain", "V3", "CreateSigTx")
+	for headerName, headerValue := range sdkHeaders {
+		builder.AddHeader(headerName, headerValue)
+	}
+	builder.AddHeader("Accept", "application/json")
+	builder.AddHeader("Content-Type", "application/json")
+
+	body := make(map[string]interface{})
+	if createSigTxOptions.TxID != nil {
+		body["tx_id"] = createSigTxOptions.TxID
+	}
+	if createSigTxOptions.Channel != nil {
+		body["channel"] = createSigTxOptions.Channel
+	}
+	if createSigTxOptions.Proposal != nil {
+		body["proposal"] = createSigTxOptions.Proposal
+	}
+	if createSigTxOptions.OriginatorMsp != nil {
+		body["originator_msp"] = createSigTxOptions.OriginatorMsp
+	}
+	if createSigTxOptions.Orgs2sign != nil {
+		body["orgs2sign"] = createSigTxOptions.Orgs2sign
+	}
+	if createSigTxOptions.Orderers != nil {
+		body["orderers"] = createSigTxOptions.Orderers
+	}
+	_, err = builder.SetBodyContentJSON(body)
+	if err != nil {
+		return
+	}
+
+	request, err := builder.Build()
+	if err != nil {
+		return
+	}
+
+	var rawResponse map[string]json.RawMessage
+	response, err = blockchain.request("CreateSigTx", request, &rawResponse)
+	if err != nil {
+		return
+	}
+	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalSignatureCollection)
+	if err != nil {
+		return
+	}
+	response.Result = result
+
+	return
+}
+
+// ApproveSigTx : Approve a signature collection tx
+// Add the signature of an organization to a signature collection transaction. The organization must be one of the
+// `orgs2sign` of the collection.
+func (blockchain *BlockchainV3) ApproveSigTx(approveSigTxOptions *ApproveSigTxOptions) (result *SignatureCollection, response *core.DetailedResponse, err error) {
+	return blockchain.ApproveSigTxWithContext(context.Background(), approveSigTxOptions)
+}
+
+// ApproveSigTxWithContext is an alternate form of the ApproveSigTx method which supports a Context parameter
+func (blockchain *BlockchainV3) ApproveSigTxWithContext(ctx context.Context, approveSigTxOptions *ApproveSigTxOptions) (result *SignatureCollection, response *core.DetailedResponse, err error) {
+	err = core.ValidateNotNil(approveSigTxOptions, "approveSigTxOptions cannot be nil")
+	if err != nil {
+		return
+	}
+	err = core.ValidateStruct(approveSigTxOptions, "approveSigTxOptions")
+	if err != nil {
+		return
+	}
+
+	pathParamsMap := map[string]string{
+		"id": *approveSigTxOptions.ID,
+	}
+
+	builder := core.NewRequestBuilder(core.PUT)
+	builder = builder.WithContext(ctx)
+	builder.EnableGzipCompression = blockchain.GetEnableGzipCompression()
+	_, err = builder.ResolveRequestURL(blockchain.Service.Options.URL, `/ak/api/v3/signature_collections/{id}`, pathParamsMap)
+	if err != nil {
+		return
+	}
+
+	for headerName, headerValue := range approveSigTxOptions.Headers {
+		builder.AddHeader(headerName, headerValue)
+	}
+
+	sdkHeaders := common.GetSdkHeaders("blockchain", "V3", "ApproveSigTx")
+	for headerName, headerValue := range sdkHeaders {
+		builder.AddHeader(headerName, headerValue)
+	}
+	builder.AddHeader("Accept", "application/json")
+	builder.AddHeader("Content-Type", "application/json")
+
+	body := make(map[string]interface{})
+	if approveSigTxOptions.MspID != nil {
+		body["msp_id"] = approveSigTxOptions.MspID
+	}
+	if approveSigTxOptions.Certificate != nil {
+		body["certificate"] = approveSigTxOptions.Certificate
+	}
+	if approveSigTxOptions.Signature != nil {
+		body["signature"] = approveSigTxOptions.Signature
+	}
+	_, err = builder.SetBodyContentJSON(body)
+	if err != nil {
+		return
+	}
+
+	request, err := builder.Build()
+	if err != nil {
+		return
+	}
+
+	var rawResponse map[string]json.RawMessage
+	response, err = blockchain.request("ApproveSigTx", request, &rawResponse)
+	if err != nil {
+		return
+	}
+	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalSignatureCollection)
+	if err != nil {
+		return
+	}
+	response.Result = result
+
+	return
+}
+
+// SubmitSigTx : Submit a signature collection tx
+// Submit a signature collection transaction to its orderers once every organization approved it, signed by the
+// submitting organization. The collection is closed once the ordering service accepted the update.
+func (blockchain *BlockchainV3) SubmitSigTx(submitSigTxOptions *SubmitSigTxOptions) (result *SignatureCollection, response *core.DetailedResponse, err error) {
+	return blockchain.SubmitSigTxWithContext(context.Background(), submitSigTxOptions)
+}
+
+// SubmitSigTxWithContext is an alternate form of the SubmitSigTx method which supports a Context parameter
+func (blockchain *BlockchainV3) SubmitSigTxWithContext(ctx context.Context, submitSigTxOptions *SubmitSigTxOptions) (result *SignatureCollection, response *core.DetailedResponse, err error) {
+	err = core.ValidateNotNil(submitSigTxOptions, "submitSigTxOptions cannot be nil")
+	if err != nil {
+		return
+	}
+	err = core.ValidateStruct(submitSigTxOptions, "submitSigTxOptions")
+	if err != nil {
+		return
+	}
+
+	pathParamsMap := map[string]string{
+		"id": *submitSigTxOptions.ID,
+	}
+
+	builder := core.NewRequestBuilder(core.POST)
+	builder = builder.WithContext(ctx)
+	builder.EnableGzipCompression = blockchain.GetEnableGzipCompression()
+	_, err = builder.ResolveRequestURL(blockchain.Service.Options.URL, `/ak/api/v3/signature_collections/{id}/submit`, pathParamsMap)
+	if err != nil {
+		return
+	}
+
+	for headerName, headerValue := range submitSigTxOptions.Headers {
+		builder.AddHeader(headerName, headerValue)
+	}
+
+	sdkHeaders := common.GetSdkHeaders("blockchain", "V3", "SubmitSigTx")
+	for headerName, headerValue := range sdkHeaders {
+		builder.AddHeader(headerName, headerValue)
+	}
+	builder.AddHeader("Accept", "application/json")
+	builder.AddHeader("Content-Type", "application/json")
+
+	body := make(map[string]interface{})
+	if submitSigTxOptions.MspID != nil {
+		body["msp_id"] = submitSigTxOptions.MspID
+	}
+	if submitSigTxOptions.Certificate != nil {
+		body["certificate"] = submitSigTxOptions.Certificate
+	}
+	if submitSigTxOptions.Signature != nil {
+		body["signature"] = submitSigTxOptions.Signature
+	}
+	_, err = builder.SetBodyContentJSON(body)
+	if err != nil {
+		return
+	}
+
+	request, err := builder.Build()
+	if err != nil {
+		return
+	}
+
+	var rawResponse map[string]json.RawMessage
+	response, err = blockchain.request("SubmitSigTx", request, &rawResponse)
+	if err != nil {
+		return
+	}
+	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalSignatureCollection)
+	if err != nil {
+		return
+	}
+	response.Result = result
+
+	return
+}
+
+// ListSigTxsOptions : The ListSigTxs options.
+type ListSigTxsOptions struct {
+
+	// Allows users to set headers on API requests
+	Headers map[string]string
+}
+
+// NewListSigTxsOptions : Instantiate ListSigTxsOptions
+func (*BlockchainV3) NewListSigTxsOptions() *ListSigTxsOptions {
+	return &ListSigTxsOptions{}
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *ListSigTxsOptions) SetHeaders(param map[string]string) *ListSigTxsOptions {
+	options.Headers = param
+	return options
+}
+
+// GetSigTxOptions : The GetSigTx options.
+type GetSigTxOptions struct {
+	// The unique transaction ID of this signature collection.
+	ID *string `json:"id" validate:"required,ne="`
+
+	// Allows users to set headers on API requests
+	Headers map[string]string
+}
+
+// NewGetSigTxOptions : Instantiate GetSigTxOptions
+func (*BlockchainV3) NewGetSigTxOptions(id string) *GetSigTxOptions {
+	return &GetSigTxOptions{
+		ID: core.StringPtr(id),
+	}
+}
+
+// SetID : Allow user to set ID
+func (options *GetSigTxOptions) SetID(id string) *GetSigTxOptions {
+	options.ID = core.StringPtr(id)
+	return options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *GetSigTxOptions) SetHeaders(param map[string]string) *GetSigTxOptions {
+	options.Headers = param
+	return options
+}
+
+// CreateSigTxOptions : The CreateSigTx options.
+type CreateSigTxOptions struct {
+	// The unique transaction ID of this signature collection. Must start with a letter.
+	TxID *string `json:"tx_id" validate:"required"`
+
+	// The name of the channel the config update applies to.
+	Channel *string `json:"channel" validate:"required"`
+
+	// The base 64 encoded config update to sign.
+	Proposal *string `json:"proposal" validate:"required"`
+
+	// The MSP ID of the organization that created the collection.
+	OriginatorMsp *string `json:"originator_msp,omitempty"`
+
+	// The organizations that must approve the config update.
+	Orgs2sign []SignatureCollectionOrg `json:"orgs2sign" validate:"required"`
+
+	// The URLs of the orderers the config update is submitted to.
+	Orderers []string `json:"orderers,omitempty"`
+
+	// Allows users to set headers on API requests
+	Headers map[string]string
+}
+
+// NewCreateSigTxOptions : Instantiate CreateSigTxOptions
+func (*BlockchainV3) NewCreateSigTxOptions(txID string, channel string, proposal string, orgs2sign []SignatureCollectionOrg) *CreateSigTxOptions {
+	return &CreateSigTxOptions{
+		TxID:      core.StringPtr(txID),
+		Channel:   core.StringPtr(channel),
+		Proposal:  core.StringPtr(proposal),
+		Orgs2sign: orgs2sign,
+	}
+}
+
+// SetTxID : Allow user to set TxID
+func (options *CreateSigTxOptions) SetTxID(txID string) *CreateSigTxOptions {
+	options.TxID = core.StringPtr(txID)
+	return options
+}
+
+// SetChannel : Allow user to set Channel
+func (options *CreateSigTxOptions) SetChannel(channel string) *CreateSigTxOptions {
+	options.Channel = core.StringPtr(channel)
+	return options
+}
+
+// SetProposal : Allow user to set Proposal
+func (options *CreateSigTxOptions) SetProposal(proposal string) *CreateSigTxOptions {
+	options.Proposal = core.StringPtr(proposal)
+	return options
+}
+
+// SetOriginatorMsp : Allow user to set OriginatorMsp
+func (options *CreateSigTxOptions) SetOriginatorMsp(originatorMsp string) *CreateSigTxOptions {
+	options.OriginatorMsp = core.StringPtr(originatorMsp)
+	return options
+}
+
+// SetOrgs2sign : Allow user to set Orgs2sign
+func (options *CreateSigTxOptions) SetOrgs2sign(orgs2sign []SignatureCollectionOrg) *CreateSigTxOptions {
+	options.Orgs2sign = orgs2sign
+	return options
+}
+
+// SetOrderers : Allow user to set Orderers
+func (options *CreateSigTxOptions) SetOrderers(orderers []string) *CreateSigTxOptions {
+	options.Orderers = orderers
+	return options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *CreateSigTxOptions) SetHeaders(param map[string]string) *CreateSigTxOptions {
+	options.Headers = param
+	return options
+}
+
+// ApproveSigTxOptions : The ApproveSigTx options.
+type ApproveSigTxOptions struct {
+	// The unique transaction ID of this signature collection.
+	ID *string `json:"id" validate:"required,ne="`
+
+	// The MSP ID of the approving organization.
+	MspID *string `json:"msp_id" validate:"required"`
+
+	// The base 64 encoded PEM certificate of the admin identity that signed the config update.
+	Certificate *string `json:"certificate" validate:"required"`
+
+	// The base 64 encoded signature of the config update.
+	Signature *string `json:"signature" validate:"required"`
+
+	// Allows users to set headers on API requests
+	Headers map[string]string
+}
+
+// NewApproveSigTxOptions : Instantiate ApproveSigTxOptions
+func (*BlockchainV3) NewApproveSigTxOptions(id string, mspID string, certificate string, signature string) *ApproveSigTxOptions {
+	return &ApproveSigTxOptions{
+		ID:          core.StringPtr(id),
+		MspID:       core.StringPtr(mspID),
+		Certificate: core.StringPtr(certificate),
+		Signature:   core.StringPtr(signature),
+	}
+}
+
+// SetID : Allow user to set ID
+func (options *ApproveSigTxOptions) SetID(id string) *ApproveSigTxOptions {
+	options.ID = core.StringPtr(id)
+	return options
+}
+
+// SetMspID : Allow user to set MspID
+func (options *ApproveSigTxOptions) SetMspID(mspID string) *ApproveSigTxOptions {
+	options.MspID = core.StringPtr(mspID)
+	return options
+}
+
+// SetCertificate : Allow user to set Certificate
+func (options *ApproveSigTxOptions) SetCertificate(certificate string) *ApproveSigTxOptions {
+	options.Certificate = core.StringPtr(certificate)
+	return options
+}
+
+// SetSignature : Allow user to set Signature
+func (options *ApproveSigTxOptions) SetSignature(signature string) *ApproveSigTxOptions {
+	options.Signature = core.StringPtr(signature)
+	return options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *ApproveSigTxOptions) SetHeaders(param map[string]string) *ApproveSigTxOptions {
+	options.Headers = param
+	return options
+}
+
+// SubmitSigTxOptions : The SubmitSigTx options.
+type SubmitSigTxOptions struct {
+	// The unique transaction ID of this signature collection.
+	ID *string `json:"id" validate:"required,ne="`
+
+	// The MSP ID of the submitting organization.
+	MspID *string `json:"msp_id" validate:"required"`
+
+	// The base 64 encoded PEM certificate of the admin identity that signed the submission.
+	Certificate *string `json:"certificate" validate:"required"`
+
+	// The base 64 encoded signature of the submission.
+	Signature *string `json:"signature" validate:"required"`
+
+	// Allows users to set headers on API requests
+	Headers map[string]string
+}
+
+// NewSubmitSigTxOptions : Instantiate SubmitSigTxOptions
+func (*BlockchainV3) NewSubmitSigTxOptions(id string, mspID string, certificate string, signature string) *SubmitSigTxOptions {
+	return &SubmitSigTxOptions{
+		ID:          core.StringPtr(id),
+		MspID:       core.StringPtr(mspID),
+		Certificate: core.StringPtr(certificate),
+		Signature:   core.StringPtr(signature),
+	}
+}
+
+// SetID : Allow user to set ID
+func (options *SubmitSigTxOptions) SetID(id string) *SubmitSigTxOptions {
+	options.ID = core.StringPtr(id)
+	return options
+}
+
+// SetMspID : Allow user to set MspID
+func (options *SubmitSigTxOptions) SetMspID(mspID string) *SubmitSigTxOptions {
+	options.MspID = core.StringPtr(mspID)
+	return options
+}
+
+// SetCertificate : Allow user to set Certificate
+func (options *SubmitSigTxOptions) SetCertificate(certificate string) *SubmitSigTxOptions {
+	options.Certificate = core.StringPtr(certificate)
+	return options
+}
+
+// SetSignature : Allow user to set Signature
+func (options *SubmitSigTxOptions) SetSignature(signature string) *SubmitSigTxOptions {
+	options.Signature = core.StringPtr(signature)
+	return options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *SubmitSigTxOptions) SetHeaders(param map[string]string) *SubmitSigTxOptions {
+	options.Headers = param
+	return options
+}
+
+// GetSignatureCollectionsResponse : GetSignatureCollectionsResponse struct
+type GetSignatureCollectionsResponse struct {
+	// The signature collection txs the console holds.
+	SignatureCollections []SignatureCollection `json:"signature_collections,omitempty"`
+}
+
+// UnmarshalGetSignatureCollectionsResponse unmarshals an instance of GetSignatureCollectionsResponse from the specified map of raw messages.
+func UnmarshalGetSignatureCollectionsResponse(m map[string]json.RawMessage, result interface{}) (err error) {
+	obj := new(GetSignatureCollectionsResponse)
+	err = core.UnmarshalModel(m, "signature_collections", &obj.SignatureCollections, UnmarshalSignatureCollection)
+	if err != nil {
+		return
+	}
+	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
+	return
+}
+
+// SignatureCollection : A channel config update and the signatures collected for it.
+type SignatureCollection struct {
+	// The unique transaction ID of this signature collection. Must start with a letter.
+	TxID *string `json:"tx_id,omitempty"`
+
+	// The name of the channel the config update applies to.
+	Channel *string `json:"channel,omitempty"`
+
+	// The status of the collection. Values can be "open" or "closed".
+	Status *string `json:"status,omitempty"`
+
+	// The MSP ID of the organization that created the collection.
+	OriginatorMsp *string `json:"originator_msp,omitempty"`
+
+	// The base 64 encoded config update.
+	Proposal *string `json:"proposal,omitempty"`
+
+	// The organizations that must approve the config update, and their signatures.
+	Orgs2sign []SignatureCollectionOrg `json:"orgs2sign,omitempty"`
+
+	// The URLs of the orderers the config update is submitted to.
+	Orderers []string `json:"orderers,omitempty"`
+
+	// UTC UNIX timestamp of when the collection was created. In milliseconds.
+	Timestamp *float64 `json:"timestamp,omitempty"`
+
+	// UTC UNIX timestamp of when the collection was last updated. In milliseconds.
+	Updated *float64 `json:"updated,omitempty"`
+}
+
+// Constants associated with the SignatureCollection.Status property.
+// The status of the collection.
+const (
+	SignatureCollection_Status_Closed = "closed"
+	SignatureCollection_Status_Open   = "open"
+)
+
+// Unsigned returns the MSP IDs of the organizations that have not approved the collection yet.
+func (collection *SignatureCollection) Unsigned() []string {
+	var mspIDs []string
+	for _, org := range collection.Orgs2sign {
+		if org.MspID != nil && (org.Signature == nil || *org.Signature == "") {
+			mspIDs = append(mspIDs, *org.MspID)
+		}
+	}
+	return mspIDs
+}
+
+// Approved returns whether every organization approved the collection.
+func (collection *SignatureCollection) Approved() bool {
+	return len(collection.Unsigned()) == 0
+}
+
+// UnmarshalSignatureCollection unmarshals an instance of SignatureCollection from the specified map of raw messages.
+func UnmarshalSignatureCollection(m map[string]json.RawMessage, result interface{}) (err error) {
+	obj := new(SignatureCollection)
+	err = core.UnmarshalPrimitive(m, "tx_id", &obj.TxID)
+	if err != nil {
+		return
+	}
+	err = core.UnmarshalPrimitive(m, "channel", &obj.Channel)
+	if err != nil {
+		return
+	}
+	err = core.UnmarshalPrimitive(m, "status", &obj.Status)
+	if err != nil {
+		return
+	}
+	err = core.UnmarshalPrimitive(m, "originator_msp", &obj.OriginatorMsp)
+	if err != nil {
+		return
+	}
+	err = core.UnmarshalPrimitive(m, "proposal", &obj.Proposal)
+	if err != nil {
+		return
+	}
+	err = core.UnmarshalModel(m, "orgs2sign", &obj.Orgs2sign, UnmarshalSignatureCollectionOrg)
+	if err != nil {
+		return
+	}
+	err = core.UnmarshalPrimitive(m, "orderers", &obj.Orderers)
+	if err != nil {
+		return
+	}
+	err = core.UnmarshalPrimitive(m, "timestamp", &obj.Timestamp)
+	if err != nil {
+		return
+	}
+	err = core.UnmarshalPrimitive(m, "updated", &obj.Updated)
+	if err != nil {
+		return
+	}
+	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
+	return
+}
+
+// SignatureCollectionOrg : An organization asked to approve a signature collection tx.
+type SignatureCollectionOrg struct {
+	// The MSP ID of the organization.
+	MspID *string `json:"msp_id,omitempty"`
+
+	// The URL of the console of the organization, when it is managed by another console.
+	OptoolsURL *string `json:"optools_url,omitempty"`
+
+	// The base 64 encoded PEM certificate of the admin identity that approved the config update. Empty until it is
+	// approved.
+	Certificate *string `json:"certificate,omitempty"`
+
+	// The base 64 encoded signature of the config update. Empty until it is approved.
+	Signature *string `json:"signature,omitempty"`
+
+	// UTC UNIX timestamp of when the organization approved the config update. In milliseconds.
+	Timestamp *float64 `json:"timestamp,omitempty"`
+}
+
+// UnmarshalSignatureCollectionOrg unmarshals an instance of SignatureCollectionOrg from the specified map of raw messages.
+func UnmarshalSignatureCollectionOrg(m map[string]json.RawMessage, result interface{}) (err error) {
+	obj := new(SignatureCollectionOrg)
+	err = core.UnmarshalPrimitive(m, "msp_id", &obj.MspID)
+	if err != nil {
+		return
+	}
+	err = core.UnmarshalPrimitive(m, "optools_url", &obj.OptoolsURL)
+	if err != nil {
+		return
+	}
+	err = core.UnmarshalPrimitive(m, "certificate", &obj.Certificate)
+	if err != nil {
+		return
+	}
+	err = core.UnmarshalPrimitive(m, "signature", &obj.Signature)
+	if err != nil {
+		return
+	}
+	err = core.UnmarshalPrimitive(m, "timestamp", &obj.Timestamp)
+	if err != nil {
+		return
+	}
+	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
+	return
+}
@@ -0,0 +1,138 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package blockchainv3_test
+
+import (
+	"encoding/json"
+	"github.com/IBM-Blockchain/ibp-go-sdk/blockchainv3"
+	"github.com/IBM/go-sdk-core/v4/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"net/http"
+	"net/http/httptest"
+)
+
+var _ = Describe(`Signature collections`, func() {
+	const collection = `{"tx_id": "abcdef", "channel": "channel1", "status": "open", "originator_msp": "org1", "proposal": "cHJvcG9zYWw=", ` +
+		`"orgs2sign": [{"msp_id": "org1", "certificate": "Y2VydA==", "signature": "c2ln", "timestamp": 1537262855753}, {"msp_id": "org2", "optools_url": "https://org2.console.local"}], ` +
+		`"orderers": ["grpcs://orderer.local:7050"], "timestamp": 1537262855753, "updated": 1537262855754}`
+
+	var consoleServer *httptest.Server
+	var service *blockchainv3.BlockchainV3
+	var method, path string
+	var body map[string]interface{}
+
+	BeforeEach(func() {
+		method, path, body = "", "", nil
+		consoleServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			method, path = req.Method, req.URL.Path
+			if req.ContentLength > 0 {
+				Expect(json.NewDecoder(req.Body).Decode(&body)).To(Succeed())
+			}
+			res.Header().Set("Content-type", "application/json")
+			res.WriteHeader(200)
+			if req.URL.Path == "/ak/api/v3/signature_collections" && req.Method == http.MethodGet {
+				res.Write([]byte(`{"signature_collections": [` + collection + `]}`))
+			} else {
+				res.Write([]byte(collection))
+			}
+		}))
+		var serviceErr error
+		service, serviceErr = blockchainv3.NewBlockchainV3(&blockchainv3.BlockchainV3Options{
+			URL:           consoleServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+
+	AfterEach(func() {
+		consoleServer.Close()
+	})
+
+	It(`lists the signature collections`, func() {
+		result, response, err := service.ListSigTxs(service.NewListSigTxsOptions())
+		Expect(err).To(BeNil())
+		Expect(response.StatusCode).To(Equal(200))
+		Expect(method).To(Equal("GET"))
+		Expect(path).To(Equal("/ak/api/v3/signature_collections"))
+		Expect(result.SignatureCollections).To(HaveLen(1))
+
+		collection := result.SignatureCollections[0]
+		Expect(*collection.TxID).To(Equal("abcdef"))
+		Expect(*collection.Status).To(Equal(blockchainv3.SignatureCollection_Status_Open))
+		Expect(collection.Orgs2sign).To(HaveLen(2))
+		Expect(*collection.Orgs2sign[1].OptoolsURL).To(Equal("https://org2.console.local"))
+		Expect(collection.Orderers).To(Equal([]string{"grpcs://orderer.local:7050"}))
+		Expect(*collection.Updated).To(Equal(float64(1537262855754)))
+		Expect(collection.Unsigned()).To(Equal([]string{"org2"}))
+		Expect(collection.Approved()).To(BeFalse())
+	})
+
+	It(`gets a signature collection`, func() {
+		result, _, err := service.GetSigTx(service.NewGetSigTxOptions("abcdef"))
+		Expect(err).To(BeNil())
+		Expect(method).To(Equal("GET"))
+		Expect(path).To(Equal("/ak/api/v3/signature_collections/abcdef"))
+		Expect(*result.Channel).To(Equal("channel1"))
+	})
+
+	It(`creates a signature collection`, func() {
+		orgs := []blockchainv3.SignatureCollectionOrg{{MspID: core.StringPtr("org1")}, {MspID: core.StringPtr("org2")}}
+		options := service.NewCreateSigTxOptions("abcdef", "channel1", "cHJvcG9zYWw=", orgs)
+		options.SetOriginatorMsp("org1").SetOrderers([]string{"grpcs://orderer.local:7050"})
+		result, _, err := service.CreateSigTx(options)
+		Expect(err).To(BeNil())
+		Expect(method).To(Equal("POST"))
+		Expect(path).To(Equal("/ak/api/v3/signature_collections"))
+		Expect(body).To(Equal(map[string]interface{}{
+			"tx_id":          "abcdef",
+			"channel":        "channel1",
+			"proposal":       "cHJvcG9zYWw=",
+			"originator_msp": "org1",
+			"orgs2sign":      []interface{}{map[string]interface{}{"msp_id": "org1"}, map[string]interface{}{"msp_id": "org2"}},
+			"orderers":       []interface{}{"grpcs://orderer.local:7050"},
+		}))
+		Expect(*result.TxID).To(Equal("abcdef"))
+	})
+
+	It(`approves a signature collection`, func() {
+		_, _, err := service.ApproveSigTx(service.NewApproveSigTxOptions("abcdef", "org2", "Y2VydA==", "c2ln"))
+		Expect(err).To(BeNil())
+		Expect(method).To(Equal("PUT"))
+		Expect(path).To(Equal("/ak/api/v3/signature_collections/abcdef"))
+		Expect(body).To(Equal(map[string]interface{}{"msp_id": "org2", "certificate": "Y2VydA==", "signature": "c2ln"}))
+	})
+
+	It(`submits a signature collection`, func() {
+		_, _, err := service.SubmitSigTx(service.NewSubmitSigTxOptions("abcdef", "org1", "Y2VydA==", "c2ln"))
+		Expect(err).To(BeNil())
+		Expect(method).To(Equal("POST"))
+		Expect(path).To(Equal("/ak/api/v3/signature_collections/abcdef/submit"))
+		Expect(body).To(Equal(map[string]interface{}{"msp_id": "org1", "certificate": "Y2VydA==", "signature": "c2ln"}))
+	})
+
+	It(`validates the options`, func() {
+		_, _, err := service.GetSigTx(nil)
+		Expect(err).ToNot(BeNil())
+		_, _, err = service.CreateSigTx(&blockchainv3.CreateSigTxOptions{TxID: core.StringPtr("abcdef")})
+		Expect(err).ToNot(BeNil())
+		_, _, err = service.ApproveSigTx(&blockchainv3.ApproveSigTxOptions{ID: core.StringPtr("abcdef")})
+		Expect(err).ToNot(BeNil())
+		Expect(method).To(BeEmpty())
+	})
+})
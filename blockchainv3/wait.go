@@ -0,0 +1,265 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package blockchainv3
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// Default values of WaitForComponentReadyOptions.
+const (
+	DefaultReadyTimeout         = 10 * time.Minute
+	DefaultReadyInitialInterval = 2 * time.Second
+	DefaultReadyMaxInterval     = 30 * time.Second
+	DefaultReadyMultiplier      = 2.0
+)
+
+// WaitForComponentReadyOptions : The WaitForComponentReady options.
+// Zero values are replaced by the matching Default* constant.
+type WaitForComponentReadyOptions struct {
+	// How long to wait before giving up with a *ComponentNotReadyError.
+	Timeout time.Duration
+
+	// The delay between the first two polls.
+	InitialInterval time.Duration
+
+	// The upper bound of the delay between two polls.
+	MaxInterval time.Duration
+
+	// The factor the delay grows by after each unsuccessful poll.
+	Multiplier float64
+
+	// The client used to probe the component's own endpoints. By default a client that trusts the system roots and the
+	// TLS certificates the console holds for the component is used.
+	HTTPClient *http.Client
+}
+
+// ComponentNotReadyError : The component did not become ready before the timeout.
+type ComponentNotReadyError struct {
+	ComponentID string
+
+	// The component type, e.g. "fabric-peer". Empty if the console never returned the component.
+	Type string
+
+	// How long WaitForComponentReady waited.
+	Waited time.Duration
+
+	// Why the last poll failed.
+	LastErr error
+}
+
+// Error returns a description of the timeout and of the last poll failure.
+func (e *ComponentNotReadyError) Error() string {
+	return fmt.Sprintf("component %s (%s) was not ready after %s: %s", e.ComponentID, e.Type, e.Waited, e.LastErr)
+}
+
+// Unwrap returns the last poll failure.
+func (e *ComponentNotReadyError) Unwrap() error {
+	return e.LastErr
+}
+
+// WaitForComponentReady : Wait for a component to be ready
+// Poll a component until it is ready to serve requests, backing off between polls. The readiness signal depends on the
+// component type:
+// - CA: its `/cainfo` endpoint answers with HTTP 200.
+// - peer and orderer: the `/healthz` endpoint of its operations URL answers with HTTP 200.
+// - any other component (e.g. an MSP): the console returns it from the Get component API.
+// Returns a *ComponentNotReadyError when the timeout elapses, or the context's error if it is cancelled first.
+func (blockchain *BlockchainV3) WaitForComponentReady(ctx context.Context, id string, options *WaitForComponentReadyOptions) error {
+	opts := WaitForComponentReadyOptions{}
+	if options != nil {
+		opts = *options
+	}
+	if opts.Timeout <= 0 {
+		opts.Timeout = DefaultReadyTimeout
+	}
+	if opts.InitialInterval <= 0 {
+		opts.InitialInterval = DefaultReadyInitialInterval
+	}
+	if opts.MaxInterval <= 0 {
+		opts.MaxInterval = DefaultReadyMaxInterval
+	}
+	if opts.Multiplier < 1 {
+		opts.Multiplier = DefaultReadyMultiplier
+	}
+
+	client := &probeClient{client: opts.HTTPClient}
+	defer client.close()
+
+	start := time.Now()
+	deadline := time.NewTimer(opts.Timeout)
+	defer deadline.Stop()
+	interval := opts.InitialInterval
+	notReady := &ComponentNotReadyError{ComponentID: id}
+	for {
+		componentType, err := blockchain.probeComponent(ctx, id, client)
+		if err == nil {
+			return nil
+		}
+		if componentType != "" {
+			notReady.Type = componentType
+		}
+		notReady.LastErr = err
+
+		var notFound *NotFoundError
+		var unauthorized *AuthorizationError
+		if errors.As(err, &notFound) || errors.As(err, &unauthorized) {
+			return err
+		}
+
+		wait := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			wait.Stop()
+			return ctx.Err()
+		case <-deadline.C:
+			wait.Stop()
+			notReady.Waited = time.Since(start)
+			return notReady
+		case <-wait.C:
+		}
+		interval = time.Duration(float64(interval) * opts.Multiplier)
+		if interval > opts.MaxInterval {
+			interval = opts.MaxInterval
+		}
+	}
+}
+
+// probeComponent checks the readiness signal of a component once and returns its type.
+func (blockchain *BlockchainV3) probeComponent(ctx context.Context, id string, client *probeClient) (string, error) {
+	getOptions := blockchain.NewGetComponentOptions(id)
+	getOptions.SetCache(GetComponentOptions_Cache_Skip)
+	component, _, err := blockchain.GetComponentWithContext(ctx, getOptions)
+	if err != nil {
+		return "", err
+	}
+	componentType := ""
+	if component.Type != nil {
+		componentType = *component.Type
+	}
+
+	var endpoint string
+	switch componentType {
+	case "fabric-ca":
+		if component.ApiURL == nil {
+			return componentType, fmt.Errorf("the console has no API URL for the CA yet")
+		}
+		endpoint = strings.TrimSuffix(*component.ApiURL, "/") + "/cainfo"
+	case "fabric-peer", "fabric-orderer":
+		if component.OperationsURL == nil {
+			return componentType, fmt.Errorf("the console has no operations URL for the node yet")
+		}
+		endpoint = strings.TrimSuffix(*component.OperationsURL, "/") + "/healthz"
+	default:
+		return componentType, nil
+	}
+
+	req, err := http.NewRequest(http.MethodGet, endpoint, nil)
+	if err != nil {
+		return componentType, err
+	}
+	resp, err := client.forComponent(component).Do(req.WithContext(ctx))
+	if err != nil {
+		return componentType, err
+	}
+	// Drain the body so that the connection can be reused by the next poll.
+	io.Copy(ioutil.Discard, resp.Body)
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return componentType, fmt.Errorf("%s answered with HTTP %d", endpoint, resp.StatusCode)
+	}
+	return componentType, nil
+}
+
+// probeClient : The client that probes the endpoints of a component, shared by the polls of one WaitForComponentReady
+// call so that they reuse its connections.
+type probeClient struct {
+	// The client given in the options, used as is.
+	client *http.Client
+
+	// The default client, and the TLS certificates it was built to trust.
+	certs    []string
+	fallback *http.Client
+}
+
+// forComponent returns the client given in the options, or the default client for the component. The default client is
+// only rebuilt when the console holds different TLS certificates for the component than at the previous poll, e.g. once
+// they are first known.
+func (client *probeClient) forComponent(component *GenericComponentResponse) *http.Client {
+	if client.client != nil {
+		return client.client
+	}
+	certs := componentTLSCerts(component)
+	if client.fallback == nil || !reflect.DeepEqual(certs, client.certs) {
+		client.close()
+		client.certs, client.fallback = certs, componentHTTPClient(certs)
+	}
+	return client.fallback
+}
+
+// close closes the idle connections of the default client.
+func (client *probeClient) close() {
+	if client.fallback != nil {
+		client.fallback.CloseIdleConnections()
+	}
+}
+
+// componentTLSCerts returns every TLS certificate the console holds for the component, base 64 encoded.
+func componentTLSCerts(component *GenericComponentResponse) []string {
+	var certs []string
+	if msp := component.Msp; msp != nil {
+		if msp.Component != nil && msp.Component.TlsCert != nil {
+			certs = append(certs, *msp.Component.TlsCert)
+		}
+		if msp.Tlsca != nil {
+			certs = append(certs, msp.Tlsca.RootCerts...)
+		}
+	}
+	return certs
+}
+
+// componentHTTPClient returns a client that trusts the system roots and the TLS certificates of a component, since
+// Fabric endpoints are usually signed by the organization's own TLS CA.
+func componentHTTPClient(certs []string) *http.Client {
+	pool, err := x509.SystemCertPool()
+	if err != nil || pool == nil {
+		pool = x509.NewCertPool()
+	}
+	for _, cert := range certs {
+		if pem, err := base64.StdEncoding.DecodeString(cert); err == nil {
+			pool.AppendCertsFromPEM(pem)
+		}
+	}
+	return &http.Client{
+		Timeout: 5 * time.Second,
+		Transport: &http.Transport{
+			Proxy:           http.ProxyFromEnvironment,
+			TLSClientConfig: &tls.Config{RootCAs: pool},
+		},
+	}
+}
@@ -0,0 +1,171 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package blockchainv3_test
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"github.com/IBM-Blockchain/ibp-go-sdk/blockchainv3"
+	"github.com/IBM/go-sdk-core/v4/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"time"
+)
+
+var _ = Describe(`WaitForComponentReady`, func() {
+	var consoleServer *httptest.Server
+	var nodeServer *httptest.Server
+	var component string
+	var nodeStatus int32
+	var probes int32
+	var opened, closed int32
+
+	fastOptions := &blockchainv3.WaitForComponentReadyOptions{
+		Timeout:         time.Second,
+		InitialInterval: 10 * time.Millisecond,
+		MaxInterval:     20 * time.Millisecond,
+	}
+
+	newService := func() *blockchainv3.BlockchainV3 {
+		blockchainService, serviceErr := blockchainv3.NewBlockchainV3(&blockchainv3.BlockchainV3Options{
+			URL:           consoleServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		return blockchainService
+	}
+
+	BeforeEach(func() {
+		atomic.StoreInt32(&nodeStatus, 503)
+		atomic.StoreInt32(&probes, 0)
+		atomic.StoreInt32(&opened, 0)
+		atomic.StoreInt32(&closed, 0)
+		nodeServer = httptest.NewUnstartedServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			atomic.AddInt32(&probes, 1)
+			if req.URL.Path != "/cainfo" && req.URL.Path != "/healthz" {
+				res.WriteHeader(404)
+				return
+			}
+			res.WriteHeader(int(atomic.LoadInt32(&nodeStatus)))
+			fmt.Fprint(res, "status")
+		}))
+		nodeServer.Config.ConnState = func(conn net.Conn, state http.ConnState) {
+			switch state {
+			case http.StateNew:
+				atomic.AddInt32(&opened, 1)
+			case http.StateClosed:
+				atomic.AddInt32(&closed, 1)
+			}
+		}
+		nodeServer.StartTLS()
+		consoleServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			Expect(req.URL.Query()["cache"]).To(Equal([]string{"skip"}))
+			res.Header().Set("Content-type", "application/json")
+			if component == "" {
+				res.WriteHeader(404)
+				fmt.Fprint(res, `{"statusCode": 404, "msg": "no such component"}`)
+				return
+			}
+			res.WriteHeader(200)
+			fmt.Fprint(res, component)
+		}))
+	})
+	AfterEach(func() {
+		consoleServer.Close()
+		nodeServer.Close()
+	})
+
+	// nodeTlsCert is the base 64 encoded PEM of the node server's certificate, as the console would return it
+	nodeTlsCert := func() string {
+		certPem := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: nodeServer.Certificate().Raw})
+		return base64.StdEncoding.EncodeToString(certPem)
+	}
+
+	It(`Waits for a CA's cainfo endpoint, trusting the CA's TLS certificate`, func() {
+		component = fmt.Sprintf(`{"id": "ca1", "type": "fabric-ca", "api_url": "%s", "msp": {"component": {"tls_cert": "%s"}}}`,
+			nodeServer.URL, nodeTlsCert())
+		go func() {
+			time.Sleep(50 * time.Millisecond)
+			atomic.StoreInt32(&nodeStatus, 200)
+		}()
+		err := newService().WaitForComponentReady(context.Background(), "ca1", fastOptions)
+		Expect(err).To(BeNil())
+		Expect(atomic.LoadInt32(&probes)).To(BeNumerically(">", 1))
+	})
+	It(`Reuses one connection to the node across polls and closes it when done`, func() {
+		component = fmt.Sprintf(`{"id": "ca1", "type": "fabric-ca", "api_url": "%s", "msp": {"component": {"tls_cert": "%s"}}}`,
+			nodeServer.URL, nodeTlsCert())
+		go func() {
+			time.Sleep(50 * time.Millisecond)
+			atomic.StoreInt32(&nodeStatus, 200)
+		}()
+		err := newService().WaitForComponentReady(context.Background(), "ca1", fastOptions)
+		Expect(err).To(BeNil())
+		Expect(atomic.LoadInt32(&probes)).To(BeNumerically(">", 1))
+		Expect(atomic.LoadInt32(&opened)).To(Equal(int32(1)))
+		Eventually(func() int32 { return atomic.LoadInt32(&closed) }).Should(Equal(int32(1)))
+	})
+	It(`Waits for a peer's healthz endpoint`, func() {
+		component = fmt.Sprintf(`{"id": "peer1", "type": "fabric-peer", "operations_url": "%s", "msp": {"tlsca": {"root_certs": ["%s"]}}}`,
+			nodeServer.URL, nodeTlsCert())
+		atomic.StoreInt32(&nodeStatus, 200)
+		err := newService().WaitForComponentReady(context.Background(), "peer1", fastOptions)
+		Expect(err).To(BeNil())
+		Expect(atomic.LoadInt32(&probes)).To(Equal(int32(1)))
+	})
+	It(`Considers an MSP ready once the console returns it`, func() {
+		component = `{"id": "msp1", "type": "msp"}`
+		err := newService().WaitForComponentReady(context.Background(), "msp1", fastOptions)
+		Expect(err).To(BeNil())
+		Expect(atomic.LoadInt32(&probes)).To(Equal(int32(0)))
+	})
+	It(`Returns a *ComponentNotReadyError on timeout`, func() {
+		component = fmt.Sprintf(`{"id": "orderer1", "type": "fabric-orderer", "operations_url": "%s", "msp": {"component": {"tls_cert": "%s"}}}`,
+			nodeServer.URL, nodeTlsCert())
+		err := newService().WaitForComponentReady(context.Background(), "orderer1", &blockchainv3.WaitForComponentReadyOptions{
+			Timeout:         100 * time.Millisecond,
+			InitialInterval: 10 * time.Millisecond,
+		})
+		var notReady *blockchainv3.ComponentNotReadyError
+		Expect(errors.As(err, &notReady)).To(BeTrue())
+		Expect(notReady.ComponentID).To(Equal("orderer1"))
+		Expect(notReady.Type).To(Equal("fabric-orderer"))
+		Expect(notReady.Waited).To(BeNumerically(">=", 100*time.Millisecond))
+		Expect(notReady.Error()).To(ContainSubstring("answered with HTTP 503"))
+	})
+	It(`Returns the context's error when cancelled`, func() {
+		component = fmt.Sprintf(`{"id": "ca1", "type": "fabric-ca", "api_url": "%s"}`, nodeServer.URL)
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		err := newService().WaitForComponentReady(ctx, "ca1", fastOptions)
+		Expect(err).To(Equal(context.DeadlineExceeded))
+	})
+	It(`Fails fast when the component does not exist`, func() {
+		component = ""
+		err := newService().WaitForComponentReady(context.Background(), "missing", fastOptions)
+		var notFound *blockchainv3.NotFoundError
+		Expect(errors.As(err, &notFound)).To(BeTrue())
+	})
+})
@@ -0,0 +1,883 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package blockchainv3fake
+
+import (
+	"context"
+	"crypto/x509"
+	"fmt"
+	"github.com/IBM-Blockchain/ibp-go-sdk/blockchainv3"
+	"github.com/IBM-Blockchain/ibp-go-sdk/certs"
+	"github.com/IBM/go-sdk-core/v4/core"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// The values of the type field of components.
+const (
+	typeCa      = "fabric-ca"
+	typePeer    = "fabric-peer"
+	typeOrderer = "fabric-orderer"
+	typeMsp     = "msp"
+)
+
+// locationDeployed is the location of the components created by the console, as opposed to imported ones.
+const locationDeployed = "ibm_saas"
+
+// deploymentAttrs are the fields only returned when the deployment attributes are included.
+var deploymentAttrs = []string{"config_override", "resources", "storage", "zone", "region", "replicas", "hsm"}
+
+// GetComponent : Get component data
+func (console *Console) GetComponentWithContext(ctx context.Context, getComponentOptions *blockchainv3.GetComponentOptions) (result *blockchainv3.GenericComponentResponse, response *core.DetailedResponse, err error) {
+	if err = validate(ctx, getComponentOptions, "getComponentOptions"); err != nil {
+		return
+	}
+	console.mu.Lock()
+	defer console.mu.Unlock()
+	component, response, err := console.component("GetComponent", *getComponentOptions.ID, "")
+	if err != nil {
+		return
+	}
+	response, err = respond(http.StatusOK, view(component, getComponentOptions.DeploymentAttrs), &result, blockchainv3.UnmarshalGenericComponentResponse)
+	return
+}
+
+// RemoveComponent : Remove imported component
+func (console *Console) RemoveComponentWithContext(ctx context.Context, removeComponentOptions *blockchainv3.RemoveComponentOptions) (result *blockchainv3.DeleteComponentResponse, response *core.DetailedResponse, err error) {
+	if err = validate(ctx, removeComponentOptions, "removeComponentOptions"); err != nil {
+		return
+	}
+	console.mu.Lock()
+	defer console.mu.Unlock()
+	if _, response, err = console.component("RemoveComponent", *removeComponentOptions.ID, ""); err != nil {
+		return
+	}
+	response, err = respond(http.StatusOK, console.remove(*removeComponentOptions.ID), &result, blockchainv3.UnmarshalDeleteComponentResponse)
+	return
+}
+
+// DeleteComponent : Delete component
+func (console *Console) DeleteComponentWithContext(ctx context.Context, deleteComponentOptions *blockchainv3.DeleteComponentOptions) (result *blockchainv3.DeleteComponentResponse, response *core.DetailedResponse, err error) {
+	if err = validate(ctx, deleteComponentOptions, "deleteComponentOptions"); err != nil {
+		return
+	}
+	console.mu.Lock()
+	defer console.mu.Unlock()
+	if _, response, err = console.component("DeleteComponent", *deleteComponentOptions.ID, ""); err != nil {
+		return
+	}
+	response, err = respond(http.StatusOK, console.remove(*deleteComponentOptions.ID), &result, blockchainv3.UnmarshalDeleteComponentResponse)
+	return
+}
+
+// CreateCa : Create a CA
+func (console *Console) CreateCaWithContext(ctx context.Context, createCaOptions *blockchainv3.CreateCaOptions) (result *blockchainv3.CaResponse, response *core.DetailedResponse, err error) {
+	if err = validate(ctx, createCaOptions, "createCaOptions"); err != nil {
+		return
+	}
+	console.mu.Lock()
+	defer console.mu.Unlock()
+	options := toObject(createCaOptions)
+	id, response, err := console.newID("CreateCa", options)
+	if err != nil {
+		return
+	}
+	component := console.create(id, typeCa, options)
+	component["api_url"] = fmt.Sprintf("https://%s-ca.%s:7054", id, domain)
+	component["msp"] = map[string]interface{}{
+		"ca":    map[string]interface{}{"name": "ca"},
+		"tlsca": map[string]interface{}{"name": "tlsca"},
+	}
+	response, err = respond(http.StatusCreated, component, &result, blockchainv3.UnmarshalCaResponse)
+	return
+}
+
+// ImportCa : Import a CA
+func (console *Console) ImportCaWithContext(ctx context.Context, importCaOptions *blockchainv3.ImportCaOptions) (result *blockchainv3.CaResponse, response *core.DetailedResponse, err error) {
+	if err = validate(ctx, importCaOptions, "importCaOptions"); err != nil {
+		return
+	}
+	console.mu.Lock()
+	defer console.mu.Unlock()
+	options := toObject(importCaOptions)
+	id, response, err := console.newID("ImportCa", options)
+	if err != nil {
+		return
+	}
+	response, err = respond(http.StatusOK, console.add(id, typeCa, options), &result, blockchainv3.UnmarshalCaResponse)
+	return
+}
+
+// UpdateCa : Update a CA
+func (console *Console) UpdateCaWithContext(ctx context.Context, updateCaOptions *blockchainv3.UpdateCaOptions) (result *blockchainv3.CaResponse, response *core.DetailedResponse, err error) {
+	if err = validate(ctx, updateCaOptions, "updateCaOptions"); err != nil {
+		return
+	}
+	console.mu.Lock()
+	defer console.mu.Unlock()
+	component, response, err := console.update("UpdateCa", typeCa, toObject(updateCaOptions))
+	if err != nil {
+		return
+	}
+	response, err = respond(http.StatusOK, component, &result, blockchainv3.UnmarshalCaResponse)
+	return
+}
+
+// EditCa : Edit data about a CA
+func (console *Console) EditCaWithContext(ctx context.Context, editCaOptions *blockchainv3.EditCaOptions) (result *blockchainv3.CaResponse, response *core.DetailedResponse, err error) {
+	if err = validate(ctx, editCaOptions, "editCaOptions"); err != nil {
+		return
+	}
+	console.mu.Lock()
+	defer console.mu.Unlock()
+	options := toObject(editCaOptions)
+	if caName, ok := options["ca_name"]; ok {
+		delete(options, "ca_name")
+		options["msp"] = map[string]interface{}{"ca": map[string]interface{}{"name": caName}}
+	}
+	component, response, err := console.edit("EditCa", typeCa, options)
+	if err != nil {
+		return
+	}
+	response, err = respond(http.StatusOK, view(component, nil), &result, blockchainv3.UnmarshalCaResponse)
+	return
+}
+
+// CaAction : Submit action to a CA
+func (console *Console) CaActionWithContext(ctx context.Context, caActionOptions *blockchainv3.CaActionOptions) (result *blockchainv3.ActionsResponse, response *core.DetailedResponse, err error) {
+	if err = validate(ctx, caActionOptions, "caActionOptions"); err != nil {
+		return
+	}
+	var actions []string
+	if isTrue(caActionOptions.Restart) {
+		actions = append(actions, "restart")
+	}
+	if caActionOptions.Renew != nil && isTrue(caActionOptions.Renew.TlsCert) {
+		actions = append(actions, "renew_tls_cert")
+	}
+	console.mu.Lock()
+	defer console.mu.Unlock()
+	object, response, err := console.act("CaAction", *caActionOptions.ID, typeCa, actions)
+	if err != nil {
+		return
+	}
+	response, err = respond(http.StatusAccepted, object, &result, blockchainv3.UnmarshalActionsResponse)
+	return
+}
+
+// CreatePeer : Create a peer
+func (console *Console) CreatePeerWithContext(ctx context.Context, createPeerOptions *blockchainv3.CreatePeerOptions) (result *blockchainv3.PeerResponse, response *core.DetailedResponse, err error) {
+	if err = validate(ctx, createPeerOptions, "createPeerOptions"); err != nil {
+		return
+	}
+	console.mu.Lock()
+	defer console.mu.Unlock()
+	options := toObject(createPeerOptions)
+	id, response, err := console.newID("CreatePeer", options)
+	if err != nil {
+		return
+	}
+	crypto, _ := options["crypto"].(map[string]interface{})
+	delete(options, "crypto")
+	component := console.create(id, typePeer, options)
+	component["api_url"] = fmt.Sprintf("grpcs://%s-peer.%s:7051", id, domain)
+	component["grpcwp_url"] = fmt.Sprintf("https://%s-proxy.%s:443", id, domain)
+	component["msp"] = mspFromCrypto(crypto)
+	if _, ok := component["state_db"]; !ok {
+		component["state_db"] = blockchainv3.CreatePeerOptions_StateDb_Couchdb
+	}
+	response, err = respond(http.StatusCreated, component, &result, blockchainv3.UnmarshalPeerResponse)
+	return
+}
+
+// ImportPeer : Import a peer
+func (console *Console) ImportPeerWithContext(ctx context.Context, importPeerOptions *blockchainv3.ImportPeerOptions) (result *blockchainv3.PeerResponse, response *core.DetailedResponse, err error) {
+	if err = validate(ctx, importPeerOptions, "importPeerOptions"); err != nil {
+		return
+	}
+	console.mu.Lock()
+	defer console.mu.Unlock()
+	options := toObject(importPeerOptions)
+	id, response, err := console.newID("ImportPeer", options)
+	if err != nil {
+		return
+	}
+	response, err = respond(http.StatusOK, console.add(id, typePeer, options), &result, blockchainv3.UnmarshalPeerResponse)
+	return
+}
+
+// EditPeer : Edit data about a peer
+func (console *Console) EditPeerWithContext(ctx context.Context, editPeerOptions *blockchainv3.EditPeerOptions) (result *blockchainv3.PeerResponse, response *core.DetailedResponse, err error) {
+	if err = validate(ctx, editPeerOptions, "editPeerOptions"); err != nil {
+		return
+	}
+	console.mu.Lock()
+	defer console.mu.Unlock()
+	component, response, err := console.edit("EditPeer", typePeer, toObject(editPeerOptions))
+	if err != nil {
+		return
+	}
+	response, err = respond(http.StatusOK, view(component, nil), &result, blockchainv3.UnmarshalPeerResponse)
+	return
+}
+
+// PeerAction : Submit action to a peer
+func (console *Console) PeerActionWithContext(ctx context.Context, peerActionOptions *blockchainv3.PeerActionOptions) (result *blockchainv3.ActionsResponse, response *core.DetailedResponse, err error) {
+	if err = validate(ctx, peerActionOptions, "peerActionOptions"); err != nil {
+		return
+	}
+	actions := nodeActions(peerActionOptions.Restart, peerActionOptions.Reenroll, peerActionOptions.Enroll)
+	if isTrue(peerActionOptions.UpgradeDbs) {
+		actions = append(actions, "upgrade_dbs")
+	}
+	console.mu.Lock()
+	defer console.mu.Unlock()
+	object, response, err := console.act("PeerAction", *peerActionOptions.ID, typePeer, actions)
+	if err != nil {
+		return
+	}
+	response, err = respond(http.StatusAccepted, object, &result, blockchainv3.UnmarshalActionsResponse)
+	return
+}
+
+// UpdatePeer : Update a peer
+func (console *Console) UpdatePeerWithContext(ctx context.Context, updatePeerOptions *blockchainv3.UpdatePeerOptions) (result *blockchainv3.PeerResponse, response *core.DetailedResponse, err error) {
+	if err = validate(ctx, updatePeerOptions, "updatePeerOptions"); err != nil {
+		return
+	}
+	console.mu.Lock()
+	defer console.mu.Unlock()
+	component, response, err := console.update("UpdatePeer", typePeer, toObject(updatePeerOptions))
+	if err != nil {
+		return
+	}
+	response, err = respond(http.StatusOK, component, &result, blockchainv3.UnmarshalPeerResponse)
+	return
+}
+
+// CreateOrderer : Create an ordering service
+func (console *Console) CreateOrdererWithContext(ctx context.Context, createOrdererOptions *blockchainv3.CreateOrdererOptions) (result *blockchainv3.CreateOrdererResponse, response *core.DetailedResponse, err error) {
+	if err = validate(ctx, createOrdererOptions, "createOrdererOptions"); err != nil {
+		return
+	}
+	console.mu.Lock()
+	defer console.mu.Unlock()
+	options := toObject(createOrdererOptions)
+	for _, key := range []string{"crypto", "config_override", "zone", "region"} {
+		delete(options, key)
+	}
+	displayName := *createOrdererOptions.DisplayName
+	clusterName := displayName
+	if createOrdererOptions.ClusterName != nil {
+		clusterName = *createOrdererOptions.ClusterName
+	}
+	clusterID := ""
+	if createOrdererOptions.ClusterID != nil {
+		clusterID = *createOrdererOptions.ClusterID
+	} else {
+		console.nextID++
+		clusterID = fmt.Sprintf("%s%d", normalizeID(clusterName), console.nextID)
+	}
+	if _, ok := options["system_channel_id"]; !ok {
+		options["system_channel_id"] = "testchainid"
+	}
+	options["cluster_name"] = clusterName
+	options["cluster_id"] = clusterID
+
+	// Every crypto object is one ordering node; the ID option only applies to a single node.
+	if len(createOrdererOptions.Crypto) > 1 {
+		delete(options, "id")
+	}
+	var created []interface{}
+	for i, crypto := range createOrdererOptions.Crypto {
+		node := clone(options).(map[string]interface{})
+		if len(createOrdererOptions.Crypto) > 1 {
+			node["display_name"] = fmt.Sprintf("%s_%d", displayName, i+1)
+		}
+		var id string
+		if id, response, err = console.newID("CreateOrderer", node); err != nil {
+			return
+		}
+		component := console.create(id, typeOrderer, node)
+		component["api_url"] = fmt.Sprintf("grpcs://%s-orderer.%s:7050", id, domain)
+		component["grpcwp_url"] = fmt.Sprintf("https://%s-proxy.%s:443", id, domain)
+		component["msp"] = mspFromCrypto(toObject(crypto))
+		if i < len(createOrdererOptions.ConfigOverride) {
+			component["config_override"] = toObject(createOrdererOptions.ConfigOverride[i])
+		}
+		if i < len(createOrdererOptions.Zone) {
+			component["zone"] = createOrdererOptions.Zone[i]
+		}
+		if i < len(createOrdererOptions.Region) {
+			component["region"] = createOrdererOptions.Region[i]
+		}
+		created = append(created, component)
+	}
+	response, err = respond(http.StatusCreated, map[string]interface{}{"created": created}, &result, blockchainv3.UnmarshalCreateOrdererResponse)
+	return
+}
+
+// ImportOrderer : Import an ordering service
+func (console *Console) ImportOrdererWithContext(ctx context.Context, importOrdererOptions *blockchainv3.ImportOrdererOptions) (result *blockchainv3.OrdererResponse, response *core.DetailedResponse, err error) {
+	if err = validate(ctx, importOrdererOptions, "importOrdererOptions"); err != nil {
+		return
+	}
+	console.mu.Lock()
+	defer console.mu.Unlock()
+	options := toObject(importOrdererOptions)
+	id, response, err := console.newID("ImportOrderer", options)
+	if err != nil {
+		return
+	}
+	if _, ok := options["cluster_id"]; !ok {
+		console.nextID++
+		options["cluster_id"] = fmt.Sprintf("%s%d", normalizeID(*importOrdererOptions.ClusterName), console.nextID)
+	}
+	response, err = respond(http.StatusOK, console.add(id, typeOrderer, options), &result, blockchainv3.UnmarshalOrdererResponse)
+	return
+}
+
+// EditOrderer : Edit data about an orderer
+func (console *Console) EditOrdererWithContext(ctx context.Context, editOrdererOptions *blockchainv3.EditOrdererOptions) (result *blockchainv3.OrdererResponse, response *core.DetailedResponse, err error) {
+	if err = validate(ctx, editOrdererOptions, "editOrdererOptions"); err != nil {
+		return
+	}
+	console.mu.Lock()
+	defer console.mu.Unlock()
+	component, response, err := console.edit("EditOrderer", typeOrderer, toObject(editOrdererOptions))
+	if err != nil {
+		return
+	}
+	response, err = respond(http.StatusOK, view(component, nil), &result, blockchainv3.UnmarshalOrdererResponse)
+	return
+}
+
+// OrdererAction : Submit action to an orderer
+func (console *Console) OrdererActionWithContext(ctx context.Context, ordererActionOptions *blockchainv3.OrdererActionOptions) (result *blockchainv3.ActionsResponse, response *core.DetailedResponse, err error) {
+	if err = validate(ctx, ordererActionOptions, "ordererActionOptions"); err != nil {
+		return
+	}
+	actions := nodeActions(ordererActionOptions.Restart, ordererActionOptions.Reenroll, ordererActionOptions.Enroll)
+	console.mu.Lock()
+	defer console.mu.Unlock()
+	object, response, err := console.act("OrdererAction", *ordererActionOptions.ID, typeOrderer, actions)
+	if err != nil {
+		return
+	}
+	response, err = respond(http.StatusAccepted, object, &result, blockchainv3.UnmarshalActionsResponse)
+	return
+}
+
+// UpdateOrderer : Update an orderer node
+func (console *Console) UpdateOrdererWithContext(ctx context.Context, updateOrdererOptions *blockchainv3.UpdateOrdererOptions) (result *blockchainv3.OrdererResponse, response *core.DetailedResponse, err error) {
+	if err = validate(ctx, updateOrdererOptions, "updateOrdererOptions"); err != nil {
+		return
+	}
+	console.mu.Lock()
+	defer console.mu.Unlock()
+	component, response, err := console.update("UpdateOrderer", typeOrderer, toObject(updateOrdererOptions))
+	if err != nil {
+		return
+	}
+	response, err = respond(http.StatusOK, component, &result, blockchainv3.UnmarshalOrdererResponse)
+	return
+}
+
+// SubmitBlock : Submit config block to orderer
+func (console *Console) SubmitBlockWithContext(ctx context.Context, submitBlockOptions *blockchainv3.SubmitBlockOptions) (result *blockchainv3.GenericComponentResponse, response *core.DetailedResponse, err error) {
+	if err = validate(ctx, submitBlockOptions, "submitBlockOptions"); err != nil {
+		return
+	}
+	console.mu.Lock()
+	defer console.mu.Unlock()
+	component, response, err := console.component("SubmitBlock", *submitBlockOptions.ID, typeOrderer)
+	if err != nil {
+		return
+	}
+	console.actions[*submitBlockOptions.ID] = append(console.actions[*submitBlockOptions.ID], "submit_block")
+	response, err = respond(http.StatusOK, view(component, nil), &result, blockchainv3.UnmarshalGenericComponentResponse)
+	return
+}
+
+// ImportMsp : Import an MSP
+func (console *Console) ImportMspWithContext(ctx context.Context, importMspOptions *blockchainv3.ImportMspOptions) (result *blockchainv3.MspResponse, response *core.DetailedResponse, err error) {
+	if err = validate(ctx, importMspOptions, "importMspOptions"); err != nil {
+		return
+	}
+	console.mu.Lock()
+	defer console.mu.Unlock()
+	options := toObject(importMspOptions)
+	id, response, err := console.newID("ImportMsp", options)
+	if err != nil {
+		return
+	}
+	response, err = respond(http.StatusOK, console.add(id, typeMsp, options), &result, blockchainv3.UnmarshalMspResponse)
+	return
+}
+
+// EditMsp : Edit an MSP
+func (console *Console) EditMspWithContext(ctx context.Context, editMspOptions *blockchainv3.EditMspOptions) (result *blockchainv3.MspResponse, response *core.DetailedResponse, err error) {
+	if err = validate(ctx, editMspOptions, "editMspOptions"); err != nil {
+		return
+	}
+	console.mu.Lock()
+	defer console.mu.Unlock()
+	component, response, err := console.edit("EditMsp", typeMsp, toObject(editMspOptions))
+	if err != nil {
+		return
+	}
+	response, err = respond(http.StatusOK, component, &result, blockchainv3.UnmarshalMspResponse)
+	return
+}
+
+// GetMspCertificate : Get MSP's public certificates
+func (console *Console) GetMspCertificateWithContext(ctx context.Context, getMspCertificateOptions *blockchainv3.GetMspCertificateOptions) (result *blockchainv3.GetMSPCertificateResponse, response *core.DetailedResponse, err error) {
+	if err = validate(ctx, getMspCertificateOptions, "getMspCertificateOptions"); err != nil {
+		return
+	}
+	console.mu.Lock()
+	defer console.mu.Unlock()
+	var msps []interface{}
+	for _, component := range console.list("") {
+		if component["type"] != typeMsp || component["msp_id"] != *getMspCertificateOptions.MspID {
+			continue
+		}
+		msp := map[string]interface{}{"msp_id": component["msp_id"]}
+		for _, key := range []string{"root_certs", "admins", "tls_root_certs"} {
+			if certs, ok := component[key]; ok {
+				msp[key] = clone(certs)
+			}
+		}
+		msps = append(msps, msp)
+	}
+	if len(msps) == 0 {
+		response, err = fail("GetMspCertificate", http.StatusNotFound, "no msp with msp_id %q", *getMspCertificateOptions.MspID)
+		return
+	}
+	response, err = respond(http.StatusOK, map[string]interface{}{"msps": msps}, &result, blockchainv3.UnmarshalGetMSPCertificateResponse)
+	return
+}
+
+// EditAdminCerts : Edit admin certs on a component
+func (console *Console) EditAdminCertsWithContext(ctx context.Context, editAdminCertsOptions *blockchainv3.EditAdminCertsOptions) (result *blockchainv3.EditAdminCertsResponse, response *core.DetailedResponse, err error) {
+	if err = validate(ctx, editAdminCertsOptions, "editAdminCertsOptions"); err != nil {
+		return
+	}
+	console.mu.Lock()
+	defer console.mu.Unlock()
+	component, response, err := console.component("EditAdminCerts", *editAdminCertsOptions.ID, "")
+	if err != nil {
+		return
+	}
+	if component["type"] != typePeer && component["type"] != typeOrderer {
+		response, err = fail("EditAdminCerts", http.StatusBadRequest, "component %q is not a peer or an orderer", *editAdminCertsOptions.ID)
+		return
+	}
+
+	mspComponent := object(object(component, "msp"), "component")
+	certs := stringsField(mspComponent, "admin_certs")
+	changes := 0
+	for _, cert := range editAdminCertsOptions.AppendAdminCerts {
+		if indexOf(certs, cert) < 0 {
+			certs = append(certs, cert)
+			changes++
+		}
+	}
+	for _, cert := range editAdminCertsOptions.RemoveAdminCerts {
+		if i := indexOf(certs, cert); i >= 0 {
+			certs = append(certs[:i], certs[i+1:]...)
+			changes++
+		}
+	}
+	mspComponent["admin_certs"] = toList(certs)
+
+	parsed := make([]interface{}, len(certs))
+	for i, cert := range certs {
+		parsed[i] = console.parseCert(cert)
+	}
+	body := map[string]interface{}{"changes_made": float64(changes), "set_admin_certs": parsed}
+	response, err = respond(http.StatusOK, body, &result, blockchainv3.UnmarshalEditAdminCertsResponse)
+	return
+}
+
+// ListComponents : Get all components
+func (console *Console) ListComponentsWithContext(ctx context.Context, listComponentsOptions *blockchainv3.ListComponentsOptions) (result *blockchainv3.GetMultiComponentsResponse, response *core.DetailedResponse, err error) {
+	if err = validate(ctx, listComponentsOptions, "listComponentsOptions"); err != nil {
+		return
+	}
+	console.mu.Lock()
+	defer console.mu.Unlock()
+	response, err = console.respondList(console.list(""), listComponentsOptions.DeploymentAttrs, &result)
+	return
+}
+
+// GetComponentsByType : Get components of a type
+func (console *Console) GetComponentsByTypeWithContext(ctx context.Context, getComponentsByTypeOptions *blockchainv3.GetComponentsByTypeOptions) (result *blockchainv3.GetMultiComponentsResponse, response *core.DetailedResponse, err error) {
+	if err = validate(ctx, getComponentsByTypeOptions, "getComponentsByTypeOptions"); err != nil {
+		return
+	}
+	console.mu.Lock()
+	defer console.mu.Unlock()
+	response, err = console.respondList(console.list(*getComponentsByTypeOptions.Type), getComponentsByTypeOptions.DeploymentAttrs, &result)
+	return
+}
+
+// GetComponentsByTag : Get components with tag
+func (console *Console) GetComponentsByTagWithContext(ctx context.Context, getComponentsByTagOptions *blockchainv3.GetComponentsByTagOptions) (result *blockchainv3.GetMultiComponentsResponse, response *core.DetailedResponse, err error) {
+	if err = validate(ctx, getComponentsByTagOptions, "getComponentsByTagOptions"); err != nil {
+		return
+	}
+	console.mu.Lock()
+	defer console.mu.Unlock()
+	response, err = console.respondList(console.tagged(*getComponentsByTagOptions.Tag), getComponentsByTagOptions.DeploymentAttrs, &result)
+	return
+}
+
+// RemoveComponentsByTag : Remove components with tag
+func (console *Console) RemoveComponentsByTagWithContext(ctx context.Context, removeComponentsByTagOptions *blockchainv3.RemoveComponentsByTagOptions) (result *blockchainv3.RemoveMultiComponentsResponse, response *core.DetailedResponse, err error) {
+	if err = validate(ctx, removeComponentsByTagOptions, "removeComponentsByTagOptions"); err != nil {
+		return
+	}
+	console.mu.Lock()
+	defer console.mu.Unlock()
+	removed, response, err := console.removeTagged("RemoveComponentsByTag", *removeComponentsByTagOptions.Tag)
+	if err != nil {
+		return
+	}
+	response, err = respond(http.StatusOK, map[string]interface{}{"removed": removed}, &result, blockchainv3.UnmarshalRemoveMultiComponentsResponse)
+	return
+}
+
+// DeleteComponentsByTag : Delete components with tag
+func (console *Console) DeleteComponentsByTagWithContext(ctx context.Context, deleteComponentsByTagOptions *blockchainv3.DeleteComponentsByTagOptions) (result *blockchainv3.DeleteMultiComponentsResponse, response *core.DetailedResponse, err error) {
+	if err = validate(ctx, deleteComponentsByTagOptions, "deleteComponentsByTagOptions"); err != nil {
+		return
+	}
+	console.mu.Lock()
+	defer console.mu.Unlock()
+	deleted, response, err := console.removeTagged("DeleteComponentsByTag", *deleteComponentsByTagOptions.Tag)
+	if err != nil {
+		return
+	}
+	response, err = respond(http.StatusOK, map[string]interface{}{"deleted": deleted}, &result, blockchainv3.UnmarshalDeleteMultiComponentsResponse)
+	return
+}
+
+// DeleteAllComponents : Delete all components
+func (console *Console) DeleteAllComponentsWithContext(ctx context.Context, deleteAllComponentsOptions *blockchainv3.DeleteAllComponentsOptions) (result *blockchainv3.DeleteMultiComponentsResponse, response *core.DetailedResponse, err error) {
+	if err = validate(ctx, deleteAllComponentsOptions, "deleteAllComponentsOptions"); err != nil {
+		return
+	}
+	console.mu.Lock()
+	defer console.mu.Unlock()
+	var deleted []interface{}
+	for _, id := range append([]string(nil), console.order...) {
+		deleted = append(deleted, console.remove(id))
+	}
+	response, err = respond(http.StatusOK, map[string]interface{}{"deleted": deleted}, &result, blockchainv3.UnmarshalDeleteMultiComponentsResponse)
+	return
+}
+
+// component returns the stored component with an ID, or a *blockchainv3.NotFoundError if there is none of the type.
+// An empty type matches every component.
+func (console *Console) component(operation, id, componentType string) (map[string]interface{}, *core.DetailedResponse, error) {
+	component, ok := console.components[id]
+	if !ok || (componentType != "" && component["type"] != componentType) {
+		response, err := fail(operation, http.StatusNotFound, "component %q not found", id)
+		return nil, response, err
+	}
+	return component, nil, nil
+}
+
+// list returns the stored components of a type in the order they were added. An empty type matches every component.
+func (console *Console) list(componentType string) []map[string]interface{} {
+	var components []map[string]interface{}
+	for _, id := range console.order {
+		if component := console.components[id]; componentType == "" || component["type"] == componentType {
+			components = append(components, component)
+		}
+	}
+	return components
+}
+
+// tagged returns the stored components with a tag in the order they were added.
+func (console *Console) tagged(tag string) []map[string]interface{} {
+	var components []map[string]interface{}
+	for _, component := range console.list("") {
+		if indexOf(stringsField(component, "tags"), tag) >= 0 {
+			components = append(components, component)
+		}
+	}
+	return components
+}
+
+func (console *Console) respondList(components []map[string]interface{}, deploymentAttrs *string, result **blockchainv3.GetMultiComponentsResponse) (*core.DetailedResponse, error) {
+	views := make([]interface{}, len(components))
+	for i, component := range components {
+		views[i] = view(component, deploymentAttrs)
+	}
+	return respond(http.StatusOK, map[string]interface{}{"components": views}, result, blockchainv3.UnmarshalGetMultiComponentsResponse)
+}
+
+// newID returns the ID requested in the options, or derives one from the display name the way the console does.
+func (console *Console) newID(operation string, options map[string]interface{}) (string, *core.DetailedResponse, error) {
+	if id := stringField(options, "id"); id != "" {
+		if _, taken := console.components[id]; taken {
+			response, err := fail(operation, http.StatusConflict, "the id %q is already in use", id)
+			return "", response, err
+		}
+		return id, nil, nil
+	}
+	base := normalizeID(stringField(options, "display_name"))
+	id := base
+	for i := 1; console.components[id] != nil; i++ {
+		id = fmt.Sprintf("%s_%d", base, i)
+	}
+	return id, nil, nil
+}
+
+// normalizeID keeps the lower case letters and digits of a name.
+func normalizeID(name string) string {
+	id := strings.Map(func(r rune) rune {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			return r
+		}
+		return -1
+	}, strings.ToLower(name))
+	if id == "" {
+		return "component"
+	}
+	return id
+}
+
+// add stores a new component built from the options of an import.
+func (console *Console) add(id, componentType string, component map[string]interface{}) map[string]interface{} {
+	component["id"] = id
+	component["type"] = componentType
+	component["timestamp"] = console.now()
+	component["scheme_version"] = "v2"
+	console.components[id] = component
+	console.order = append(console.order, id)
+	return component
+}
+
+// create stores a new component built from the options of a create, with the fields the console sets on deployment.
+func (console *Console) create(id, componentType string, component map[string]interface{}) map[string]interface{} {
+	component["dep_component_id"] = id
+	component["location"] = locationDeployed
+	component["operations_url"] = fmt.Sprintf("https://%s-operations.%s:9443", id, domain)
+	if stringField(component, "version") == "" {
+		component["version"] = console.defaultVersion(componentType)
+	}
+	return console.add(id, componentType, component)
+}
+
+// update applies the options of an update to a component created by the console.
+func (console *Console) update(operation, componentType string, options map[string]interface{}) (map[string]interface{}, *core.DetailedResponse, error) {
+	id := stringField(options, "id")
+	component, response, err := console.component(operation, id, componentType)
+	if err != nil {
+		return nil, response, err
+	}
+	if component["location"] != locationDeployed {
+		response, err = fail(operation, http.StatusBadRequest, "component %q was imported and cannot be updated", id)
+		return nil, response, err
+	}
+	delete(options, "id")
+	if certs, ok := options["admin_certs"]; ok {
+		delete(options, "admin_certs")
+		object(object(component, "msp"), "component")["admin_certs"] = certs
+	}
+	if crypto, ok := options["crypto"].(map[string]interface{}); ok {
+		delete(options, "crypto")
+		merge(object(component, "msp"), mspFromCrypto(crypto))
+	}
+	merge(component, options)
+	component["timestamp"] = console.now()
+	return component, nil, nil
+}
+
+// edit applies the options of an edit to a component.
+func (console *Console) edit(operation, componentType string, options map[string]interface{}) (map[string]interface{}, *core.DetailedResponse, error) {
+	component, response, err := console.component(operation, stringField(options, "id"), componentType)
+	if err != nil {
+		return nil, response, err
+	}
+	delete(options, "id")
+	merge(component, options)
+	component["timestamp"] = console.now()
+	return component, nil, nil
+}
+
+// remove deletes a component and returns the console's report of the deletion.
+func (console *Console) remove(id string) map[string]interface{} {
+	component := console.components[id]
+	delete(console.components, id)
+	delete(console.notReady, id)
+	for i, ordered := range console.order {
+		if ordered == id {
+			console.order = append(console.order[:i], console.order[i+1:]...)
+			break
+		}
+	}
+	return map[string]interface{}{
+		"message":      "deleted",
+		"type":         component["type"],
+		"id":           id,
+		"display_name": component["display_name"],
+	}
+}
+
+// removeTagged removes the components with a tag, or returns a *blockchainv3.NotFoundError if there are none.
+func (console *Console) removeTagged(operation, tag string) ([]interface{}, *core.DetailedResponse, error) {
+	components := console.tagged(tag)
+	if len(components) == 0 {
+		response, err := fail(operation, http.StatusNotFound, "no components with the tag %q", tag)
+		return nil, response, err
+	}
+	removed := make([]interface{}, len(components))
+	for i, component := range components {
+		removed[i] = console.remove(stringField(component, "id"))
+	}
+	return removed, nil, nil
+}
+
+// act records the actions requested on a component and returns the console's acknowledgement.
+func (console *Console) act(operation, id, componentType string, actions []string) (map[string]interface{}, *core.DetailedResponse, error) {
+	if _, response, err := console.component(operation, id, componentType); err != nil {
+		return nil, response, err
+	}
+	if len(actions) == 0 {
+		response, err := fail(operation, http.StatusBadRequest, "no action was requested")
+		return nil, response, err
+	}
+	console.actions[id] = append(console.actions[id], actions...)
+	return map[string]interface{}{"message": "accepted", "id": id, "actions": toList(actions)}, nil, nil
+}
+
+// nodeActions returns the names of the actions requested on a peer or an orderer.
+func nodeActions(restart *bool, reenroll *blockchainv3.ActionReenroll, enroll *blockchainv3.ActionEnroll) []string {
+	var actions []string
+	if isTrue(restart) {
+		actions = append(actions, "restart")
+	}
+	if reenroll != nil && isTrue(reenroll.Ecert) {
+		actions = append(actions, "reenroll_ecert")
+	}
+	if reenroll != nil && isTrue(reenroll.TlsCert) {
+		actions = append(actions, "reenroll_tls_cert")
+	}
+	if enroll != nil && isTrue(enroll.Ecert) {
+		actions = append(actions, "enroll_ecert")
+	}
+	if enroll != nil && isTrue(enroll.TlsCert) {
+		actions = append(actions, "enroll_tls_cert")
+	}
+	return actions
+}
+
+// defaultVersion returns the default Fabric version of a component type.
+func (console *Console) defaultVersion(componentType string) string {
+	versions, _ := console.fabVersions["versions"].(map[string]interface{})
+	byVersion, _ := versions[strings.TrimPrefix(componentType, "fabric-")].(map[string]interface{})
+	for version, details := range byVersion {
+		if details, ok := details.(map[string]interface{}); ok && details["default"] == true {
+			return version
+		}
+	}
+	return ""
+}
+
+// parseCert returns the parsed form of a base 64 encoded PEM certificate, as reported by the console.
+func (console *Console) parseCert(cert string) map[string]interface{} {
+	parsed := map[string]interface{}{"base_64_pem": cert}
+	der, err := certs.DER(cert)
+	if err != nil {
+		return parsed
+	}
+	certificate, err := x509.ParseCertificate(der)
+	if err != nil {
+		return parsed
+	}
+	parsed["issuer"] = certificate.Issuer.String()
+	parsed["subject"] = certificate.Subject.String()
+	parsed["serial_number_hex"] = certificate.SerialNumber.Text(16)
+	parsed["signature_algorithm"] = certificate.SignatureAlgorithm.String()
+	parsed["X509_version"] = float64(certificate.Version)
+	parsed["not_before_ts"] = float64(certificate.NotBefore.UnixNano() / int64(time.Millisecond))
+	parsed["not_after_ts"] = float64(certificate.NotAfter.UnixNano() / int64(time.Millisecond))
+	parsed["time_left"] = fmt.Sprintf("%.1f days", certificate.NotAfter.Sub(console.Now()).Hours()/24)
+	return parsed
+}
+
+// view returns the fields of a component the console returns, without its deployment attributes unless they are
+// included.
+func view(component map[string]interface{}, include *string) map[string]interface{} {
+	copied := clone(component).(map[string]interface{})
+	if include == nil || *include != blockchainv3.GetComponentOptions_DeploymentAttrs_Included {
+		for _, key := range deploymentAttrs {
+			delete(copied, key)
+		}
+	}
+	return copied
+}
+
+// mspFromCrypto returns the public MSP data of a crypto object: its certificates, without the private keys and
+// enrollment secrets.
+func mspFromCrypto(crypto map[string]interface{}) map[string]interface{} {
+	msp := map[string]interface{}{}
+	if cryptoMsp, ok := crypto["msp"].(map[string]interface{}); ok {
+		copyFields(msp, "component", cryptoMsp["component"], "ecert", "tls_cert", "admin_certs")
+		copyFields(msp, "ca", cryptoMsp["ca"], "root_certs")
+		copyFields(msp, "tlsca", cryptoMsp["tlsca"], "root_certs")
+	}
+	if enrollment, ok := crypto["enrollment"].(map[string]interface{}); ok {
+		copyFields(msp, "ca", enrollment["ca"], "name")
+		copyFields(msp, "tlsca", enrollment["tlsca"], "name")
+		if component, ok := enrollment["component"].(map[string]interface{}); ok && component["admincerts"] != nil {
+			object(msp, "component")["admin_certs"] = clone(component["admincerts"])
+		}
+	}
+	return msp
+}
+
+// copyFields copies the named fields of src, if any, into the nested object key of dst.
+func copyFields(dst map[string]interface{}, key string, src interface{}, fields ...string) {
+	srcObject, ok := src.(map[string]interface{})
+	if !ok {
+		return
+	}
+	for _, field := range fields {
+		if value, ok := srcObject[field]; ok {
+			object(dst, key)[field] = clone(value)
+		}
+	}
+}
+
+func isTrue(value *bool) bool {
+	return value != nil && *value
+}
+
+func indexOf(values []string, value string) int {
+	for i, candidate := range values {
+		if candidate == value {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,258 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package blockchainv3fake provides Console, a stateful in-memory implementation of blockchainv3.BlockchainV3API for
+// tests. It stores components, MSPs, tags, settings and notifications, assigns IDs and timestamps the way the IBP
+// console does, and reports failures with the same error types as the real client:
+//
+//	console := blockchainv3fake.NewConsole()
+//	provisioner := NewProvisioner(console) // takes a blockchainv3.BlockchainV3API
+//
+// Nothing is deployed: created components are ready at once unless SetComponentReady says otherwise, and actions such
+// as restarts or re-enrollments are only recorded.
+package blockchainv3fake
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/IBM-Blockchain/ibp-go-sdk/blockchainv3"
+	"github.com/IBM/go-sdk-core/v4/core"
+	"net/http"
+	"reflect"
+	"sync"
+	"time"
+)
+
+var _ blockchainv3.BlockchainV3API = (*Console)(nil)
+
+// domain is the host name suffix of the URLs of created components.
+const domain = "fake.console.local"
+
+// Console : An in-memory IBP console. The zero value is not usable; create one with NewConsole.
+// A Console is safe for concurrent use.
+type Console struct {
+	// Now returns the current time, used for timestamps. Defaults to time.Now.
+	Now func() time.Time
+
+	mu            sync.Mutex
+	components    map[string]map[string]interface{}
+	order         []string
+	settings      map[string]interface{}
+	fabVersions   map[string]interface{}
+	health        map[string]interface{}
+	notifications []*notification
+	notReady      map[string]bool
+	actions       map[string][]string
+	sigTxs        map[string]map[string]interface{}
+	sigTxOrder    []string
+	nextID        int
+}
+
+// NewConsole returns an empty console with default settings, Fabric versions and health stats.
+func NewConsole() *Console {
+	console := &Console{
+		Now:        time.Now,
+		components: map[string]map[string]interface{}{},
+		notReady:   map[string]bool{},
+		actions:    map[string][]string{},
+		sigTxs:     map[string]map[string]interface{}{},
+	}
+	console.settings = mustObject(defaultSettings)
+	console.fabVersions = mustObject(defaultFabVersions)
+	console.health = mustObject(defaultHealth)
+	return console
+}
+
+// SetComponentReady sets whether WaitForComponentReady reports a component as ready. Components are ready by default.
+func (console *Console) SetComponentReady(id string, ready bool) {
+	console.mu.Lock()
+	defer console.mu.Unlock()
+	console.notReady[id] = !ready
+}
+
+// Actions returns the actions (e.g. "restart", "reenroll_ecert") requested on a component, in order.
+func (console *Console) Actions(id string) []string {
+	console.mu.Lock()
+	defer console.mu.Unlock()
+	return append([]string(nil), console.actions[id]...)
+}
+
+// WaitForComponentReady : Wait for a component to be ready
+// The fake answers at once: nil if the component exists and is ready, a *blockchainv3.NotFoundError if it does not
+// exist, and a *blockchainv3.ComponentNotReadyError if SetComponentReady marked it as not ready.
+func (console *Console) WaitForComponentReady(ctx context.Context, id string, options *blockchainv3.WaitForComponentReadyOptions) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	console.mu.Lock()
+	defer console.mu.Unlock()
+	component, _, err := console.component("GetComponent", id, "")
+	if err != nil {
+		return err
+	}
+	if console.notReady[id] {
+		return &blockchainv3.ComponentNotReadyError{
+			ComponentID: id,
+			Type:        stringField(component, "type"),
+			LastErr:     errors.New("the component was marked as not ready"),
+		}
+	}
+	return nil
+}
+
+// now returns the current time in milliseconds since the epoch, the unit of console timestamps.
+func (console *Console) now() float64 {
+	return float64(console.Now().UnixNano() / int64(time.Millisecond))
+}
+
+// validate checks the context and options the way the client does before sending a request.
+func validate(ctx context.Context, options interface{}, name string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return core.ValidateStruct(options, name)
+}
+
+// respond decodes a JSON object into result, a pointer to a pointer to a model, with the model's generated
+// unmarshaller, the way the client decodes a response body.
+func respond(status int, object interface{}, result interface{}, unmarshal core.ModelUnmarshaller) (*core.DetailedResponse, error) {
+	data, err := json.Marshal(object)
+	if err != nil {
+		return nil, err
+	}
+	var raw map[string]json.RawMessage
+	if err = json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+	if err = core.UnmarshalModel(raw, "", result, unmarshal); err != nil {
+		return nil, err
+	}
+	return &core.DetailedResponse{
+		StatusCode: status,
+		Headers:    http.Header{"Content-Type": []string{"application/json"}},
+		Result:     reflect.ValueOf(result).Elem().Interface(),
+	}, nil
+}
+
+// fail returns the response and error the client returns when the console answers with an error status.
+func fail(operation string, status int, format string, args ...interface{}) (*core.DetailedResponse, error) {
+	msg := fmt.Sprintf(format, args...)
+	response := &core.DetailedResponse{
+		StatusCode: status,
+		Headers:    http.Header{"Content-Type": []string{"application/json"}},
+		Result:     map[string]interface{}{"statusCode": float64(status), "msg": msg},
+	}
+	return response, blockchainv3.NewServiceError(operation, response, errors.New(msg))
+}
+
+// notImplemented returns the error of the operations the fake does not implement.
+func notImplemented(operation string) error {
+	_, err := fail(operation, http.StatusNotImplemented, "%s is not implemented by the fake console", operation)
+	return err
+}
+
+// toObject converts options or a model to its JSON object, without the Headers field of options.
+func toObject(value interface{}) map[string]interface{} {
+	object := map[string]interface{}{}
+	if core.IsNil(value) {
+		return object
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		panic(err)
+	}
+	if err = json.Unmarshal(data, &object); err != nil {
+		panic(err)
+	}
+	delete(object, "Headers")
+	return object
+}
+
+func mustObject(src string) map[string]interface{} {
+	object := map[string]interface{}{}
+	if err := json.Unmarshal([]byte(src), &object); err != nil {
+		panic(err)
+	}
+	return object
+}
+
+// clone returns a deep copy of a JSON value.
+func clone(value interface{}) interface{} {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		copied := make(map[string]interface{}, len(value))
+		for key, field := range value {
+			copied[key] = clone(field)
+		}
+		return copied
+	case []interface{}:
+		copied := make([]interface{}, len(value))
+		for i, item := range value {
+			copied[i] = clone(item)
+		}
+		return copied
+	}
+	return value
+}
+
+// merge copies the fields of src into dst, merging nested objects and replacing everything else.
+func merge(dst, src map[string]interface{}) {
+	for key, value := range src {
+		if value, ok := value.(map[string]interface{}); ok {
+			if existing, ok := dst[key].(map[string]interface{}); ok {
+				merge(existing, value)
+				continue
+			}
+		}
+		dst[key] = clone(value)
+	}
+}
+
+// object returns the nested object at key, creating it if needed.
+func object(parent map[string]interface{}, key string) map[string]interface{} {
+	child, ok := parent[key].(map[string]interface{})
+	if !ok {
+		child = map[string]interface{}{}
+		parent[key] = child
+	}
+	return child
+}
+
+func stringField(object map[string]interface{}, key string) string {
+	value, _ := object[key].(string)
+	return value
+}
+
+func stringsField(object map[string]interface{}, key string) []string {
+	var values []string
+	items, _ := object[key].([]interface{})
+	for _, item := range items {
+		if value, ok := item.(string); ok {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+func toList(values []string) []interface{} {
+	list := make([]interface{}, len(values))
+	for i, value := range values {
+		list[i] = value
+	}
+	return list
+}
@@ -0,0 +1,414 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package blockchainv3fake_test
+
+import (
+	"context"
+	"crypto/x509/pkix"
+	"errors"
+	"github.com/IBM-Blockchain/ibp-go-sdk/blockchainv3"
+	"github.com/IBM-Blockchain/ibp-go-sdk/blockchainv3fake"
+	"github.com/IBM-Blockchain/ibp-go-sdk/internal/testutil"
+	"github.com/IBM/go-sdk-core/v4/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"time"
+)
+
+func peerCrypto(ecert string) *blockchainv3.CryptoObject {
+	crypto := testutil.CryptoObject()
+	crypto.Msp.Component.Ecert = core.StringPtr(ecert)
+	return crypto
+}
+
+var _ = Describe(`Console`, func() {
+	var console *blockchainv3fake.Console
+	var api blockchainv3.BlockchainV3API
+	now := time.Date(2021, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	BeforeEach(func() {
+		console = blockchainv3fake.NewConsole()
+		console.Now = func() time.Time { return now }
+		api = console
+	})
+
+	createPeer := func(displayName string, tags ...string) *blockchainv3.PeerResponse {
+		options := api.NewCreatePeerOptions("org1msp", displayName, peerCrypto("ecert"))
+		options.SetTags(tags)
+		options.SetZone("dal10")
+		peer, response, err := api.CreatePeer(options)
+		Expect(err).To(BeNil())
+		Expect(response.StatusCode).To(Equal(201))
+		return peer
+	}
+
+	Describe(`Components`, func() {
+		It(`Creates components with the IDs, URLs and defaults of the console`, func() {
+			peer := createPeer("Org1 Peer")
+			Expect(*peer.ID).To(Equal("org1peer"))
+			Expect(*peer.Type).To(Equal("fabric-peer"))
+			Expect(*peer.Version).To(Equal("2.2.1-4"))
+			Expect(*peer.StateDb).To(Equal("couchdb"))
+			Expect(*peer.Timestamp).To(Equal(float64(now.Unix() * 1000)))
+			Expect(*peer.OperationsURL).To(HavePrefix("https://org1peer-operations."))
+			Expect(*peer.Msp.Component.Ecert).To(Equal("ecert"))
+			Expect(peer.Msp.Component.AdminCerts).To(Equal([]string{"admin"}))
+			Expect(peer.Msp.Ca.RootCerts).To(Equal([]string{"root"}))
+
+			second := createPeer("Org1 Peer")
+			Expect(*second.ID).To(Equal("org1peer_1"))
+
+			options := api.NewCreatePeerOptions("org1msp", "Another peer", peerCrypto("ecert"))
+			options.SetID("org1peer")
+			_, response, err := api.CreatePeer(options)
+			var conflict *blockchainv3.ConflictError
+			Expect(errors.As(err, &conflict)).To(BeTrue())
+			Expect(response.StatusCode).To(Equal(409))
+		})
+		It(`Returns the deployment attributes only when they are included`, func() {
+			createPeer("peer")
+			getOptions := api.NewGetComponentOptions("peer")
+			component, _, err := api.GetComponent(getOptions)
+			Expect(err).To(BeNil())
+			Expect(component.Zone).To(BeNil())
+			Expect(*component.Msp.Component.Ecert).To(Equal("ecert"))
+
+			getOptions.SetDeploymentAttrs(blockchainv3.GetComponentOptions_DeploymentAttrs_Included)
+			component, _, err = api.GetComponent(getOptions)
+			Expect(err).To(BeNil())
+			Expect(*component.Zone).To(Equal("dal10"))
+		})
+		It(`Reports unknown components and invalid options like the client`, func() {
+			_, response, err := api.GetComponent(api.NewGetComponentOptions("missing"))
+			var notFound *blockchainv3.NotFoundError
+			Expect(errors.As(err, &notFound)).To(BeTrue())
+			Expect(notFound.Operation).To(Equal("GetComponent"))
+			Expect(response.StatusCode).To(Equal(404))
+
+			_, response, err = api.GetComponent(nil)
+			Expect(err).ToNot(BeNil())
+			Expect(response).To(BeNil())
+
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+			_, _, err = api.ListComponentsWithContext(ctx, api.NewListComponentsOptions())
+			Expect(err).To(Equal(context.Canceled))
+		})
+		It(`Updates created components and edits any component`, func() {
+			createPeer("peer")
+			updateOptions := api.NewUpdatePeerOptions("peer")
+			updateOptions.SetVersion("2.2.1-5")
+			updateOptions.SetAdminCerts([]string{"admin2"})
+			updated, _, err := api.UpdatePeer(updateOptions)
+			Expect(err).To(BeNil())
+			Expect(*updated.Version).To(Equal("2.2.1-5"))
+			Expect(updated.Msp.Component.AdminCerts).To(Equal([]string{"admin2"}))
+			Expect(*updated.Msp.Component.Ecert).To(Equal("ecert"))
+
+			importOptions := api.NewImportPeerOptions("Imported", "https://proxy", &blockchainv3.MspCryptoField{
+				Tlsca:     &blockchainv3.MspCryptoFieldTlsca{RootCerts: []string{"tlsroot"}},
+				Component: &blockchainv3.MspCryptoFieldComponent{TlsCert: core.StringPtr("tlscert")},
+			}, "org2msp")
+			_, _, err = api.ImportPeer(importOptions)
+			Expect(err).To(BeNil())
+			_, _, err = api.UpdatePeer(api.NewUpdatePeerOptions("imported"))
+			var invalid *blockchainv3.ValidationError
+			Expect(errors.As(err, &invalid)).To(BeTrue())
+
+			editOptions := api.NewEditPeerOptions("imported")
+			editOptions.SetDisplayName("Renamed")
+			edited, _, err := api.EditPeer(editOptions)
+			Expect(err).To(BeNil())
+			Expect(*edited.DisplayName).To(Equal("Renamed"))
+			Expect(*edited.GrpcwpURL).To(Equal("https://proxy"))
+
+			_, _, err = api.EditCa(api.NewEditCaOptions("imported"))
+			var notFound *blockchainv3.NotFoundError
+			Expect(errors.As(err, &notFound)).To(BeTrue())
+		})
+		It(`Creates an ordering node per crypto object in one cluster`, func() {
+			cryptos := []blockchainv3.CryptoObject{*peerCrypto("e1"), *peerCrypto("e2"), *peerCrypto("e3")}
+			options := api.NewCreateOrdererOptions("raft", "osmsp", "Orderer", cryptos)
+			options.SetZone([]string{"dal10", "dal12", "dal13"})
+			created, _, err := api.CreateOrderer(options)
+			Expect(err).To(BeNil())
+			Expect(created.Created).To(HaveLen(3))
+			for i, node := range created.Created {
+				Expect(*node.DisplayName).To(Equal([]string{"Orderer_1", "Orderer_2", "Orderer_3"}[i]))
+				Expect(*node.ClusterID).To(Equal(*created.Created[0].ClusterID))
+				Expect(*node.ClusterName).To(Equal("Orderer"))
+				Expect(*node.Zone).To(Equal(options.Zone[i]))
+				Expect(*node.Msp.Component.Ecert).To(Equal(*cryptos[i].Msp.Component.Ecert))
+			}
+
+			orderers, _, err := api.GetComponentsByType(api.NewGetComponentsByTypeOptions("fabric-orderer"))
+			Expect(err).To(BeNil())
+			Expect(orderers.Components).To(HaveLen(3))
+		})
+		It(`Finds and removes components by tag`, func() {
+			createPeer("peer1", "org1")
+			createPeer("peer2", "org1")
+			createPeer("peer3", "org2")
+
+			tagged, _, err := api.GetComponentsByTag(api.NewGetComponentsByTagOptions("org1"))
+			Expect(err).To(BeNil())
+			Expect(tagged.Components).To(HaveLen(2))
+
+			removed, _, err := api.RemoveComponentsByTag(api.NewRemoveComponentsByTagOptions("org1"))
+			Expect(err).To(BeNil())
+			Expect(removed.Removed).To(HaveLen(2))
+			Expect(*removed.Removed[0].ID).To(Equal("peer1"))
+
+			_, _, err = api.DeleteComponentsByTag(api.NewDeleteComponentsByTagOptions("org1"))
+			var notFound *blockchainv3.NotFoundError
+			Expect(errors.As(err, &notFound)).To(BeTrue())
+
+			all, _, err := api.ListComponents(api.NewListComponentsOptions())
+			Expect(err).To(BeNil())
+			Expect(all.Components).To(HaveLen(1))
+			Expect(*all.Components[0].ID).To(Equal("peer3"))
+		})
+		It(`Records actions`, func() {
+			createPeer("peer")
+			options := api.NewPeerActionOptions("peer")
+			options.SetRestart(true)
+			options.SetReenroll(&blockchainv3.ActionReenroll{Ecert: core.BoolPtr(true)})
+			result, response, err := api.PeerAction(options)
+			Expect(err).To(BeNil())
+			Expect(response.StatusCode).To(Equal(202))
+			Expect(result.Actions).To(Equal([]string{"restart", "reenroll_ecert"}))
+			Expect(console.Actions("peer")).To(Equal([]string{"restart", "reenroll_ecert"}))
+
+			_, _, err = api.PeerAction(api.NewPeerActionOptions("peer"))
+			var invalid *blockchainv3.ValidationError
+			Expect(errors.As(err, &invalid)).To(BeTrue())
+		})
+		It(`Waits for components`, func() {
+			createPeer("peer")
+			Expect(api.WaitForComponentReady(context.Background(), "peer", nil)).To(Succeed())
+
+			console.SetComponentReady("peer", false)
+			err := api.WaitForComponentReady(context.Background(), "peer", nil)
+			var notReady *blockchainv3.ComponentNotReadyError
+			Expect(errors.As(err, &notReady)).To(BeTrue())
+			Expect(notReady.Type).To(Equal("fabric-peer"))
+
+			err = api.WaitForComponentReady(context.Background(), "missing", nil)
+			var notFound *blockchainv3.NotFoundError
+			Expect(errors.As(err, &notFound)).To(BeTrue())
+		})
+	})
+
+	Describe(`MSPs`, func() {
+		It(`Stores MSPs and returns their public certificates`, func() {
+			msp, _, err := api.ImportMsp(api.NewImportMspOptions("org1msp", "Org1 MSP", []string{"root"}))
+			Expect(err).To(BeNil())
+			Expect(*msp.ID).To(Equal("org1msp"))
+			Expect(*msp.Type).To(Equal("msp"))
+
+			editOptions := api.NewEditMspOptions("org1msp")
+			editOptions.SetAdmins([]string{"admin"})
+			_, _, err = api.EditMsp(editOptions)
+			Expect(err).To(BeNil())
+
+			certs, _, err := api.GetMspCertificate(api.NewGetMspCertificateOptions("org1msp"))
+			Expect(err).To(BeNil())
+			Expect(certs.Msps).To(HaveLen(1))
+			Expect(certs.Msps[0].RootCerts).To(Equal([]string{"root"}))
+			Expect(certs.Msps[0].Admins).To(Equal([]string{"admin"}))
+
+			_, _, err = api.GetMspCertificate(api.NewGetMspCertificateOptions("org2msp"))
+			var notFound *blockchainv3.NotFoundError
+			Expect(errors.As(err, &notFound)).To(BeTrue())
+		})
+		It(`Edits the admin certificates of nodes`, func() {
+			createPeer("peer")
+			notAfter := now.Add(30 * 24 * time.Hour)
+			admin := testutil.SelfSignedCert(pkix.Name{CommonName: "admin2"}, 42, notAfter)
+			options := api.NewEditAdminCertsOptions("peer")
+			options.SetAppendAdminCerts([]string{admin})
+			options.SetRemoveAdminCerts([]string{"admin"})
+			result, _, err := api.EditAdminCerts(options)
+			Expect(err).To(BeNil())
+			Expect(*result.ChangesMade).To(Equal(float64(2)))
+			Expect(result.SetAdminCerts).To(HaveLen(1))
+			Expect(*result.SetAdminCerts[0].Subject).To(Equal("CN=admin2"))
+			Expect(*result.SetAdminCerts[0].SerialNumberHex).To(Equal("2a"))
+			Expect(*result.SetAdminCerts[0].NotAfterTs).To(Equal(float64(notAfter.Unix() * 1000)))
+
+			component, _, err := api.GetComponent(api.NewGetComponentOptions("peer"))
+			Expect(err).To(BeNil())
+			Expect(component.Msp.Component.AdminCerts).To(Equal([]string{admin}))
+		})
+	})
+
+	Describe(`Settings`, func() {
+		It(`Edits settings`, func() {
+			options := api.NewEditSettingsOptions()
+			options.SetMaxReqPerMin(100)
+			options.SetFabricGeneralTimeoutMs(5000)
+			options.SetInactivityTimeouts(&blockchainv3.EditSettingsBodyInactivityTimeouts{Enabled: core.BoolPtr(true)})
+			_, _, err := api.EditSettings(options)
+			Expect(err).To(BeNil())
+
+			settings, _, err := api.GetSettings(api.NewGetSettingsOptions())
+			Expect(err).To(BeNil())
+			Expect(*settings.MAXREQPERMIN).To(Equal(float64(100)))
+			Expect(*settings.MAXREQPERMINAK).To(Equal(float64(25)))
+			Expect(*settings.INACTIVITYTIMEOUTS.Enabled).To(BeTrue())
+			Expect(*settings.INACTIVITYTIMEOUTS.MaxIdleTime).To(Equal(float64(90000)))
+			Expect(settings.TIMEOUTS).To(HaveKeyWithValue("fabric_general_timeout_ms", float64(5000)))
+			Expect(settings.CRN).ToNot(BeNil())
+		})
+		It(`Uses the default Fabric versions for new components`, func() {
+			versions := &blockchainv3.GetFabricVersionsResponse{Versions: &blockchainv3.GetFabricVersionsResponseVersions{
+				Peer: &blockchainv3.FabricVersionDictionary{},
+			}}
+			versions.Versions.Peer.SetProperty("2.2.2-0", map[string]interface{}{"default": true, "version": "2.2.2-0"})
+			console.SetFabVersions(versions)
+
+			result, _, err := api.GetFabVersions(api.NewGetFabVersionsOptions())
+			Expect(err).To(BeNil())
+			Expect(result.Versions.Peer.GetProperty("2.2.2-0")).ToNot(BeNil())
+			Expect(*createPeer("peer").Version).To(Equal("2.2.2-0"))
+		})
+	})
+
+	Describe(`Notifications`, func() {
+		It(`Lists, filters and archives notifications`, func() {
+			for i, componentID := range []string{"peer", "ca", "peer"} {
+				console.AddNotification(blockchainv3.NotificationData{
+					Type:      core.StringPtr("component"),
+					TsDisplay: core.Float64Ptr(float64(i)),
+				}, componentID)
+			}
+
+			all, _, err := api.ListNotifications(api.NewListNotificationsOptions())
+			Expect(err).To(BeNil())
+			Expect(*all.Total).To(Equal(float64(3)))
+			Expect(*all.Notifications[0].TsDisplay).To(Equal(float64(2)))
+
+			options := api.NewListNotificationsOptions()
+			options.SetComponentID("peer")
+			options.SetSkip(1)
+			options.SetLimit(1)
+			page, _, err := api.ListNotifications(options)
+			Expect(err).To(BeNil())
+			Expect(*page.Total).To(Equal(float64(2)))
+			Expect(*page.Returning).To(Equal(float64(1)))
+			Expect(*page.Notifications[0].TsDisplay).To(Equal(float64(0)))
+
+			archived, _, err := api.ArchiveNotifications(api.NewArchiveNotificationsOptions([]string{*all.Notifications[0].ID}))
+			Expect(err).To(BeNil())
+			Expect(*archived.Details).To(Equal("archived 1 notification(s)"))
+			Expect(console.UpdateNotification(*all.Notifications[1].ID, "success", "done")).To(BeTrue())
+			Expect(console.UpdateNotification("missing", "success", "done")).To(BeFalse())
+			all, _, err = api.ListNotifications(api.NewListNotificationsOptions())
+			Expect(err).To(BeNil())
+			Expect(*all.Total).To(Equal(float64(2)))
+			Expect(*all.Notifications[0].Status).To(Equal("success"))
+			Expect(*all.Notifications[0].Message).To(Equal("done"))
+		})
+		It(`Watches notifications`, func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			events := api.WatchNotifications(ctx, &blockchainv3.NotificationFilter{
+				ComponentID:  "peer",
+				AutoArchive:  true,
+				PollInterval: 10 * time.Millisecond,
+			})
+			console.AddNotification(blockchainv3.NotificationData{Status: core.StringPtr("success")}, "ca")
+			id := console.AddNotification(blockchainv3.NotificationData{Status: core.StringPtr("success")}, "peer")
+
+			var event *blockchainv3.NotificationEvent
+			Eventually(events, time.Second).Should(Receive(&event))
+			Expect(*event.Notification.ID).To(Equal(id))
+			Eventually(func() float64 {
+				all, _, err := api.ListNotifications(api.NewListNotificationsOptions())
+				Expect(err).To(BeNil())
+				return *all.Total
+			}).Should(Equal(float64(1)))
+		})
+	})
+
+	Describe(`Signature collections`, func() {
+		org := func(mspID string) blockchainv3.SignatureCollectionOrg {
+			return blockchainv3.SignatureCollectionOrg{MspID: core.StringPtr(mspID)}
+		}
+
+		It(`Collects the signatures before the submission`, func() {
+			options := api.NewCreateSigTxOptions("abcdef", "channel1", "cHJvcG9zYWw=", []blockchainv3.SignatureCollectionOrg{org("org1"), org("org2")})
+			created, _, err := api.CreateSigTx(options)
+			Expect(err).To(BeNil())
+			Expect(*created.Status).To(Equal(blockchainv3.SignatureCollection_Status_Open))
+			Expect(created.Unsigned()).To(Equal([]string{"org1", "org2"}))
+
+			_, _, err = api.CreateSigTx(options)
+			var conflictErr *blockchainv3.ConflictError
+			Expect(errors.As(err, &conflictErr)).To(BeTrue())
+
+			_, _, err = api.ApproveSigTx(api.NewApproveSigTxOptions("abcdef", "org3", "Y2VydA==", "c2ln"))
+			var validationErr *blockchainv3.ValidationError
+			Expect(errors.As(err, &validationErr)).To(BeTrue())
+
+			approved, _, err := api.ApproveSigTx(api.NewApproveSigTxOptions("abcdef", "org1", "Y2VydA==", "c2ln"))
+			Expect(err).To(BeNil())
+			Expect(approved.Unsigned()).To(Equal([]string{"org2"}))
+
+			_, _, err = api.SubmitSigTx(api.NewSubmitSigTxOptions("abcdef", "org1", "Y2VydA==", "c2ln"))
+			Expect(errors.As(err, &validationErr)).To(BeTrue())
+			Expect(validationErr.Message).To(ContainSubstring(`"org2" has not signed`))
+
+			_, _, err = api.ApproveSigTx(api.NewApproveSigTxOptions("abcdef", "org2", "Y2VydA==", "c2ln"))
+			Expect(err).To(BeNil())
+			submitted, _, err := api.SubmitSigTx(api.NewSubmitSigTxOptions("abcdef", "org1", "Y2VydA==", "c2ln"))
+			Expect(err).To(BeNil())
+			Expect(*submitted.Status).To(Equal(blockchainv3.SignatureCollection_Status_Closed))
+			Expect(submitted.Approved()).To(BeTrue())
+
+			fetched, _, err := api.GetSigTx(api.NewGetSigTxOptions("abcdef"))
+			Expect(err).To(BeNil())
+			Expect(fetched).To(Equal(submitted))
+		})
+		It(`Lists and deletes signature collections`, func() {
+			for _, id := range []string{"first", "second"} {
+				_, _, err := api.CreateSigTx(api.NewCreateSigTxOptions(id, "channel1", "cHJvcG9zYWw=", []blockchainv3.SignatureCollectionOrg{org("org1")}))
+				Expect(err).To(BeNil())
+			}
+			deleted, _, err := api.DeleteSigTx(api.NewDeleteSigTxOptions("first"))
+			Expect(err).To(BeNil())
+			Expect(*deleted.TxID).To(Equal("first"))
+
+			all, _, err := api.ListSigTxs(api.NewListSigTxsOptions())
+			Expect(err).To(BeNil())
+			Expect(all.SignatureCollections).To(HaveLen(1))
+			Expect(*all.SignatureCollections[0].TxID).To(Equal("second"))
+
+			_, _, err = api.GetSigTx(api.NewGetSigTxOptions("first"))
+			var notFoundErr *blockchainv3.NotFoundError
+			Expect(errors.As(err, &notFoundErr)).To(BeTrue())
+		})
+	})
+
+	It(`Reports the operations it does not implement`, func() {
+		_, response, err := api.GetSwagger(api.NewGetSwaggerOptions())
+		var serviceErr *blockchainv3.ServiceError
+		Expect(errors.As(err, &serviceErr)).To(BeTrue())
+		Expect(serviceErr.StatusCode).To(Equal(501))
+		Expect(response).To(BeNil())
+	})
+})
@@ -0,0 +1,798 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by genapi. DO NOT EDIT.
+
+package blockchainv3fake
+
+import (
+	"context"
+	"github.com/IBM-Blockchain/ibp-go-sdk/blockchainv3"
+	"github.com/IBM/go-sdk-core/v4/core"
+)
+
+// constructors builds options and models; BlockchainV3's constructors do not use the client.
+var constructors = new(blockchainv3.BlockchainV3)
+
+// GetComponent : Get component data
+func (console *Console) GetComponent(getComponentOptions *blockchainv3.GetComponentOptions) (result *blockchainv3.GenericComponentResponse, response *core.DetailedResponse, err error) {
+	return console.GetComponentWithContext(context.Background(), getComponentOptions)
+}
+
+// RemoveComponent : Remove imported component
+func (console *Console) RemoveComponent(removeComponentOptions *blockchainv3.RemoveComponentOptions) (result *blockchainv3.DeleteComponentResponse, response *core.DetailedResponse, err error) {
+	return console.RemoveComponentWithContext(context.Background(), removeComponentOptions)
+}
+
+// DeleteComponent : Delete component
+func (console *Console) DeleteComponent(deleteComponentOptions *blockchainv3.DeleteComponentOptions) (result *blockchainv3.DeleteComponentResponse, response *core.DetailedResponse, err error) {
+	return console.DeleteComponentWithContext(context.Background(), deleteComponentOptions)
+}
+
+// CreateCa : Create a CA
+func (console *Console) CreateCa(createCaOptions *blockchainv3.CreateCaOptions) (result *blockchainv3.CaResponse, response *core.DetailedResponse, err error) {
+	return console.CreateCaWithContext(context.Background(), createCaOptions)
+}
+
+// ImportCa : Import a CA
+func (console *Console) ImportCa(importCaOptions *blockchainv3.ImportCaOptions) (result *blockchainv3.CaResponse, response *core.DetailedResponse, err error) {
+	return console.ImportCaWithContext(context.Background(), importCaOptions)
+}
+
+// UpdateCa : Update a CA
+func (console *Console) UpdateCa(updateCaOptions *blockchainv3.UpdateCaOptions) (result *blockchainv3.CaResponse, response *core.DetailedResponse, err error) {
+	return console.UpdateCaWithContext(context.Background(), updateCaOptions)
+}
+
+// EditCa : Edit data about a CA
+func (console *Console) EditCa(editCaOptions *blockchainv3.EditCaOptions) (result *blockchainv3.CaResponse, response *core.DetailedResponse, err error) {
+	return console.EditCaWithContext(context.Background(), editCaOptions)
+}
+
+// CaAction : Submit action to a CA
+func (console *Console) CaAction(caActionOptions *blockchainv3.CaActionOptions) (result *blockchainv3.ActionsResponse, response *core.DetailedResponse, err error) {
+	return console.CaActionWithContext(context.Background(), caActionOptions)
+}
+
+// CreatePeer : Create a peer
+func (console *Console) CreatePeer(createPeerOptions *blockchainv3.CreatePeerOptions) (result *blockchainv3.PeerResponse, response *core.DetailedResponse, err error) {
+	return console.CreatePeerWithContext(context.Background(), createPeerOptions)
+}
+
+// ImportPeer : Import a peer
+func (console *Console) ImportPeer(importPeerOptions *blockchainv3.ImportPeerOptions) (result *blockchainv3.PeerResponse, response *core.DetailedResponse, err error) {
+	return console.ImportPeerWithContext(context.Background(), importPeerOptions)
+}
+
+// EditPeer : Edit data about a peer
+func (console *Console) EditPeer(editPeerOptions *blockchainv3.EditPeerOptions) (result *blockchainv3.PeerResponse, response *core.DetailedResponse, err error) {
+	return console.EditPeerWithContext(context.Background(), editPeerOptions)
+}
+
+// PeerAction : Submit action to a peer
+func (console *Console) PeerAction(peerActionOptions *blockchainv3.PeerActionOptions) (result *blockchainv3.ActionsResponse, response *core.DetailedResponse, err error) {
+	return console.PeerActionWithContext(context.Background(), peerActionOptions)
+}
+
+// UpdatePeer : Update a peer
+func (console *Console) UpdatePeer(updatePeerOptions *blockchainv3.UpdatePeerOptions) (result *blockchainv3.PeerResponse, response *core.DetailedResponse, err error) {
+	return console.UpdatePeerWithContext(context.Background(), updatePeerOptions)
+}
+
+// CreateOrderer : Create an ordering service
+func (console *Console) CreateOrderer(createOrdererOptions *blockchainv3.CreateOrdererOptions) (result *blockchainv3.CreateOrdererResponse, response *core.DetailedResponse, err error) {
+	return console.CreateOrdererWithContext(context.Background(), createOrdererOptions)
+}
+
+// ImportOrderer : Import an ordering service
+func (console *Console) ImportOrderer(importOrdererOptions *blockchainv3.ImportOrdererOptions) (result *blockchainv3.OrdererResponse, response *core.DetailedResponse, err error) {
+	return console.ImportOrdererWithContext(context.Background(), importOrdererOptions)
+}
+
+// EditOrderer : Edit data about an orderer
+func (console *Console) EditOrderer(editOrdererOptions *blockchainv3.EditOrdererOptions) (result *blockchainv3.OrdererResponse, response *core.DetailedResponse, err error) {
+	return console.EditOrdererWithContext(context.Background(), editOrdererOptions)
+}
+
+// OrdererAction : Submit action to an orderer
+func (console *Console) OrdererAction(ordererActionOptions *blockchainv3.OrdererActionOptions) (result *blockchainv3.ActionsResponse, response *core.DetailedResponse, err error) {
+	return console.OrdererActionWithContext(context.Background(), ordererActionOptions)
+}
+
+// UpdateOrderer : Update an orderer node
+func (console *Console) UpdateOrderer(updateOrdererOptions *blockchainv3.UpdateOrdererOptions) (result *blockchainv3.OrdererResponse, response *core.DetailedResponse, err error) {
+	return console.UpdateOrdererWithContext(context.Background(), updateOrdererOptions)
+}
+
+// SubmitBlock : Submit config block to orderer
+func (console *Console) SubmitBlock(submitBlockOptions *blockchainv3.SubmitBlockOptions) (result *blockchainv3.GenericComponentResponse, response *core.DetailedResponse, err error) {
+	return console.SubmitBlockWithContext(context.Background(), submitBlockOptions)
+}
+
+// ImportMsp : Import an MSP
+func (console *Console) ImportMsp(importMspOptions *blockchainv3.ImportMspOptions) (result *blockchainv3.MspResponse, response *core.DetailedResponse, err error) {
+	return console.ImportMspWithContext(context.Background(), importMspOptions)
+}
+
+// EditMsp : Edit an MSP
+func (console *Console) EditMsp(editMspOptions *blockchainv3.EditMspOptions) (result *blockchainv3.MspResponse, response *core.DetailedResponse, err error) {
+	return console.EditMspWithContext(context.Background(), editMspOptions)
+}
+
+// GetMspCertificate : Get MSP's public certificates
+func (console *Console) GetMspCertificate(getMspCertificateOptions *blockchainv3.GetMspCertificateOptions) (result *blockchainv3.GetMSPCertificateResponse, response *core.DetailedResponse, err error) {
+	return console.GetMspCertificateWithContext(context.Background(), getMspCertificateOptions)
+}
+
+// EditAdminCerts : Edit admin certs on a component
+func (console *Console) EditAdminCerts(editAdminCertsOptions *blockchainv3.EditAdminCertsOptions) (result *blockchainv3.EditAdminCertsResponse, response *core.DetailedResponse, err error) {
+	return console.EditAdminCertsWithContext(context.Background(), editAdminCertsOptions)
+}
+
+// ListComponents : Get all components
+func (console *Console) ListComponents(listComponentsOptions *blockchainv3.ListComponentsOptions) (result *blockchainv3.GetMultiComponentsResponse, response *core.DetailedResponse, err error) {
+	return console.ListComponentsWithContext(context.Background(), listComponentsOptions)
+}
+
+// GetComponentsByType : Get components of a type
+func (console *Console) GetComponentsByType(getComponentsByTypeOptions *blockchainv3.GetComponentsByTypeOptions) (result *blockchainv3.GetMultiComponentsResponse, response *core.DetailedResponse, err error) {
+	return console.GetComponentsByTypeWithContext(context.Background(), getComponentsByTypeOptions)
+}
+
+// GetComponentsByTag : Get components with tag
+func (console *Console) GetComponentsByTag(getComponentsByTagOptions *blockchainv3.GetComponentsByTagOptions) (result *blockchainv3.GetMultiComponentsResponse, response *core.DetailedResponse, err error) {
+	return console.GetComponentsByTagWithContext(context.Background(), getComponentsByTagOptions)
+}
+
+// RemoveComponentsByTag : Remove components with tag
+func (console *Console) RemoveComponentsByTag(removeComponentsByTagOptions *blockchainv3.RemoveComponentsByTagOptions) (result *blockchainv3.RemoveMultiComponentsResponse, response *core.DetailedResponse, err error) {
+	return console.RemoveComponentsByTagWithContext(context.Background(), removeComponentsByTagOptions)
+}
+
+// DeleteComponentsByTag : Delete components with tag
+func (console *Console) DeleteComponentsByTag(deleteComponentsByTagOptions *blockchainv3.DeleteComponentsByTagOptions) (result *blockchainv3.DeleteMultiComponentsResponse, response *core.DetailedResponse, err error) {
+	return console.DeleteComponentsByTagWithContext(context.Background(), deleteComponentsByTagOptions)
+}
+
+// DeleteAllComponents : Delete all components
+func (console *Console) DeleteAllComponents(deleteAllComponentsOptions *blockchainv3.DeleteAllComponentsOptions) (result *blockchainv3.DeleteMultiComponentsResponse, response *core.DetailedResponse, err error) {
+	return console.DeleteAllComponentsWithContext(context.Background(), deleteAllComponentsOptions)
+}
+
+// GetSettings : Get public IBP console settings
+func (console *Console) GetSettings(getSettingsOptions *blockchainv3.GetSettingsOptions) (result *blockchainv3.GetPublicSettingsResponse, response *core.DetailedResponse, err error) {
+	return console.GetSettingsWithContext(context.Background(), getSettingsOptions)
+}
+
+// EditSettings : Change IBP console settings
+func (console *Console) EditSettings(editSettingsOptions *blockchainv3.EditSettingsOptions) (result *blockchainv3.GetPublicSettingsResponse, response *core.DetailedResponse, err error) {
+	return console.EditSettingsWithContext(context.Background(), editSettingsOptions)
+}
+
+// GetFabVersions : Get supported Fabric versions
+func (console *Console) GetFabVersions(getFabVersionsOptions *blockchainv3.GetFabVersionsOptions) (result *blockchainv3.GetFabricVersionsResponse, response *core.DetailedResponse, err error) {
+	return console.GetFabVersionsWithContext(context.Background(), getFabVersionsOptions)
+}
+
+// GetHealth : Get IBP console health stats
+func (console *Console) GetHealth(getHealthOptions *blockchainv3.GetHealthOptions) (result *blockchainv3.GetAthenaHealthStatsResponse, response *core.DetailedResponse, err error) {
+	return console.GetHealthWithContext(context.Background(), getHealthOptions)
+}
+
+// ListNotifications : Get all notifications
+func (console *Console) ListNotifications(listNotificationsOptions *blockchainv3.ListNotificationsOptions) (result *blockchainv3.GetNotificationsResponse, response *core.DetailedResponse, err error) {
+	return console.ListNotificationsWithContext(context.Background(), listNotificationsOptions)
+}
+
+// DeleteSigTx : Delete a signature collection tx
+func (console *Console) DeleteSigTx(deleteSigTxOptions *blockchainv3.DeleteSigTxOptions) (result *blockchainv3.DeleteSignatureCollectionResponse, response *core.DetailedResponse, err error) {
+	return console.DeleteSigTxWithContext(context.Background(), deleteSigTxOptions)
+}
+
+// ArchiveNotifications : Archive notifications
+func (console *Console) ArchiveNotifications(archiveNotificationsOptions *blockchainv3.ArchiveNotificationsOptions) (result *blockchainv3.ArchiveResponse, response *core.DetailedResponse, err error) {
+	return console.ArchiveNotificationsWithContext(context.Background(), archiveNotificationsOptions)
+}
+
+// Restart : Restart the IBP console
+func (console *Console) Restart(restartOptions *blockchainv3.RestartOptions) (result *blockchainv3.RestartAthenaResponse, response *core.DetailedResponse, err error) {
+	return console.RestartWithContext(context.Background(), restartOptions)
+}
+
+// RestartWithContext is an alternate form of the Restart method which supports a Context parameter
+// Not implemented by the fake.
+func (console *Console) RestartWithContext(ctx context.Context, restartOptions *blockchainv3.RestartOptions) (result *blockchainv3.RestartAthenaResponse, response *core.DetailedResponse, err error) {
+	err = notImplemented("Restart")
+	return
+}
+
+// DeleteAllSessions : Delete all IBP console sessions
+func (console *Console) DeleteAllSessions(deleteAllSessionsOptions *blockchainv3.DeleteAllSessionsOptions) (result *blockchainv3.DeleteAllSessionsResponse, response *core.DetailedResponse, err error) {
+	return console.DeleteAllSessionsWithContext(context.Background(), deleteAllSessionsOptions)
+}
+
+// DeleteAllSessionsWithContext is an alternate form of the DeleteAllSessions method which supports a Context parameter
+// Not implemented by the fake.
+func (console *Console) DeleteAllSessionsWithContext(ctx context.Context, deleteAllSessionsOptions *blockchainv3.DeleteAllSessionsOptions) (result *blockchainv3.DeleteAllSessionsResponse, response *core.DetailedResponse, err error) {
+	err = notImplemented("DeleteAllSessions")
+	return
+}
+
+// DeleteAllNotifications : Delete all notifications
+func (console *Console) DeleteAllNotifications(deleteAllNotificationsOptions *blockchainv3.DeleteAllNotificationsOptions) (result *blockchainv3.DeleteAllNotificationsResponse, response *core.DetailedResponse, err error) {
+	return console.DeleteAllNotificationsWithContext(context.Background(), deleteAllNotificationsOptions)
+}
+
+// ClearCaches : Clear IBP console caches
+func (console *Console) ClearCaches(clearCachesOptions *blockchainv3.ClearCachesOptions) (result *blockchainv3.CacheFlushResponse, response *core.DetailedResponse, err error) {
+	return console.ClearCachesWithContext(context.Background(), clearCachesOptions)
+}
+
+// ClearCachesWithContext is an alternate form of the ClearCaches method which supports a Context parameter
+// Not implemented by the fake.
+func (console *Console) ClearCachesWithContext(ctx context.Context, clearCachesOptions *blockchainv3.ClearCachesOptions) (result *blockchainv3.CacheFlushResponse, response *core.DetailedResponse, err error) {
+	err = notImplemented("ClearCaches")
+	return
+}
+
+// GetPostman : Generate Postman collection
+func (console *Console) GetPostman(getPostmanOptions *blockchainv3.GetPostmanOptions) (response *core.DetailedResponse, err error) {
+	return console.GetPostmanWithContext(context.Background(), getPostmanOptions)
+}
+
+// GetPostmanWithContext is an alternate form of the GetPostman method which supports a Context parameter
+// Not implemented by the fake.
+func (console *Console) GetPostmanWithContext(ctx context.Context, getPostmanOptions *blockchainv3.GetPostmanOptions) (response *core.DetailedResponse, err error) {
+	err = notImplemented("GetPostman")
+	return
+}
+
+// GetSwagger : Download OpenAPI file
+func (console *Console) GetSwagger(getSwaggerOptions *blockchainv3.GetSwaggerOptions) (result *string, response *core.DetailedResponse, err error) {
+	return console.GetSwaggerWithContext(context.Background(), getSwaggerOptions)
+}
+
+// GetSwaggerWithContext is an alternate form of the GetSwagger method which supports a Context parameter
+// Not implemented by the fake.
+func (console *Console) GetSwaggerWithContext(ctx context.Context, getSwaggerOptions *blockchainv3.GetSwaggerOptions) (result *string, response *core.DetailedResponse, err error) {
+	err = notImplemented("GetSwagger")
+	return
+}
+
+// NewArchiveNotificationsOptions : Instantiate ArchiveNotificationsOptions
+func (*Console) NewArchiveNotificationsOptions(notificationIds []string) *blockchainv3.ArchiveNotificationsOptions {
+	return constructors.NewArchiveNotificationsOptions(notificationIds)
+}
+
+// NewBccspPKCS11 : Instantiate BccspPKCS11 (Generic Model Constructor)
+func (*Console) NewBccspPKCS11(label string, pin string) (model *blockchainv3.BccspPKCS11, err error) {
+	return constructors.NewBccspPKCS11(label, pin)
+}
+
+// NewBccspSW : Instantiate BccspSW (Generic Model Constructor)
+func (*Console) NewBccspSW(hash string, security float64) (model *blockchainv3.BccspSW, err error) {
+	return constructors.NewBccspSW(hash, security)
+}
+
+// NewCaActionOptions : Instantiate CaActionOptions
+func (*Console) NewCaActionOptions(id string) *blockchainv3.CaActionOptions {
+	return constructors.NewCaActionOptions(id)
+}
+
+// NewClearCachesOptions : Instantiate ClearCachesOptions
+func (*Console) NewClearCachesOptions() *blockchainv3.ClearCachesOptions {
+	return constructors.NewClearCachesOptions()
+}
+
+// NewConfigCACfgIdentities : Instantiate ConfigCACfgIdentities (Generic Model Constructor)
+func (*Console) NewConfigCACfgIdentities(passwordattempts float64) (model *blockchainv3.ConfigCACfgIdentities, err error) {
+	return constructors.NewConfigCACfgIdentities(passwordattempts)
+}
+
+// NewConfigCACreate : Instantiate ConfigCACreate (Generic Model Constructor)
+func (*Console) NewConfigCACreate(registry *blockchainv3.ConfigCARegistry) (model *blockchainv3.ConfigCACreate, err error) {
+	return constructors.NewConfigCACreate(registry)
+}
+
+// NewConfigCACsrKeyrequest : Instantiate ConfigCACsrKeyrequest (Generic Model Constructor)
+func (*Console) NewConfigCACsrKeyrequest(algo string, size float64) (model *blockchainv3.ConfigCACsrKeyrequest, err error) {
+	return constructors.NewConfigCACsrKeyrequest(algo, size)
+}
+
+// NewConfigCACsrNamesItem : Instantiate ConfigCACsrNamesItem (Generic Model Constructor)
+func (*Console) NewConfigCACsrNamesItem(c string, sT string, o string) (model *blockchainv3.ConfigCACsrNamesItem, err error) {
+	return constructors.NewConfigCACsrNamesItem(c, sT, o)
+}
+
+// NewConfigCADbTlsClient : Instantiate ConfigCADbTlsClient (Generic Model Constructor)
+func (*Console) NewConfigCADbTlsClient(certfile string, keyfile string) (model *blockchainv3.ConfigCADbTlsClient, err error) {
+	return constructors.NewConfigCADbTlsClient(certfile, keyfile)
+}
+
+// NewConfigCAIntermediateEnrollment : Instantiate ConfigCAIntermediateEnrollment (Generic Model Constructor)
+func (*Console) NewConfigCAIntermediateEnrollment(hosts string, profile string, label string) (model *blockchainv3.ConfigCAIntermediateEnrollment, err error) {
+	return constructors.NewConfigCAIntermediateEnrollment(hosts, profile, label)
+}
+
+// NewConfigCAIntermediateParentserver : Instantiate ConfigCAIntermediateParentserver (Generic Model Constructor)
+func (*Console) NewConfigCAIntermediateParentserver(url string, caname string) (model *blockchainv3.ConfigCAIntermediateParentserver, err error) {
+	return constructors.NewConfigCAIntermediateParentserver(url, caname)
+}
+
+// NewConfigCAIntermediateTls : Instantiate ConfigCAIntermediateTls (Generic Model Constructor)
+func (*Console) NewConfigCAIntermediateTls(certfiles []string) (model *blockchainv3.ConfigCAIntermediateTls, err error) {
+	return constructors.NewConfigCAIntermediateTls(certfiles)
+}
+
+// NewConfigCAIntermediateTlsClient : Instantiate ConfigCAIntermediateTlsClient (Generic Model Constructor)
+func (*Console) NewConfigCAIntermediateTlsClient(certfile string, keyfile string) (model *blockchainv3.ConfigCAIntermediateTlsClient, err error) {
+	return constructors.NewConfigCAIntermediateTlsClient(certfile, keyfile)
+}
+
+// NewConfigCARegistryIdentitiesItem : Instantiate ConfigCARegistryIdentitiesItem (Generic Model Constructor)
+func (*Console) NewConfigCARegistryIdentitiesItem(name string, pass string, typeVar string) (model *blockchainv3.ConfigCARegistryIdentitiesItem, err error) {
+	return constructors.NewConfigCARegistryIdentitiesItem(name, pass, typeVar)
+}
+
+// NewConfigCATlsClientauth : Instantiate ConfigCATlsClientauth (Generic Model Constructor)
+func (*Console) NewConfigCATlsClientauth(typeVar string, certfiles []string) (model *blockchainv3.ConfigCATlsClientauth, err error) {
+	return constructors.NewConfigCATlsClientauth(typeVar, certfiles)
+}
+
+// NewConfigCACfg : Instantiate ConfigCACfg (Generic Model Constructor)
+func (*Console) NewConfigCACfg(identities *blockchainv3.ConfigCACfgIdentities) (model *blockchainv3.ConfigCACfg, err error) {
+	return constructors.NewConfigCACfg(identities)
+}
+
+// NewConfigCACors : Instantiate ConfigCACors (Generic Model Constructor)
+func (*Console) NewConfigCACors(enabled bool, origins []string) (model *blockchainv3.ConfigCACors, err error) {
+	return constructors.NewConfigCACors(enabled, origins)
+}
+
+// NewConfigCACrl : Instantiate ConfigCACrl (Generic Model Constructor)
+func (*Console) NewConfigCACrl(expiry string) (model *blockchainv3.ConfigCACrl, err error) {
+	return constructors.NewConfigCACrl(expiry)
+}
+
+// NewConfigCACsr : Instantiate ConfigCACsr (Generic Model Constructor)
+func (*Console) NewConfigCACsr(cn string, names []blockchainv3.ConfigCACsrNamesItem, ca *blockchainv3.ConfigCACsrCa) (model *blockchainv3.ConfigCACsr, err error) {
+	return constructors.NewConfigCACsr(cn, names, ca)
+}
+
+// NewConfigCADb : Instantiate ConfigCADb (Generic Model Constructor)
+func (*Console) NewConfigCADb(typeVar string, datasource string) (model *blockchainv3.ConfigCADb, err error) {
+	return constructors.NewConfigCADb(typeVar, datasource)
+}
+
+// NewConfigCAIdemix : Instantiate ConfigCAIdemix (Generic Model Constructor)
+func (*Console) NewConfigCAIdemix(rhpoolsize float64, nonceexpiration string, noncesweepinterval string) (model *blockchainv3.ConfigCAIdemix, err error) {
+	return constructors.NewConfigCAIdemix(rhpoolsize, nonceexpiration, noncesweepinterval)
+}
+
+// NewConfigCAIntermediate : Instantiate ConfigCAIntermediate (Generic Model Constructor)
+func (*Console) NewConfigCAIntermediate(parentserver *blockchainv3.ConfigCAIntermediateParentserver) (model *blockchainv3.ConfigCAIntermediate, err error) {
+	return constructors.NewConfigCAIntermediate(parentserver)
+}
+
+// NewConfigCARegistry : Instantiate ConfigCARegistry (Generic Model Constructor)
+func (*Console) NewConfigCARegistry(maxenrollments float64, identities []blockchainv3.ConfigCARegistryIdentitiesItem) (model *blockchainv3.ConfigCARegistry, err error) {
+	return constructors.NewConfigCARegistry(maxenrollments, identities)
+}
+
+// NewConfigCATls : Instantiate ConfigCATls (Generic Model Constructor)
+func (*Console) NewConfigCATls(keyfile string, certfile string) (model *blockchainv3.ConfigCATls, err error) {
+	return constructors.NewConfigCATls(keyfile, certfile)
+}
+
+// NewConfigPeerAdminService : Instantiate ConfigPeerAdminService (Generic Model Constructor)
+func (*Console) NewConfigPeerAdminService(listenAddress string) (model *blockchainv3.ConfigPeerAdminService, err error) {
+	return constructors.NewConfigPeerAdminService(listenAddress)
+}
+
+// NewConfigPeerAuthentication : Instantiate ConfigPeerAuthentication (Generic Model Constructor)
+func (*Console) NewConfigPeerAuthentication(timewindow string) (model *blockchainv3.ConfigPeerAuthentication, err error) {
+	return constructors.NewConfigPeerAuthentication(timewindow)
+}
+
+// NewConfigPeerClient : Instantiate ConfigPeerClient (Generic Model Constructor)
+func (*Console) NewConfigPeerClient(connTimeout string) (model *blockchainv3.ConfigPeerClient, err error) {
+	return constructors.NewConfigPeerClient(connTimeout)
+}
+
+// NewCreateCaBodyConfigOverride : Instantiate CreateCaBodyConfigOverride (Generic Model Constructor)
+func (*Console) NewCreateCaBodyConfigOverride(ca *blockchainv3.ConfigCACreate) (model *blockchainv3.CreateCaBodyConfigOverride, err error) {
+	return constructors.NewCreateCaBodyConfigOverride(ca)
+}
+
+// NewCreateCaBodyResources : Instantiate CreateCaBodyResources (Generic Model Constructor)
+func (*Console) NewCreateCaBodyResources(ca *blockchainv3.ResourceObject) (model *blockchainv3.CreateCaBodyResources, err error) {
+	return constructors.NewCreateCaBodyResources(ca)
+}
+
+// NewCreateCaBodyStorage : Instantiate CreateCaBodyStorage (Generic Model Constructor)
+func (*Console) NewCreateCaBodyStorage(ca *blockchainv3.StorageObject) (model *blockchainv3.CreateCaBodyStorage, err error) {
+	return constructors.NewCreateCaBodyStorage(ca)
+}
+
+// NewCreateCaOptions : Instantiate CreateCaOptions
+func (*Console) NewCreateCaOptions(displayName string, configOverride *blockchainv3.CreateCaBodyConfigOverride) *blockchainv3.CreateCaOptions {
+	return constructors.NewCreateCaOptions(displayName, configOverride)
+}
+
+// NewCreateOrdererOptions : Instantiate CreateOrdererOptions
+func (*Console) NewCreateOrdererOptions(ordererType string, mspID string, displayName string, crypto []blockchainv3.CryptoObject) *blockchainv3.CreateOrdererOptions {
+	return constructors.NewCreateOrdererOptions(ordererType, mspID, displayName, crypto)
+}
+
+// NewCreateOrdererRaftBodyResources : Instantiate CreateOrdererRaftBodyResources (Generic Model Constructor)
+func (*Console) NewCreateOrdererRaftBodyResources(orderer *blockchainv3.ResourceObject) (model *blockchainv3.CreateOrdererRaftBodyResources, err error) {
+	return constructors.NewCreateOrdererRaftBodyResources(orderer)
+}
+
+// NewCreateOrdererRaftBodyStorage : Instantiate CreateOrdererRaftBodyStorage (Generic Model Constructor)
+func (*Console) NewCreateOrdererRaftBodyStorage(orderer *blockchainv3.StorageObject) (model *blockchainv3.CreateOrdererRaftBodyStorage, err error) {
+	return constructors.NewCreateOrdererRaftBodyStorage(orderer)
+}
+
+// NewCreatePeerBodyStorage : Instantiate CreatePeerBodyStorage (Generic Model Constructor)
+func (*Console) NewCreatePeerBodyStorage(peer *blockchainv3.StorageObject) (model *blockchainv3.CreatePeerBodyStorage, err error) {
+	return constructors.NewCreatePeerBodyStorage(peer)
+}
+
+// NewCreatePeerOptions : Instantiate CreatePeerOptions
+func (*Console) NewCreatePeerOptions(mspID string, displayName string, crypto *blockchainv3.CryptoObject) *blockchainv3.CreatePeerOptions {
+	return constructors.NewCreatePeerOptions(mspID, displayName, crypto)
+}
+
+// NewCryptoObjectEnrollment : Instantiate CryptoObjectEnrollment (Generic Model Constructor)
+func (*Console) NewCryptoObjectEnrollment(component *blockchainv3.CryptoEnrollmentComponent, ca *blockchainv3.CryptoObjectEnrollmentCa, tlsca *blockchainv3.CryptoObjectEnrollmentTlsca) (model *blockchainv3.CryptoObjectEnrollment, err error) {
+	return constructors.NewCryptoObjectEnrollment(component, ca, tlsca)
+}
+
+// NewCryptoObjectEnrollmentCa : Instantiate CryptoObjectEnrollmentCa (Generic Model Constructor)
+func (*Console) NewCryptoObjectEnrollmentCa(host string, port float64, name string, tlsCert string, enrollID string, enrollSecret string) (model *blockchainv3.CryptoObjectEnrollmentCa, err error) {
+	return constructors.NewCryptoObjectEnrollmentCa(host, port, name, tlsCert, enrollID, enrollSecret)
+}
+
+// NewCryptoObjectEnrollmentTlsca : Instantiate CryptoObjectEnrollmentTlsca (Generic Model Constructor)
+func (*Console) NewCryptoObjectEnrollmentTlsca(host string, port float64, name string, tlsCert string, enrollID string, enrollSecret string) (model *blockchainv3.CryptoObjectEnrollmentTlsca, err error) {
+	return constructors.NewCryptoObjectEnrollmentTlsca(host, port, name, tlsCert, enrollID, enrollSecret)
+}
+
+// NewCryptoObjectMsp : Instantiate CryptoObjectMsp (Generic Model Constructor)
+func (*Console) NewCryptoObjectMsp(component *blockchainv3.MspCryptoComp, ca *blockchainv3.MspCryptoCa, tlsca *blockchainv3.MspCryptoCa) (model *blockchainv3.CryptoObjectMsp, err error) {
+	return constructors.NewCryptoObjectMsp(component, ca, tlsca)
+}
+
+// NewDeleteAllComponentsOptions : Instantiate DeleteAllComponentsOptions
+func (*Console) NewDeleteAllComponentsOptions() *blockchainv3.DeleteAllComponentsOptions {
+	return constructors.NewDeleteAllComponentsOptions()
+}
+
+// NewDeleteAllNotificationsOptions : Instantiate DeleteAllNotificationsOptions
+func (*Console) NewDeleteAllNotificationsOptions() *blockchainv3.DeleteAllNotificationsOptions {
+	return constructors.NewDeleteAllNotificationsOptions()
+}
+
+// NewDeleteAllSessionsOptions : Instantiate DeleteAllSessionsOptions
+func (*Console) NewDeleteAllSessionsOptions() *blockchainv3.DeleteAllSessionsOptions {
+	return constructors.NewDeleteAllSessionsOptions()
+}
+
+// NewDeleteComponentOptions : Instantiate DeleteComponentOptions
+func (*Console) NewDeleteComponentOptions(id string) *blockchainv3.DeleteComponentOptions {
+	return constructors.NewDeleteComponentOptions(id)
+}
+
+// NewDeleteComponentsByTagOptions : Instantiate DeleteComponentsByTagOptions
+func (*Console) NewDeleteComponentsByTagOptions(tag string) *blockchainv3.DeleteComponentsByTagOptions {
+	return constructors.NewDeleteComponentsByTagOptions(tag)
+}
+
+// NewDeleteSigTxOptions : Instantiate DeleteSigTxOptions
+func (*Console) NewDeleteSigTxOptions(id string) *blockchainv3.DeleteSigTxOptions {
+	return constructors.NewDeleteSigTxOptions(id)
+}
+
+// NewEditAdminCertsOptions : Instantiate EditAdminCertsOptions
+func (*Console) NewEditAdminCertsOptions(id string) *blockchainv3.EditAdminCertsOptions {
+	return constructors.NewEditAdminCertsOptions(id)
+}
+
+// NewEditCaOptions : Instantiate EditCaOptions
+func (*Console) NewEditCaOptions(id string) *blockchainv3.EditCaOptions {
+	return constructors.NewEditCaOptions(id)
+}
+
+// NewEditMspOptions : Instantiate EditMspOptions
+func (*Console) NewEditMspOptions(id string) *blockchainv3.EditMspOptions {
+	return constructors.NewEditMspOptions(id)
+}
+
+// NewEditOrdererOptions : Instantiate EditOrdererOptions
+func (*Console) NewEditOrdererOptions(id string) *blockchainv3.EditOrdererOptions {
+	return constructors.NewEditOrdererOptions(id)
+}
+
+// NewEditPeerOptions : Instantiate EditPeerOptions
+func (*Console) NewEditPeerOptions(id string) *blockchainv3.EditPeerOptions {
+	return constructors.NewEditPeerOptions(id)
+}
+
+// NewEditSettingsOptions : Instantiate EditSettingsOptions
+func (*Console) NewEditSettingsOptions() *blockchainv3.EditSettingsOptions {
+	return constructors.NewEditSettingsOptions()
+}
+
+// NewGetComponentOptions : Instantiate GetComponentOptions
+func (*Console) NewGetComponentOptions(id string) *blockchainv3.GetComponentOptions {
+	return constructors.NewGetComponentOptions(id)
+}
+
+// NewGetComponentsByTagOptions : Instantiate GetComponentsByTagOptions
+func (*Console) NewGetComponentsByTagOptions(tag string) *blockchainv3.GetComponentsByTagOptions {
+	return constructors.NewGetComponentsByTagOptions(tag)
+}
+
+// NewGetComponentsByTypeOptions : Instantiate GetComponentsByTypeOptions
+func (*Console) NewGetComponentsByTypeOptions(typeVar string) *blockchainv3.GetComponentsByTypeOptions {
+	return constructors.NewGetComponentsByTypeOptions(typeVar)
+}
+
+// NewGetFabVersionsOptions : Instantiate GetFabVersionsOptions
+func (*Console) NewGetFabVersionsOptions() *blockchainv3.GetFabVersionsOptions {
+	return constructors.NewGetFabVersionsOptions()
+}
+
+// NewGetHealthOptions : Instantiate GetHealthOptions
+func (*Console) NewGetHealthOptions() *blockchainv3.GetHealthOptions {
+	return constructors.NewGetHealthOptions()
+}
+
+// NewGetMspCertificateOptions : Instantiate GetMspCertificateOptions
+func (*Console) NewGetMspCertificateOptions(mspID string) *blockchainv3.GetMspCertificateOptions {
+	return constructors.NewGetMspCertificateOptions(mspID)
+}
+
+// NewGetPostmanOptions : Instantiate GetPostmanOptions
+func (*Console) NewGetPostmanOptions(authType string) *blockchainv3.GetPostmanOptions {
+	return constructors.NewGetPostmanOptions(authType)
+}
+
+// NewGetSettingsOptions : Instantiate GetSettingsOptions
+func (*Console) NewGetSettingsOptions() *blockchainv3.GetSettingsOptions {
+	return constructors.NewGetSettingsOptions()
+}
+
+// NewGetSwaggerOptions : Instantiate GetSwaggerOptions
+func (*Console) NewGetSwaggerOptions() *blockchainv3.GetSwaggerOptions {
+	return constructors.NewGetSwaggerOptions()
+}
+
+// NewImportCaBodyMsp : Instantiate ImportCaBodyMsp (Generic Model Constructor)
+func (*Console) NewImportCaBodyMsp(ca *blockchainv3.ImportCaBodyMspCa, tlsca *blockchainv3.ImportCaBodyMspTlsca, component *blockchainv3.ImportCaBodyMspComponent) (model *blockchainv3.ImportCaBodyMsp, err error) {
+	return constructors.NewImportCaBodyMsp(ca, tlsca, component)
+}
+
+// NewImportCaBodyMspCa : Instantiate ImportCaBodyMspCa (Generic Model Constructor)
+func (*Console) NewImportCaBodyMspCa(name string) (model *blockchainv3.ImportCaBodyMspCa, err error) {
+	return constructors.NewImportCaBodyMspCa(name)
+}
+
+// NewImportCaBodyMspComponent : Instantiate ImportCaBodyMspComponent (Generic Model Constructor)
+func (*Console) NewImportCaBodyMspComponent(tlsCert string) (model *blockchainv3.ImportCaBodyMspComponent, err error) {
+	return constructors.NewImportCaBodyMspComponent(tlsCert)
+}
+
+// NewImportCaBodyMspTlsca : Instantiate ImportCaBodyMspTlsca (Generic Model Constructor)
+func (*Console) NewImportCaBodyMspTlsca(name string) (model *blockchainv3.ImportCaBodyMspTlsca, err error) {
+	return constructors.NewImportCaBodyMspTlsca(name)
+}
+
+// NewImportCaOptions : Instantiate ImportCaOptions
+func (*Console) NewImportCaOptions(displayName string, apiURL string, msp *blockchainv3.ImportCaBodyMsp) *blockchainv3.ImportCaOptions {
+	return constructors.NewImportCaOptions(displayName, apiURL, msp)
+}
+
+// NewImportMspOptions : Instantiate ImportMspOptions
+func (*Console) NewImportMspOptions(mspID string, displayName string, rootCerts []string) *blockchainv3.ImportMspOptions {
+	return constructors.NewImportMspOptions(mspID, displayName, rootCerts)
+}
+
+// NewImportOrdererOptions : Instantiate ImportOrdererOptions
+func (*Console) NewImportOrdererOptions(clusterName string, displayName string, grpcwpURL string, msp *blockchainv3.MspCryptoField, mspID string) *blockchainv3.ImportOrdererOptions {
+	return constructors.NewImportOrdererOptions(clusterName, displayName, grpcwpURL, msp, mspID)
+}
+
+// NewImportPeerOptions : Instantiate ImportPeerOptions
+func (*Console) NewImportPeerOptions(displayName string, grpcwpURL string, msp *blockchainv3.MspCryptoField, mspID string) *blockchainv3.ImportPeerOptions {
+	return constructors.NewImportPeerOptions(displayName, grpcwpURL, msp, mspID)
+}
+
+// NewListComponentsOptions : Instantiate ListComponentsOptions
+func (*Console) NewListComponentsOptions() *blockchainv3.ListComponentsOptions {
+	return constructors.NewListComponentsOptions()
+}
+
+// NewListNotificationsOptions : Instantiate ListNotificationsOptions
+func (*Console) NewListNotificationsOptions() *blockchainv3.ListNotificationsOptions {
+	return constructors.NewListNotificationsOptions()
+}
+
+// NewMetrics : Instantiate Metrics (Generic Model Constructor)
+func (*Console) NewMetrics(provider string) (model *blockchainv3.Metrics, err error) {
+	return constructors.NewMetrics(provider)
+}
+
+// NewMetricsStatsd : Instantiate MetricsStatsd (Generic Model Constructor)
+func (*Console) NewMetricsStatsd(network string, address string, writeInterval string, prefix string) (model *blockchainv3.MetricsStatsd, err error) {
+	return constructors.NewMetricsStatsd(network, address, writeInterval, prefix)
+}
+
+// NewMspCryptoCa : Instantiate MspCryptoCa (Generic Model Constructor)
+func (*Console) NewMspCryptoCa(rootCerts []string) (model *blockchainv3.MspCryptoCa, err error) {
+	return constructors.NewMspCryptoCa(rootCerts)
+}
+
+// NewMspCryptoComp : Instantiate MspCryptoComp (Generic Model Constructor)
+func (*Console) NewMspCryptoComp(ekey string, ecert string, tlsKey string, tlsCert string) (model *blockchainv3.MspCryptoComp, err error) {
+	return constructors.NewMspCryptoComp(ekey, ecert, tlsKey, tlsCert)
+}
+
+// NewMspCryptoFieldComponent : Instantiate MspCryptoFieldComponent (Generic Model Constructor)
+func (*Console) NewMspCryptoFieldComponent(tlsCert string) (model *blockchainv3.MspCryptoFieldComponent, err error) {
+	return constructors.NewMspCryptoFieldComponent(tlsCert)
+}
+
+// NewMspCryptoFieldTlsca : Instantiate MspCryptoFieldTlsca (Generic Model Constructor)
+func (*Console) NewMspCryptoFieldTlsca(rootCerts []string) (model *blockchainv3.MspCryptoFieldTlsca, err error) {
+	return constructors.NewMspCryptoFieldTlsca(rootCerts)
+}
+
+// NewOrdererActionOptions : Instantiate OrdererActionOptions
+func (*Console) NewOrdererActionOptions(id string) *blockchainv3.OrdererActionOptions {
+	return constructors.NewOrdererActionOptions(id)
+}
+
+// NewPeerActionOptions : Instantiate PeerActionOptions
+func (*Console) NewPeerActionOptions(id string) *blockchainv3.PeerActionOptions {
+	return constructors.NewPeerActionOptions(id)
+}
+
+// NewRemoveComponentOptions : Instantiate RemoveComponentOptions
+func (*Console) NewRemoveComponentOptions(id string) *blockchainv3.RemoveComponentOptions {
+	return constructors.NewRemoveComponentOptions(id)
+}
+
+// NewRemoveComponentsByTagOptions : Instantiate RemoveComponentsByTagOptions
+func (*Console) NewRemoveComponentsByTagOptions(tag string) *blockchainv3.RemoveComponentsByTagOptions {
+	return constructors.NewRemoveComponentsByTagOptions(tag)
+}
+
+// NewResourceObject : Instantiate ResourceObject (Generic Model Constructor)
+func (*Console) NewResourceObject(requests *blockchainv3.ResourceRequests) (model *blockchainv3.ResourceObject, err error) {
+	return constructors.NewResourceObject(requests)
+}
+
+// NewResourceObjectCouchDb : Instantiate ResourceObjectCouchDb (Generic Model Constructor)
+func (*Console) NewResourceObjectCouchDb(requests *blockchainv3.ResourceRequests) (model *blockchainv3.ResourceObjectCouchDb, err error) {
+	return constructors.NewResourceObjectCouchDb(requests)
+}
+
+// NewResourceObjectFabV1 : Instantiate ResourceObjectFabV1 (Generic Model Constructor)
+func (*Console) NewResourceObjectFabV1(requests *blockchainv3.ResourceRequests) (model *blockchainv3.ResourceObjectFabV1, err error) {
+	return constructors.NewResourceObjectFabV1(requests)
+}
+
+// NewResourceObjectFabV2 : Instantiate ResourceObjectFabV2 (Generic Model Constructor)
+func (*Console) NewResourceObjectFabV2(requests *blockchainv3.ResourceRequests) (model *blockchainv3.ResourceObjectFabV2, err error) {
+	return constructors.NewResourceObjectFabV2(requests)
+}
+
+// NewRestartOptions : Instantiate RestartOptions
+func (*Console) NewRestartOptions() *blockchainv3.RestartOptions {
+	return constructors.NewRestartOptions()
+}
+
+// NewSubmitBlockOptions : Instantiate SubmitBlockOptions
+func (*Console) NewSubmitBlockOptions(id string) *blockchainv3.SubmitBlockOptions {
+	return constructors.NewSubmitBlockOptions(id)
+}
+
+// NewUpdateCaBodyConfigOverride : Instantiate UpdateCaBodyConfigOverride (Generic Model Constructor)
+func (*Console) NewUpdateCaBodyConfigOverride(ca *blockchainv3.ConfigCAUpdate) (model *blockchainv3.UpdateCaBodyConfigOverride, err error) {
+	return constructors.NewUpdateCaBodyConfigOverride(ca)
+}
+
+// NewUpdateCaBodyResources : Instantiate UpdateCaBodyResources (Generic Model Constructor)
+func (*Console) NewUpdateCaBodyResources(ca *blockchainv3.ResourceObject) (model *blockchainv3.UpdateCaBodyResources, err error) {
+	return constructors.NewUpdateCaBodyResources(ca)
+}
+
+// NewUpdateCaOptions : Instantiate UpdateCaOptions
+func (*Console) NewUpdateCaOptions(id string) *blockchainv3.UpdateCaOptions {
+	return constructors.NewUpdateCaOptions(id)
+}
+
+// NewUpdateOrdererOptions : Instantiate UpdateOrdererOptions
+func (*Console) NewUpdateOrdererOptions(id string) *blockchainv3.UpdateOrdererOptions {
+	return constructors.NewUpdateOrdererOptions(id)
+}
+
+// NewUpdatePeerOptions : Instantiate UpdatePeerOptions
+func (*Console) NewUpdatePeerOptions(id string) *blockchainv3.UpdatePeerOptions {
+	return constructors.NewUpdatePeerOptions(id)
+}
+
+// NewHsm : Instantiate Hsm (Generic Model Constructor)
+func (*Console) NewHsm(pkcs11endpoint string) (model *blockchainv3.Hsm, err error) {
+	return constructors.NewHsm(pkcs11endpoint)
+}
+
+// NewMspCryptoField : Instantiate MspCryptoField (Generic Model Constructor)
+func (*Console) NewMspCryptoField(tlsca *blockchainv3.MspCryptoFieldTlsca, component *blockchainv3.MspCryptoFieldComponent) (model *blockchainv3.MspCryptoField, err error) {
+	return constructors.NewMspCryptoField(tlsca, component)
+}
+
+// ListSigTxs : Get all signature collection txs
+func (console *Console) ListSigTxs(listSigTxsOptions *blockchainv3.ListSigTxsOptions) (result *blockchainv3.GetSignatureCollectionsResponse, response *core.DetailedResponse, err error) {
+	return console.ListSigTxsWithContext(context.Background(), listSigTxsOptions)
+}
+
+// GetSigTx : Get a signature collection tx
+func (console *Console) GetSigTx(getSigTxOptions *blockchainv3.GetSigTxOptions) (result *blockchainv3.SignatureCollection, response *core.DetailedResponse, err error) {
+	return console.GetSigTxWithContext(context.Background(), getSigTxOptions)
+}
+
+// CreateSigTx : Create a signature collection tx
+func (console *Console) CreateSigTx(createSigTxOptions *blockchainv3.CreateSigTxOptions) (result *blockchainv3.SignatureCollection, response *core.DetailedResponse, err error) {
+	return console.CreateSigTxWithContext(context.Background(), createSigTxOptions)
+}
+
+// ApproveSigTx : Approve a signature collection tx
+func (console *Console) ApproveSigTx(approveSigTxOptions *blockchainv3.ApproveSigTxOptions) (result *blockchainv3.SignatureCollection, response *core.DetailedResponse, err error) {
+	return console.ApproveSigTxWithContext(context.Background(), approveSigTxOptions)
+}
+
+// SubmitSigTx : Submit a signature collection tx
+func (console *Console) SubmitSigTx(submitSigTxOptions *blockchainv3.SubmitSigTxOptions) (result *blockchainv3.SignatureCollection, response *core.DetailedResponse, err error) {
+	return console.SubmitSigTxWithContext(context.Background(), submitSigTxOptions)
+}
+
+// NewListSigTxsOptions : Instantiate ListSigTxsOptions
+func (*Console) NewListSigTxsOptions() *blockchainv3.ListSigTxsOptions {
+	return constructors.NewListSigTxsOptions()
+}
+
+// NewGetSigTxOptions : Instantiate GetSigTxOptions
+func (*Console) NewGetSigTxOptions(id string) *blockchainv3.GetSigTxOptions {
+	return constructors.NewGetSigTxOptions(id)
+}
+
+// NewCreateSigTxOptions : Instantiate CreateSigTxOptions
+func (*Console) NewCreateSigTxOptions(txID string, channel string, proposal string, orgs2sign []blockchainv3.SignatureCollectionOrg) *blockchainv3.CreateSigTxOptions {
+	return constructors.NewCreateSigTxOptions(txID, channel, proposal, orgs2sign)
+}
+
+// NewApproveSigTxOptions : Instantiate ApproveSigTxOptions
+func (*Console) NewApproveSigTxOptions(id string, mspID string, certificate string, signature string) *blockchainv3.ApproveSigTxOptions {
+	return constructors.NewApproveSigTxOptions(id, mspID, certificate, signature)
+}
+
+// NewSubmitSigTxOptions : Instantiate SubmitSigTxOptions
+func (*Console) NewSubmitSigTxOptions(id string, mspID string, certificate string, signature string) *blockchainv3.SubmitSigTxOptions {
+	return constructors.NewSubmitSigTxOptions(id, mspID, certificate, signature)
+}
@@ -0,0 +1,301 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package blockchainv3fake
+
+import (
+	"context"
+	"fmt"
+	"github.com/IBM-Blockchain/ibp-go-sdk/blockchainv3"
+	"github.com/IBM/go-sdk-core/v4/core"
+	"net/http"
+	"sort"
+)
+
+// defaultSettings are the settings of a new console.
+const defaultSettings = `{
+	"ACTIVITY_TRACKER_PATH": "/logs",
+	"ATHENA_ID": "fake",
+	"AUTH_SCHEME": "iam",
+	"CALLBACK_URI": "/auth/cb",
+	"CLUSTER_DATA": {"type": "paid"},
+	"CONFIGTXLATOR_URL": "https://configtxlator.fake.console.local",
+	"CRN": {"account_id": "a/fake", "c_name": "staging", "c_type": "public", "instance_id": "fake", "location": "us-south", "resource_id": "-", "resource_type": "-", "service_name": "blockchain", "version": "v1"},
+	"CRN_STRING": "crn:v1:staging:public:blockchain:us-south:a/fake:fake::",
+	"DB_SYSTEM": "system",
+	"DOMAIN": "fake.console.local",
+	"ENVIRONMENT": "prod",
+	"FABRIC_CAPABILITIES": {"application": ["V2_0"], "channel": ["V2_0"], "orderer": ["V2_0"]},
+	"FEATURE_FLAGS": {},
+	"FILE_LOGGING": {
+		"server": {"client": {"enabled": true, "level": "silly", "unique_name": false}, "server": {"enabled": true, "level": "silly", "unique_name": false}},
+		"client": {"client": {"enabled": true, "level": "silly", "unique_name": false}, "server": {"enabled": true, "level": "silly", "unique_name": false}}
+	},
+	"HOST_URL": "https://fake.console.local:443",
+	"INACTIVITY_TIMEOUTS": {"enabled": false, "max_idle_time": 90000},
+	"INFRASTRUCTURE": "ibmcloud",
+	"MAX_REQ_PER_MIN": 25,
+	"MAX_REQ_PER_MIN_AK": 25,
+	"PORT": 443,
+	"REGION": "us_south",
+	"TIMEOUTS": {
+		"fabric_get_block_timeout_ms": 10000,
+		"fabric_instantiate_timeout_ms": 300000,
+		"fabric_join_channel_timeout_ms": 25000,
+		"fabric_install_cc_timeout_ms": 300000,
+		"fabric_lc_install_cc_timeout_ms": 300000,
+		"fabric_lc_get_cc_timeout_ms": 180000,
+		"fabric_general_timeout_ms": 10000
+	},
+	"TRUST_UNKNOWN_CERTS": true,
+	"VERSIONS": {"apollo": "fake", "athena": "fake", "stitch": "fake", "tag": "fake"}
+}`
+
+// defaultFabVersions are the Fabric versions a new console can deploy.
+const defaultFabVersions = `{"versions": {
+	"ca": {"1.4.9-5": {"default": true, "version": "1.4.9-5", "image": {}}},
+	"peer": {
+		"1.4.12-2": {"default": false, "version": "1.4.12-2", "image": {}},
+		"2.2.1-4": {"default": true, "version": "2.2.1-4", "image": {}}
+	},
+	"orderer": {
+		"1.4.12-2": {"default": false, "version": "1.4.12-2", "image": {}},
+		"2.2.1-4": {"default": true, "version": "2.2.1-4", "image": {}}
+	}
+}}`
+
+// defaultHealth are the health stats of a new console.
+const defaultHealth = `{
+	"OPTOOLS": {"instance_id": "fake", "up_time": "1 day", "memory_usage": {"rss": "56.1 MB", "heapTotal": "34.4 MB", "heapUsed": "28.4 MB", "external": "369.3 KB"}},
+	"OS": {"arch": "x64", "type": "Linux", "endian": "LE", "loadavg": [0], "total_memory": "31.7 GB", "free_memory": "21.9 GB", "up_time": "1 day"}
+}`
+
+// settingTimeouts are the options of EditSettings stored in the TIMEOUTS setting.
+var settingTimeouts = []string{
+	"fabric_get_block_timeout_ms",
+	"fabric_instantiate_timeout_ms",
+	"fabric_join_channel_timeout_ms",
+	"fabric_install_cc_timeout_ms",
+	"fabric_lc_install_cc_timeout_ms",
+	"fabric_lc_get_cc_timeout_ms",
+	"fabric_general_timeout_ms",
+}
+
+// notification is a stored notification.
+type notification struct {
+	data        map[string]interface{}
+	componentID string
+	archived    bool
+}
+
+// SetSettings replaces the settings returned by GetSettings.
+func (console *Console) SetSettings(settings *blockchainv3.GetPublicSettingsResponse) {
+	console.mu.Lock()
+	defer console.mu.Unlock()
+	console.settings = toObject(settings)
+}
+
+// SetFabVersions replaces the Fabric versions returned by GetFabVersions. The default version of each component type is
+// also the version of the components created without one.
+func (console *Console) SetFabVersions(versions *blockchainv3.GetFabricVersionsResponse) {
+	console.mu.Lock()
+	defer console.mu.Unlock()
+	console.fabVersions = toObject(versions)
+}
+
+// SetHealth replaces the health stats returned by GetHealth.
+func (console *Console) SetHealth(health *blockchainv3.GetAthenaHealthStatsResponse) {
+	console.mu.Lock()
+	defer console.mu.Unlock()
+	console.health = toObject(health)
+}
+
+// AddNotification stores a notification about a component, which may be empty, and returns its ID. The ID and the
+// timestamp are set if the notification does not have them.
+func (console *Console) AddNotification(data blockchainv3.NotificationData, componentID string) string {
+	console.mu.Lock()
+	defer console.mu.Unlock()
+	object := toObject(data)
+	if stringField(object, "id") == "" {
+		console.nextID++
+		object["id"] = fmt.Sprintf("%032x", console.nextID)
+	}
+	if _, ok := object["ts_display"]; !ok {
+		object["ts_display"] = console.now()
+	}
+	console.notifications = append(console.notifications, &notification{data: object, componentID: componentID})
+	return stringField(object, "id")
+}
+
+// UpdateNotification sets the status and the message of a stored notification, as the console does when a pending
+// operation finishes. It returns false if there is no such notification.
+func (console *Console) UpdateNotification(id, status, message string) bool {
+	console.mu.Lock()
+	defer console.mu.Unlock()
+	for _, stored := range console.notifications {
+		if stringField(stored.data, "id") == id {
+			stored.data["status"], stored.data["message"] = status, message
+			return true
+		}
+	}
+	return false
+}
+
+// GetSettings : Get settings
+func (console *Console) GetSettingsWithContext(ctx context.Context, getSettingsOptions *blockchainv3.GetSettingsOptions) (result *blockchainv3.GetPublicSettingsResponse, response *core.DetailedResponse, err error) {
+	if err = validate(ctx, getSettingsOptions, "getSettingsOptions"); err != nil {
+		return
+	}
+	console.mu.Lock()
+	defer console.mu.Unlock()
+	response, err = respond(http.StatusOK, console.settings, &result, blockchainv3.UnmarshalGetPublicSettingsResponse)
+	return
+}
+
+// EditSettings : Change settings
+func (console *Console) EditSettingsWithContext(ctx context.Context, editSettingsOptions *blockchainv3.EditSettingsOptions) (result *blockchainv3.GetPublicSettingsResponse, response *core.DetailedResponse, err error) {
+	if err = validate(ctx, editSettingsOptions, "editSettingsOptions"); err != nil {
+		return
+	}
+	console.mu.Lock()
+	defer console.mu.Unlock()
+	options := toObject(editSettingsOptions)
+	if timeouts, ok := options["inactivity_timeouts"].(map[string]interface{}); ok {
+		merge(object(console.settings, "INACTIVITY_TIMEOUTS"), timeouts)
+	}
+	if logging, ok := options["file_logging"].(map[string]interface{}); ok {
+		merge(object(object(console.settings, "FILE_LOGGING"), "server"), logging)
+		merge(object(object(console.settings, "FILE_LOGGING"), "client"), logging)
+	}
+	if value, ok := options["max_req_per_min"]; ok {
+		console.settings["MAX_REQ_PER_MIN"] = value
+	}
+	if value, ok := options["max_req_per_min_ak"]; ok {
+		console.settings["MAX_REQ_PER_MIN_AK"] = value
+	}
+	for _, key := range settingTimeouts {
+		if value, ok := options[key]; ok {
+			object(console.settings, "TIMEOUTS")[key] = value
+		}
+	}
+	response, err = respond(http.StatusOK, console.settings, &result, blockchainv3.UnmarshalGetPublicSettingsResponse)
+	return
+}
+
+// GetFabVersions : Get supported Fabric versions
+func (console *Console) GetFabVersionsWithContext(ctx context.Context, getFabVersionsOptions *blockchainv3.GetFabVersionsOptions) (result *blockchainv3.GetFabricVersionsResponse, response *core.DetailedResponse, err error) {
+	if err = validate(ctx, getFabVersionsOptions, "getFabVersionsOptions"); err != nil {
+		return
+	}
+	console.mu.Lock()
+	defer console.mu.Unlock()
+	response, err = respond(http.StatusOK, console.fabVersions, &result, blockchainv3.UnmarshalGetFabricVersionsResponse)
+	return
+}
+
+// GetHealth : Get IBP console health stats
+func (console *Console) GetHealthWithContext(ctx context.Context, getHealthOptions *blockchainv3.GetHealthOptions) (result *blockchainv3.GetAthenaHealthStatsResponse, response *core.DetailedResponse, err error) {
+	if err = validate(ctx, getHealthOptions, "getHealthOptions"); err != nil {
+		return
+	}
+	console.mu.Lock()
+	defer console.mu.Unlock()
+	response, err = respond(http.StatusOK, console.health, &result, blockchainv3.UnmarshalGetAthenaHealthStatsResponse)
+	return
+}
+
+// ListNotifications : Get all notifications
+// The notifications that are not archived are returned newest first.
+func (console *Console) ListNotificationsWithContext(ctx context.Context, listNotificationsOptions *blockchainv3.ListNotificationsOptions) (result *blockchainv3.GetNotificationsResponse, response *core.DetailedResponse, err error) {
+	if err = validate(ctx, listNotificationsOptions, "listNotificationsOptions"); err != nil {
+		return
+	}
+	console.mu.Lock()
+	defer console.mu.Unlock()
+	var matching []map[string]interface{}
+	for _, stored := range console.notifications {
+		if stored.archived || (listNotificationsOptions.ComponentID != nil && stored.componentID != *listNotificationsOptions.ComponentID) {
+			continue
+		}
+		matching = append(matching, stored.data)
+	}
+	sort.SliceStable(matching, func(i, j int) bool {
+		ti, _ := matching[i]["ts_display"].(float64)
+		tj, _ := matching[j]["ts_display"].(float64)
+		return ti > tj
+	})
+
+	page := matching
+	if skip := listNotificationsOptions.Skip; skip != nil && *skip > 0 {
+		if int(*skip) >= len(page) {
+			page = nil
+		} else {
+			page = page[int(*skip):]
+		}
+	}
+	if limit := listNotificationsOptions.Limit; limit != nil && *limit > 0 && int(*limit) < len(page) {
+		page = page[:int(*limit)]
+	}
+	notifications := make([]interface{}, len(page))
+	for i, data := range page {
+		notifications[i] = clone(data)
+	}
+	body := map[string]interface{}{
+		"total":         float64(len(matching)),
+		"returning":     float64(len(page)),
+		"notifications": notifications,
+	}
+	response, err = respond(http.StatusOK, body, &result, blockchainv3.UnmarshalGetNotificationsResponse)
+	return
+}
+
+// ArchiveNotifications : Archive notifications
+func (console *Console) ArchiveNotificationsWithContext(ctx context.Context, archiveNotificationsOptions *blockchainv3.ArchiveNotificationsOptions) (result *blockchainv3.ArchiveResponse, response *core.DetailedResponse, err error) {
+	if err = validate(ctx, archiveNotificationsOptions, "archiveNotificationsOptions"); err != nil {
+		return
+	}
+	console.mu.Lock()
+	defer console.mu.Unlock()
+	archived := 0
+	for _, stored := range console.notifications {
+		if !stored.archived && indexOf(archiveNotificationsOptions.NotificationIds, stringField(stored.data, "id")) >= 0 {
+			stored.archived = true
+			archived++
+		}
+	}
+	body := map[string]interface{}{"message": "ok", "details": fmt.Sprintf("archived %d notification(s)", archived)}
+	response, err = respond(http.StatusOK, body, &result, blockchainv3.UnmarshalArchiveResponse)
+	return
+}
+
+// DeleteAllNotifications : Delete all notifications
+func (console *Console) DeleteAllNotificationsWithContext(ctx context.Context, deleteAllNotificationsOptions *blockchainv3.DeleteAllNotificationsOptions) (result *blockchainv3.DeleteAllNotificationsResponse, response *core.DetailedResponse, err error) {
+	if err = validate(ctx, deleteAllNotificationsOptions, "deleteAllNotificationsOptions"); err != nil {
+		return
+	}
+	console.mu.Lock()
+	defer console.mu.Unlock()
+	body := map[string]interface{}{"message": "ok", "details": fmt.Sprintf("deleted %d notification(s)", len(console.notifications))}
+	console.notifications = nil
+	response, err = respond(http.StatusOK, body, &result, blockchainv3.UnmarshalDeleteAllNotificationsResponse)
+	return
+}
+
+// WatchNotifications : Watch the console notifications
+// The fake polls its own notifications the way the client does.
+func (console *Console) WatchNotifications(ctx context.Context, filter *blockchainv3.NotificationFilter) <-chan *blockchainv3.NotificationEvent {
+	return blockchainv3.PollNotifications(ctx, console, filter)
+}
@@ -0,0 +1,184 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package blockchainv3fake
+
+import (
+	"context"
+	"github.com/IBM-Blockchain/ibp-go-sdk/blockchainv3"
+	"github.com/IBM/go-sdk-core/v4/core"
+	"net/http"
+)
+
+// ListSigTxs : Get all signature collection txs
+func (console *Console) ListSigTxsWithContext(ctx context.Context, listSigTxsOptions *blockchainv3.ListSigTxsOptions) (result *blockchainv3.GetSignatureCollectionsResponse, response *core.DetailedResponse, err error) {
+	if err = validate(ctx, listSigTxsOptions, "listSigTxsOptions"); err != nil {
+		return
+	}
+	console.mu.Lock()
+	defer console.mu.Unlock()
+	collections := []interface{}{}
+	for _, id := range console.sigTxOrder {
+		collections = append(collections, console.sigTxs[id])
+	}
+	body := map[string]interface{}{"signature_collections": collections}
+	response, err = respond(http.StatusOK, body, &result, blockchainv3.UnmarshalGetSignatureCollectionsResponse)
+	return
+}
+
+// GetSigTx : Get a signature collection tx
+func (console *Console) GetSigTxWithContext(ctx context.Context, getSigTxOptions *blockchainv3.GetSigTxOptions) (result *blockchainv3.SignatureCollection, response *core.DetailedResponse, err error) {
+	if err = validate(ctx, getSigTxOptions, "getSigTxOptions"); err != nil {
+		return
+	}
+	console.mu.Lock()
+	defer console.mu.Unlock()
+	collection, response, err := console.sigTx("GetSigTx", *getSigTxOptions.ID)
+	if err != nil {
+		return
+	}
+	response, err = respond(http.StatusOK, collection, &result, blockchainv3.UnmarshalSignatureCollection)
+	return
+}
+
+// CreateSigTx : Create a signature collection tx
+// The signatures of the organizations to sign are dropped: they must approve the collection.
+func (console *Console) CreateSigTxWithContext(ctx context.Context, createSigTxOptions *blockchainv3.CreateSigTxOptions) (result *blockchainv3.SignatureCollection, response *core.DetailedResponse, err error) {
+	if err = validate(ctx, createSigTxOptions, "createSigTxOptions"); err != nil {
+		return
+	}
+	console.mu.Lock()
+	defer console.mu.Unlock()
+	id := *createSigTxOptions.TxID
+	if _, ok := console.sigTxs[id]; ok {
+		response, err = fail("CreateSigTx", http.StatusConflict, "the tx_id %q is already in use", id)
+		return
+	}
+	collection := toObject(createSigTxOptions)
+	orgs, _ := collection["orgs2sign"].([]interface{})
+	for _, org := range orgs {
+		if org, ok := org.(map[string]interface{}); ok {
+			delete(org, "certificate")
+			delete(org, "signature")
+			delete(org, "timestamp")
+		}
+	}
+	collection["status"] = blockchainv3.SignatureCollection_Status_Open
+	collection["timestamp"] = console.now()
+	collection["updated"] = console.now()
+	console.sigTxs[id] = collection
+	console.sigTxOrder = append(console.sigTxOrder, id)
+	response, err = respond(http.StatusOK, collection, &result, blockchainv3.UnmarshalSignatureCollection)
+	return
+}
+
+// ApproveSigTx : Approve a signature collection tx
+func (console *Console) ApproveSigTxWithContext(ctx context.Context, approveSigTxOptions *blockchainv3.ApproveSigTxOptions) (result *blockchainv3.SignatureCollection, response *core.DetailedResponse, err error) {
+	if err = validate(ctx, approveSigTxOptions, "approveSigTxOptions"); err != nil {
+		return
+	}
+	console.mu.Lock()
+	defer console.mu.Unlock()
+	collection, response, err := console.openSigTx("ApproveSigTx", *approveSigTxOptions.ID)
+	if err != nil {
+		return
+	}
+	var org map[string]interface{}
+	orgs, _ := collection["orgs2sign"].([]interface{})
+	for _, candidate := range orgs {
+		if candidate, ok := candidate.(map[string]interface{}); ok && stringField(candidate, "msp_id") == *approveSigTxOptions.MspID {
+			org = candidate
+		}
+	}
+	if org == nil {
+		response, err = fail("ApproveSigTx", http.StatusBadRequest, "msp %q is not asked to sign signature collection %q", *approveSigTxOptions.MspID, *approveSigTxOptions.ID)
+		return
+	}
+	org["certificate"] = *approveSigTxOptions.Certificate
+	org["signature"] = *approveSigTxOptions.Signature
+	org["timestamp"] = console.now()
+	collection["updated"] = console.now()
+	response, err = respond(http.StatusOK, collection, &result, blockchainv3.UnmarshalSignatureCollection)
+	return
+}
+
+// SubmitSigTx : Submit a signature collection tx
+// The fake has no ordering service: the collection is closed once every organization approved it.
+func (console *Console) SubmitSigTxWithContext(ctx context.Context, submitSigTxOptions *blockchainv3.SubmitSigTxOptions) (result *blockchainv3.SignatureCollection, response *core.DetailedResponse, err error) {
+	if err = validate(ctx, submitSigTxOptions, "submitSigTxOptions"); err != nil {
+		return
+	}
+	console.mu.Lock()
+	defer console.mu.Unlock()
+	collection, response, err := console.openSigTx("SubmitSigTx", *submitSigTxOptions.ID)
+	if err != nil {
+		return
+	}
+	orgs, _ := collection["orgs2sign"].([]interface{})
+	for _, org := range orgs {
+		if org, ok := org.(map[string]interface{}); ok && stringField(org, "signature") == "" {
+			response, err = fail("SubmitSigTx", http.StatusBadRequest, "msp %q has not signed signature collection %q", stringField(org, "msp_id"), *submitSigTxOptions.ID)
+			return
+		}
+	}
+	collection["status"] = blockchainv3.SignatureCollection_Status_Closed
+	collection["updated"] = console.now()
+	response, err = respond(http.StatusOK, collection, &result, blockchainv3.UnmarshalSignatureCollection)
+	return
+}
+
+// DeleteSigTx : Delete a signature collection tx
+func (console *Console) DeleteSigTxWithContext(ctx context.Context, deleteSigTxOptions *blockchainv3.DeleteSigTxOptions) (result *blockchainv3.DeleteSignatureCollectionResponse, response *core.DetailedResponse, err error) {
+	if err = validate(ctx, deleteSigTxOptions, "deleteSigTxOptions"); err != nil {
+		return
+	}
+	console.mu.Lock()
+	defer console.mu.Unlock()
+	id := *deleteSigTxOptions.ID
+	if _, response, err = console.sigTx("DeleteSigTx", id); err != nil {
+		return
+	}
+	delete(console.sigTxs, id)
+	if i := indexOf(console.sigTxOrder, id); i >= 0 {
+		console.sigTxOrder = append(console.sigTxOrder[:i], console.sigTxOrder[i+1:]...)
+	}
+	body := map[string]interface{}{"message": "ok", "tx_id": id}
+	response, err = respond(http.StatusOK, body, &result, blockchainv3.UnmarshalDeleteSignatureCollectionResponse)
+	return
+}
+
+// sigTx returns a stored signature collection, or the error of an operation on a missing one.
+func (console *Console) sigTx(operation, id string) (map[string]interface{}, *core.DetailedResponse, error) {
+	collection, ok := console.sigTxs[id]
+	if !ok {
+		response, err := fail(operation, http.StatusNotFound, "signature collection %q not found", id)
+		return nil, response, err
+	}
+	return collection, nil, nil
+}
+
+// openSigTx returns a stored signature collection that was not submitted yet.
+func (console *Console) openSigTx(operation, id string) (map[string]interface{}, *core.DetailedResponse, error) {
+	collection, response, err := console.sigTx(operation, id)
+	if err != nil {
+		return nil, response, err
+	}
+	if stringField(collection, "status") != blockchainv3.SignatureCollection_Status_Open {
+		response, err = fail(operation, http.StatusBadRequest, "signature collection %q is closed", id)
+		return nil, response, err
+	}
+	return collection, nil, nil
+}
@@ -0,0 +1,210 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package certs inventories the certificates of a console's components and MSPs and finds the ones that expire soon.
+package certs
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"github.com/IBM-Blockchain/ibp-go-sdk/blockchainv3"
+	"github.com/IBM/go-sdk-core/v4/core"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Certificate : A certificate of a component or MSP.
+type Certificate struct {
+	// The component holding the certificate.
+	ComponentID string `json:"component_id,omitempty"`
+
+	DisplayName string `json:"display_name,omitempty"`
+
+	// The component type, e.g. "fabric-peer" or "msp".
+	Type string `json:"type,omitempty"`
+
+	MspID string `json:"msp_id,omitempty"`
+
+	// Where the certificate is found in the component, e.g. "msp.component.ecert" or "msp.ca.root_certs[0]".
+	Field string `json:"field"`
+
+	Subject string `json:"subject,omitempty"`
+
+	Issuer string `json:"issuer,omitempty"`
+
+	SerialNumberHex string `json:"serial_number_hex,omitempty"`
+
+	NotBefore time.Time `json:"not_before"`
+
+	NotAfter time.Time `json:"not_after"`
+
+	// Why the certificate could not be parsed. The other fields describing the certificate are empty when it is set.
+	Error string `json:"error,omitempty"`
+}
+
+// DER returns the DER encoding of a base 64 encoded PEM certificate, the form the console uses, or of a PEM certificate.
+func DER(cert string) ([]byte, error) {
+	data, err := base64.StdEncoding.DecodeString(strings.TrimSpace(cert))
+	if err != nil {
+		data = []byte(cert)
+	}
+	block, _ := pem.Decode(data)
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, errors.New("not a PEM certificate")
+	}
+	return block.Bytes, nil
+}
+
+// Encode returns a DER certificate as a base 64 encoded PEM certificate, the form the console uses.
+func Encode(der []byte) string {
+	return base64.StdEncoding.EncodeToString(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+// Parse parses a base 64 encoded PEM certificate, the form the console uses, or a PEM certificate.
+func Parse(cert string) (*Certificate, error) {
+	der, err := DER(cert)
+	if err != nil {
+		return nil, err
+	}
+	certificate, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, err
+	}
+	return &Certificate{
+		Subject:         certificate.Subject.String(),
+		Issuer:          certificate.Issuer.String(),
+		SerialNumberHex: certificate.SerialNumber.Text(16),
+		NotBefore:       certificate.NotBefore.UTC(),
+		NotAfter:        certificate.NotAfter.UTC(),
+	}, nil
+}
+
+// collector gathers the certificates of a component.
+type collector struct {
+	template Certificate
+	certs    []Certificate
+}
+
+func (c *collector) add(field string, cert *string) {
+	if cert == nil || *cert == "" {
+		return
+	}
+	parsed, err := Parse(*cert)
+	if err != nil {
+		parsed = &Certificate{Error: err.Error()}
+	}
+	parsed.ComponentID, parsed.DisplayName = c.template.ComponentID, c.template.DisplayName
+	parsed.Type, parsed.MspID = c.template.Type, c.template.MspID
+	parsed.Field = field
+	c.certs = append(c.certs, *parsed)
+}
+
+func (c *collector) addAll(field string, certs []string) {
+	for i := range certs {
+		c.add(field+"["+strconv.Itoa(i)+"]", &certs[i])
+	}
+}
+
+// ComponentCertificates returns the certificates of a component: the root certificates of its CA and TLS CA, and its
+// enrollment, TLS and admin certificates.
+func ComponentCertificates(component *blockchainv3.GenericComponentResponse) []Certificate {
+	c := &collector{template: Certificate{
+		ComponentID: core.StringNilMapper(component.ID),
+		DisplayName: core.StringNilMapper(component.DisplayName),
+		Type:        core.StringNilMapper(component.Type),
+		MspID:       core.StringNilMapper(component.MspID),
+	}}
+	if msp := component.Msp; msp != nil {
+		if msp.Ca != nil {
+			c.addAll("msp.ca.root_certs", msp.Ca.RootCerts)
+		}
+		if msp.Tlsca != nil {
+			c.addAll("msp.tlsca.root_certs", msp.Tlsca.RootCerts)
+		}
+		if msp.Component != nil {
+			c.add("msp.component.ecert", msp.Component.Ecert)
+			c.add("msp.component.tls_cert", msp.Component.TlsCert)
+			c.addAll("msp.component.admin_certs", msp.Component.AdminCerts)
+		}
+	}
+	return c.certs
+}
+
+// MspCertificates returns the root, admin and TLS root certificates of an MSP definition.
+func MspCertificates(msp *blockchainv3.MspPublicData) []Certificate {
+	c := &collector{template: Certificate{Type: "msp", MspID: core.StringNilMapper(msp.MspID)}}
+	c.addAll("root_certs", msp.RootCerts)
+	c.addAll("admins", msp.Admins)
+	c.addAll("tls_root_certs", msp.TlsRootCerts)
+	return c.certs
+}
+
+// Inventory lists the certificates of every component of the console, and of the MSP definitions of its MSP
+// components. The console's parsed certificates are not part of the component model, so the certificates are parsed
+// from the PEMs the components hold.
+func Inventory(ctx context.Context, service blockchainv3.BlockchainV3API) ([]Certificate, error) {
+	list, _, err := service.ListComponentsWithContext(ctx, service.NewListComponentsOptions())
+	if err != nil {
+		return nil, fmt.Errorf("could not list the components: %w", err)
+	}
+	var certs []Certificate
+	mspIDs := map[string]bool{}
+	for i := range list.Components {
+		component := &list.Components[i]
+		if core.StringNilMapper(component.Type) != "msp" {
+			certs = append(certs, ComponentCertificates(component)...)
+			continue
+		}
+		mspID := core.StringNilMapper(component.MspID)
+		if mspID == "" || mspIDs[mspID] {
+			continue
+		}
+		mspIDs[mspID] = true
+		msp, _, err := service.GetMspCertificateWithContext(ctx, service.NewGetMspCertificateOptions(mspID))
+		if err != nil {
+			return nil, fmt.Errorf("could not get MSP %s: %w", mspID, err)
+		}
+		for j := range msp.Msps {
+			mspCerts := MspCertificates(&msp.Msps[j])
+			for k := range mspCerts {
+				mspCerts[k].ComponentID = core.StringNilMapper(component.ID)
+				mspCerts[k].DisplayName = core.StringNilMapper(component.DisplayName)
+			}
+			certs = append(certs, mspCerts...)
+		}
+	}
+	return certs, nil
+}
+
+// ExpiringWithin returns the parsed certificates that expire before now plus the window, including the expired ones,
+// soonest first.
+func ExpiringWithin(certs []Certificate, now time.Time, window time.Duration) []Certificate {
+	deadline := now.Add(window)
+	var expiring []Certificate
+	for _, cert := range certs {
+		if cert.Error == "" && cert.NotAfter.Before(deadline) {
+			expiring = append(expiring, cert)
+		}
+	}
+	sort.SliceStable(expiring, func(i, j int) bool { return expiring[i].NotAfter.Before(expiring[j].NotAfter) })
+	return expiring
+}
@@ -0,0 +1,52 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package certs
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"text/tabwriter"
+	"time"
+)
+
+// WriteJSON writes the certificates as an indented JSON array.
+func WriteJSON(w io.Writer, certs []Certificate) error {
+	if certs == nil {
+		certs = []Certificate{}
+	}
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(certs)
+}
+
+// WriteTable writes the certificates as a table with a line per certificate and the days they have left at now.
+func WriteTable(w io.Writer, certs []Certificate, now time.Time) error {
+	table := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(table, "COMPONENT\tTYPE\tFIELD\tSUBJECT\tISSUER\tSERIAL\tNOT AFTER\tDAYS LEFT")
+	for _, cert := range certs {
+		if cert.Error != "" {
+			fmt.Fprintf(table, "%s\t%s\t%s\t(%s)\t\t\t\t\n", cert.ComponentID, cert.Type, cert.Field, cert.Error)
+			continue
+		}
+		daysLeft := int(math.Floor(cert.NotAfter.Sub(now).Hours() / 24))
+		fmt.Fprintf(table, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%d\n", cert.ComponentID, cert.Type, cert.Field, cert.Subject,
+			cert.Issuer, cert.SerialNumberHex, cert.NotAfter.Format(time.RFC3339), daysLeft)
+	}
+	return table.Flush()
+}
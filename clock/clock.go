@@ -0,0 +1,51 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package clock is the source of time of the helper packages, which tests replace with a clock they control.
+package clock
+
+import (
+	"time"
+)
+
+// Clock : A source of time.
+type Clock interface {
+	Now() time.Time
+
+	// After waits for the duration to elapse and then sends the current time on the returned channel, like time.After.
+	After(d time.Duration) <-chan time.Time
+}
+
+// System is the Clock of the time package.
+var System Clock = system{}
+
+type system struct{}
+
+func (system) Now() time.Time {
+	return time.Now()
+}
+
+func (system) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
+// OrSystem returns the clock, or System if it is nil.
+func OrSystem(clock Clock) Clock {
+	if clock == nil {
+		return System
+	}
+	return clock
+}
@@ -0,0 +1,36 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package clock_test
+
+import (
+	"github.com/IBM-Blockchain/ibp-go-sdk/clock"
+	"github.com/IBM-Blockchain/ibp-go-sdk/internal/testutil"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"time"
+)
+
+var _ = Describe(`Clock`, func() {
+	It(`Defaults to the system clock`, func() {
+		Expect(clock.OrSystem(nil)).To(Equal(clock.System))
+		Expect(clock.System.Now()).To(BeTemporally("~", time.Now(), time.Second))
+		Eventually(clock.System.After(time.Millisecond)).Should(Receive())
+
+		fake := testutil.NewClock(time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC))
+		Expect(clock.OrSystem(fake)).To(BeIdenticalTo(fake))
+	})
+})
@@ -0,0 +1,129 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Command ibp-health-exporter serves the health of an IBP console and of its components as Prometheus metrics.
+//
+//	IBP_API_KEY=... ibp-health-exporter -url https://my-console.blockchain.cloud.ibm.com -listen :9464
+//
+// The console is authenticated with the IAM API key in IBP_API_KEY, or with the IBP_USERNAME and IBP_PASSWORD of a
+// software console. The metrics are served on /metrics.
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"flag"
+	"fmt"
+	"github.com/IBM-Blockchain/ibp-go-sdk/blockchainv3"
+	"github.com/IBM-Blockchain/ibp-go-sdk/health"
+	"github.com/IBM/go-sdk-core/v4/core"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+)
+
+func main() {
+	consoleURL := flag.String("url", os.Getenv("IBP_CONSOLE_URL"), "the URL of the console (defaults to $IBP_CONSOLE_URL)")
+	listen := flag.String("listen", ":9464", "the address to serve the metrics on")
+	interval := flag.Duration("interval", health.DefaultInterval, "the delay between two scrapes")
+	probeTimeout := flag.Duration("probe-timeout", health.DefaultProbeTimeout, "the timeout of the /healthz probes")
+	probeCA := flag.String("probe-ca", "", "a PEM file of the root certificates the operations endpoints are trusted with")
+	insecure := flag.Bool("insecure-skip-verify", false, "do not verify the TLS certificates of the operations endpoints")
+	flag.Parse()
+
+	if err := run(*consoleURL, *listen, *interval, *probeTimeout, *probeCA, *insecure); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func run(consoleURL, listen string, interval, probeTimeout time.Duration, probeCA string, insecure bool) error {
+	if consoleURL == "" {
+		return fmt.Errorf("the console URL is required")
+	}
+	authenticator, err := newAuthenticator()
+	if err != nil {
+		return err
+	}
+	service, err := blockchainv3.NewBlockchainV3(&blockchainv3.BlockchainV3Options{
+		URL:           consoleURL,
+		Authenticator: authenticator,
+	})
+	if err != nil {
+		return err
+	}
+
+	tlsConfig := &tls.Config{InsecureSkipVerify: insecure}
+	if probeCA != "" {
+		pem, err := ioutil.ReadFile(probeCA)
+		if err != nil {
+			return err
+		}
+		tlsConfig.RootCAs = x509.NewCertPool()
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(pem) {
+			return fmt.Errorf("no certificate found in %s", probeCA)
+		}
+	}
+	monitor := &health.Monitor{
+		Service:  service,
+		Interval: interval,
+		Client: &http.Client{
+			Timeout:   probeTimeout,
+			Transport: &http.Transport{TLSClientConfig: tlsConfig},
+		},
+		OnError: func(err error) {
+			log.Printf("scrape failed: %s", err)
+		},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		<-signals
+		cancel()
+	}()
+	go monitor.Run(ctx)
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", monitor.Handler())
+	server := &http.Server{Addr: listen, Handler: mux}
+	go func() {
+		<-ctx.Done()
+		server.Shutdown(context.Background())
+	}()
+	log.Printf("serving the metrics of %s on %s/metrics", consoleURL, listen)
+	if err := server.ListenAndServe(); err != http.ErrServerClosed {
+		return err
+	}
+	return nil
+}
+
+// newAuthenticator returns the authenticator of the console from the environment.
+func newAuthenticator() (core.Authenticator, error) {
+	if apiKey := os.Getenv("IBP_API_KEY"); apiKey != "" {
+		return core.NewIamAuthenticator(apiKey, "", "", "", false, nil)
+	}
+	if username := os.Getenv("IBP_USERNAME"); username != "" {
+		return core.NewBasicAuthenticator(username, os.Getenv("IBP_PASSWORD"))
+	}
+	return nil, fmt.Errorf("set IBP_API_KEY, or IBP_USERNAME and IBP_PASSWORD")
+}
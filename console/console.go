@@ -0,0 +1,242 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package console : A client interface that works against IBP consoles serving either API version 2 or 3
+package console
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/IBM-Blockchain/ibp-go-sdk/blockchainv2"
+	"github.com/IBM-Blockchain/ibp-go-sdk/blockchainv3"
+	"github.com/IBM/go-sdk-core/v4/core"
+)
+
+// The API versions a Client can talk to.
+const (
+	APIVersion2 = 2
+	APIVersion3 = 3
+)
+
+// Client : The operations common to the IBP console APIs.
+// Options and results use the API version 3 models whatever version the console serves. Operations, and option fields,
+// that the console's API version does not have fail with an *UnsupportedError before any request is sent. Unsuccessful
+// responses are returned as the blockchainv3 error types (*blockchainv3.NotFoundError etc.) by both versions.
+type Client interface {
+	// APIVersion returns the API version the client talks to, APIVersion2 or APIVersion3.
+	APIVersion() int
+
+	// GetComponent : Get component data
+	GetComponent(ctx context.Context, getComponentOptions *blockchainv3.GetComponentOptions) (result *blockchainv3.GenericComponentResponse, response *core.DetailedResponse, err error)
+
+	// ListComponents : Get all components
+	ListComponents(ctx context.Context, listComponentsOptions *blockchainv3.ListComponentsOptions) (result *blockchainv3.GetMultiComponentsResponse, response *core.DetailedResponse, err error)
+
+	// GetComponentsByType : Get components of a type
+	GetComponentsByType(ctx context.Context, getComponentsByTypeOptions *blockchainv3.GetComponentsByTypeOptions) (result *blockchainv3.GetMultiComponentsResponse, response *core.DetailedResponse, err error)
+
+	// GetComponentsByTag : Get components with tag
+	GetComponentsByTag(ctx context.Context, getComponentsByTagOptions *blockchainv3.GetComponentsByTagOptions) (result *blockchainv3.GetMultiComponentsResponse, response *core.DetailedResponse, err error)
+
+	// RemoveComponent : Remove imported component
+	RemoveComponent(ctx context.Context, removeComponentOptions *blockchainv3.RemoveComponentOptions) (result *blockchainv3.DeleteComponentResponse, response *core.DetailedResponse, err error)
+
+	// DeleteComponent : Delete component
+	DeleteComponent(ctx context.Context, deleteComponentOptions *blockchainv3.DeleteComponentOptions) (result *blockchainv3.DeleteComponentResponse, response *core.DetailedResponse, err error)
+
+	// RemoveComponentsByTag : Remove components with tag
+	RemoveComponentsByTag(ctx context.Context, removeComponentsByTagOptions *blockchainv3.RemoveComponentsByTagOptions) (result *blockchainv3.RemoveMultiComponentsResponse, response *core.DetailedResponse, err error)
+
+	// DeleteComponentsByTag : Delete components with tag
+	DeleteComponentsByTag(ctx context.Context, deleteComponentsByTagOptions *blockchainv3.DeleteComponentsByTagOptions) (result *blockchainv3.DeleteMultiComponentsResponse, response *core.DetailedResponse, err error)
+
+	// CreateCa : Create a CA
+	CreateCa(ctx context.Context, createCaOptions *blockchainv3.CreateCaOptions) (result *blockchainv3.CaResponse, response *core.DetailedResponse, err error)
+
+	// ImportCa : Import a CA
+	ImportCa(ctx context.Context, importCaOptions *blockchainv3.ImportCaOptions) (result *blockchainv3.CaResponse, response *core.DetailedResponse, err error)
+
+	// UpdateCa : Update a CA
+	UpdateCa(ctx context.Context, updateCaOptions *blockchainv3.UpdateCaOptions) (result *blockchainv3.CaResponse, response *core.DetailedResponse, err error)
+
+	// EditCa : Edit data about a CA
+	EditCa(ctx context.Context, editCaOptions *blockchainv3.EditCaOptions) (result *blockchainv3.CaResponse, response *core.DetailedResponse, err error)
+
+	// CaAction : Submit action to a CA
+	CaAction(ctx context.Context, caActionOptions *blockchainv3.CaActionOptions) (result *blockchainv3.ActionsResponse, response *core.DetailedResponse, err error)
+
+	// CreatePeer : Create a peer
+	CreatePeer(ctx context.Context, createPeerOptions *blockchainv3.CreatePeerOptions) (result *blockchainv3.PeerResponse, response *core.DetailedResponse, err error)
+
+	// ImportPeer : Import a peer
+	ImportPeer(ctx context.Context, importPeerOptions *blockchainv3.ImportPeerOptions) (result *blockchainv3.PeerResponse, response *core.DetailedResponse, err error)
+
+	// UpdatePeer : Update a peer
+	UpdatePeer(ctx context.Context, updatePeerOptions *blockchainv3.UpdatePeerOptions) (result *blockchainv3.PeerResponse, response *core.DetailedResponse, err error)
+
+	// EditPeer : Edit data about a peer
+	EditPeer(ctx context.Context, editPeerOptions *blockchainv3.EditPeerOptions) (result *blockchainv3.PeerResponse, response *core.DetailedResponse, err error)
+
+	// PeerAction : Submit action to a peer
+	PeerAction(ctx context.Context, peerActionOptions *blockchainv3.PeerActionOptions) (result *blockchainv3.ActionsResponse, response *core.DetailedResponse, err error)
+
+	// CreateOrderer : Create an ordering service
+	CreateOrderer(ctx context.Context, createOrdererOptions *blockchainv3.CreateOrdererOptions) (result *blockchainv3.CreateOrdererResponse, response *core.DetailedResponse, err error)
+
+	// ImportOrderer : Import an ordering service
+	ImportOrderer(ctx context.Context, importOrdererOptions *blockchainv3.ImportOrdererOptions) (result *blockchainv3.OrdererResponse, response *core.DetailedResponse, err error)
+
+	// UpdateOrderer : Update an orderer node
+	UpdateOrderer(ctx context.Context, updateOrdererOptions *blockchainv3.UpdateOrdererOptions) (result *blockchainv3.OrdererResponse, response *core.DetailedResponse, err error)
+
+	// EditOrderer : Edit data about an orderer
+	EditOrderer(ctx context.Context, editOrdererOptions *blockchainv3.EditOrdererOptions) (result *blockchainv3.OrdererResponse, response *core.DetailedResponse, err error)
+
+	// OrdererAction : Submit action to an orderer
+	OrdererAction(ctx context.Context, ordererActionOptions *blockchainv3.OrdererActionOptions) (result *blockchainv3.ActionsResponse, response *core.DetailedResponse, err error)
+
+	// SubmitBlock : Submit config block to orderer
+	SubmitBlock(ctx context.Context, submitBlockOptions *blockchainv3.SubmitBlockOptions) (result *blockchainv3.GenericComponentResponse, response *core.DetailedResponse, err error)
+
+	// ImportMsp : Import an MSP
+	ImportMsp(ctx context.Context, importMspOptions *blockchainv3.ImportMspOptions) (result *blockchainv3.MspResponse, response *core.DetailedResponse, err error)
+
+	// EditMsp : Edit an MSP
+	EditMsp(ctx context.Context, editMspOptions *blockchainv3.EditMspOptions) (result *blockchainv3.MspResponse, response *core.DetailedResponse, err error)
+
+	// GetMspCertificate : Get MSP's public certificates
+	GetMspCertificate(ctx context.Context, getMspCertificateOptions *blockchainv3.GetMspCertificateOptions) (result *blockchainv3.GetMSPCertificateResponse, response *core.DetailedResponse, err error)
+
+	// EditAdminCerts : Edit admin certs on a component
+	EditAdminCerts(ctx context.Context, editAdminCertsOptions *blockchainv3.EditAdminCertsOptions) (result *blockchainv3.EditAdminCertsResponse, response *core.DetailedResponse, err error)
+
+	// GetSettings : Get public IBP console settings
+	GetSettings(ctx context.Context, getSettingsOptions *blockchainv3.GetSettingsOptions) (result *blockchainv3.GetPublicSettingsResponse, response *core.DetailedResponse, err error)
+
+	// EditSettings : Change IBP console settings
+	EditSettings(ctx context.Context, editSettingsOptions *blockchainv3.EditSettingsOptions) (result *blockchainv3.GetPublicSettingsResponse, response *core.DetailedResponse, err error)
+
+	// GetFabVersions : Get supported Fabric versions
+	GetFabVersions(ctx context.Context, getFabVersionsOptions *blockchainv3.GetFabVersionsOptions) (result *blockchainv3.GetFabricVersionsResponse, response *core.DetailedResponse, err error)
+
+	// GetHealth : Get IBP console health stats
+	GetHealth(ctx context.Context, getHealthOptions *blockchainv3.GetHealthOptions) (result *blockchainv3.GetAthenaHealthStatsResponse, response *core.DetailedResponse, err error)
+
+	// ListNotifications : Get all notifications
+	ListNotifications(ctx context.Context, listNotificationsOptions *blockchainv3.ListNotificationsOptions) (result *blockchainv3.GetNotificationsResponse, response *core.DetailedResponse, err error)
+
+	// ArchiveNotifications : Archive notifications
+	ArchiveNotifications(ctx context.Context, archiveNotificationsOptions *blockchainv3.ArchiveNotificationsOptions) (result *blockchainv3.ArchiveResponse, response *core.DetailedResponse, err error)
+
+	// DeleteSigTx : Delete a signature collection tx
+	DeleteSigTx(ctx context.Context, deleteSigTxOptions *blockchainv3.DeleteSigTxOptions) (result *blockchainv3.DeleteSignatureCollectionResponse, response *core.DetailedResponse, err error)
+}
+
+// Options : The options of New.
+type Options struct {
+	// The console URL, e.g. "https://console.example.com:443".
+	URL string
+
+	Authenticator core.Authenticator
+
+	// The API version to use, APIVersion2 or APIVersion3. Zero detects it with DetectAPIVersion.
+	APIVersion int
+}
+
+// New : Create a Client for a console
+// Unless options.APIVersion is set, the console is probed for the newest API version it serves.
+func New(ctx context.Context, options *Options) (Client, error) {
+	if options == nil || options.URL == "" {
+		return nil, fmt.Errorf("the console URL is required")
+	}
+	version := options.APIVersion
+	if version == 0 {
+		var err error
+		version, err = DetectAPIVersion(ctx, options.URL, options.Authenticator)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	switch version {
+	case APIVersion2:
+		service, err := blockchainv2.NewBlockchainV2(&blockchainv2.BlockchainV2Options{
+			URL:           options.URL,
+			Authenticator: options.Authenticator,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return NewV2Client(service), nil
+	case APIVersion3:
+		service, err := blockchainv3.NewBlockchainV3(&blockchainv3.BlockchainV3Options{
+			URL:           options.URL,
+			Authenticator: options.Authenticator,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return NewV3Client(service), nil
+	}
+	return nil, fmt.Errorf("unknown API version %d", version)
+}
+
+// DetectAPIVersion : Find the newest API version a console serves
+// The console's settings are requested with API version 3 and, if the console does not know that route, with API
+// version 2.
+func DetectAPIVersion(ctx context.Context, url string, authenticator core.Authenticator) (int, error) {
+	v3, err := blockchainv3.NewBlockchainV3(&blockchainv3.BlockchainV3Options{URL: url, Authenticator: authenticator})
+	if err != nil {
+		return 0, err
+	}
+	_, _, err = v3.GetSettingsWithContext(ctx, v3.NewGetSettingsOptions())
+	var notFound *blockchainv3.NotFoundError
+	if err == nil {
+		return APIVersion3, nil
+	} else if !errors.As(err, &notFound) {
+		return 0, fmt.Errorf("probe API version 3: %w", err)
+	}
+
+	v2, err := blockchainv2.NewBlockchainV2(&blockchainv2.BlockchainV2Options{URL: url, Authenticator: authenticator})
+	if err != nil {
+		return 0, err
+	}
+	_, response, err := v2.GetSettingsWithContext(ctx, v2.NewGetSettingsOptions())
+	if err != nil {
+		return 0, fmt.Errorf("the console serves neither API version 3 nor 2: %w", blockchainv3.NewServiceError("GetSettings", response, err))
+	}
+	return APIVersion2, nil
+}
+
+// UnsupportedError : The console's API version has no equivalent of an operation or of one of its option fields.
+type UnsupportedError struct {
+	Operation string
+
+	// The JSON path of the option field, e.g. "crypto.msp.component.client_auth". Empty if the whole operation is
+	// unsupported.
+	Field string
+
+	APIVersion int
+}
+
+// Error returns a description of what is not supported.
+func (e *UnsupportedError) Error() string {
+	if e.Field == "" {
+		return fmt.Sprintf("%s is not supported on API version %d", e.Operation, e.APIVersion)
+	}
+	return fmt.Sprintf("%s: field %s is not supported on API version %d", e.Operation, e.Field, e.APIVersion)
+}
@@ -0,0 +1,98 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package console_test
+
+import (
+	"context"
+	"errors"
+	"github.com/IBM-Blockchain/ibp-go-sdk/blockchainv3"
+	"github.com/IBM-Blockchain/ibp-go-sdk/blockchainv3fake"
+	"github.com/IBM-Blockchain/ibp-go-sdk/console"
+	"github.com/IBM-Blockchain/ibp-go-sdk/internal/testutil"
+	"github.com/IBM/go-sdk-core/v4/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// newClient returns a client of the given API version for the mock console.
+func newClient(mock *testutil.MockConsole, version int) console.Client {
+	client, err := console.New(context.Background(), &console.Options{
+		URL:           mock.URL(),
+		Authenticator: &core.NoAuthAuthenticator{},
+		APIVersion:    version,
+	})
+	Expect(err).To(BeNil())
+	return client
+}
+
+var _ = Describe(`New`, func() {
+	var mock *testutil.MockConsole
+
+	BeforeEach(func() {
+		mock = testutil.NewMockConsole()
+	})
+	AfterEach(func() {
+		mock.Close()
+	})
+
+	It(`Detects a console serving API version 3`, func() {
+		mock.Responses["GET /ak/api/v3/settings"] = `{"PORT": 3000}`
+		mock.Responses["GET /ak/api/v2/settings"] = `{"PORT": "3000"}`
+		client := newClient(mock, 0)
+		Expect(client.APIVersion()).To(Equal(console.APIVersion3))
+		Expect(mock.Calls).To(Equal([]string{"GET /ak/api/v3/settings"}))
+	})
+	It(`Falls back to API version 2`, func() {
+		mock.Responses["GET /ak/api/v2/settings"] = `{"PORT": "3000"}`
+		client := newClient(mock, 0)
+		Expect(client.APIVersion()).To(Equal(console.APIVersion2))
+		Expect(mock.Calls).To(Equal([]string{"GET /ak/api/v3/settings", "GET /ak/api/v2/settings"}))
+	})
+	It(`Fails when the console serves neither version`, func() {
+		_, err := console.New(context.Background(), &console.Options{
+			URL:           mock.URL(),
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		var notFound *blockchainv3.NotFoundError
+		Expect(errors.As(err, &notFound)).To(BeTrue())
+		Expect(err.Error()).To(HavePrefix("the console serves neither API version 3 nor 2: "))
+	})
+	It(`Does not probe when the version is given`, func() {
+		client := newClient(mock, console.APIVersion2)
+		Expect(client.APIVersion()).To(Equal(console.APIVersion2))
+		Expect(mock.Calls).To(BeEmpty())
+
+		_, err := console.New(context.Background(), &console.Options{URL: mock.URL(), APIVersion: 4})
+		Expect(err).To(MatchError("unknown API version 4"))
+	})
+	It(`Sends API version 3 requests unchanged`, func() {
+		mock.Responses["GET /ak/api/v3/components/peer1"] = `{"id": "peer1", "msp": {"component": {"tls_cert": "dGxz"}}}`
+		client := newClient(mock, console.APIVersion3)
+		service := blockchainv3.BlockchainV3{}
+		component, _, err := client.GetComponent(context.Background(), service.NewGetComponentOptions("peer1"))
+		Expect(err).To(BeNil())
+		Expect(*component.Msp.Component.TlsCert).To(Equal("dGxz"))
+	})
+	It(`Wraps a fake console`, func() {
+		client := console.NewV3Client(blockchainv3fake.NewConsole())
+		Expect(client.APIVersion()).To(Equal(console.APIVersion3))
+		service := blockchainv3.BlockchainV3{}
+		result, _, err := client.ListComponents(context.Background(), service.NewListComponentsOptions())
+		Expect(err).To(BeNil())
+		Expect(result.Components).To(BeEmpty())
+	})
+})
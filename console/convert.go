@@ -0,0 +1,393 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package console
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/IBM-Blockchain/ibp-go-sdk/blockchainv3"
+	"github.com/IBM/go-sdk-core/v4/core"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// The options and results of API versions 2 and 3 are converted through their JSON form. Most fields have the same JSON
+// name in both versions; a rule moves the ones that do not before decoding into the other version's model.
+
+// rule rewrites a decoded JSON object of one API version into the shape of the other.
+type rule func(object map[string]interface{})
+
+// toV2 converts API version 3 options into dst, a pointer to a pointer to the matching API version 2 options. Nil options
+// are left nil so the API version 2 client reports them. A set field that has no API version 2 equivalent is returned
+// as an *UnsupportedError.
+func toV2(operation string, options interface{}, dst interface{}, convert rule) error {
+	if core.IsNil(options) {
+		return nil
+	}
+	object, err := toObject(options)
+	if err != nil {
+		return err
+	}
+	if convert != nil {
+		convert(object)
+	}
+	data, err := json.Marshal(object)
+	if err != nil {
+		return err
+	}
+	if err = json.Unmarshal(data, dst); err != nil {
+		var typeErr *json.UnmarshalTypeError
+		if errors.As(err, &typeErr) {
+			return &UnsupportedError{Operation: operation, Field: typeErr.Field, APIVersion: APIVersion2}
+		}
+		return err
+	}
+	converted, err := toObject(dst)
+	if err != nil {
+		return err
+	}
+	if field := missingField(object, converted, ""); field != "" {
+		return &UnsupportedError{Operation: operation, Field: field, APIVersion: APIVersion2}
+	}
+	return nil
+}
+
+// fromV2 converts the result of an API version 2 operation into result, a pointer to a pointer to the API version 3
+// model decoded by unmarshal, and points response.Result at it. A failed request's error is converted into the
+// blockchainv3 error types.
+func fromV2(operation string, v2Result interface{}, result interface{}, unmarshal core.ModelUnmarshaller, response *core.DetailedResponse, err error, convert rule) error {
+	if err != nil {
+		return blockchainv3.NewServiceError(operation, response, err)
+	}
+	if core.IsNil(v2Result) {
+		return nil
+	}
+	object, err := toObject(v2Result)
+	if err != nil {
+		return err
+	}
+	if convert != nil {
+		convert(object)
+	}
+	data, err := json.Marshal(object)
+	if err != nil {
+		return err
+	}
+	var raw map[string]json.RawMessage
+	if err = json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	if err = core.UnmarshalModel(raw, "", result, unmarshal); err != nil {
+		return fmt.Errorf("%s: convert the API version 2 response: %w", operation, err)
+	}
+	if response != nil {
+		response.Result = reflect.ValueOf(result).Elem().Interface()
+	}
+	return nil
+}
+
+func toObject(value interface{}) (map[string]interface{}, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	object := map[string]interface{}{}
+	err = json.Unmarshal(data, &object)
+	return object, err
+}
+
+// missingField returns the path of the first set field of src that dst does not have. A nil dst has none of them.
+func missingField(src, dst interface{}, path string) string {
+	switch src := src.(type) {
+	case map[string]interface{}:
+		dst, _ := dst.(map[string]interface{})
+		keys := make([]string, 0, len(src))
+		for key := range src {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			if isEmpty(src[key]) {
+				continue
+			}
+			field := key
+			if path != "" {
+				field = path + "." + key
+			}
+			if _, ok := src[key].(map[string]interface{}); ok && isEmpty(dst[key]) {
+				return missingField(src[key], nil, field)
+			} else if isEmpty(dst[key]) {
+				return field
+			}
+			if missing := missingField(src[key], dst[key], field); missing != "" {
+				return missing
+			}
+		}
+	case []interface{}:
+		dst, _ := dst.([]interface{})
+		for i := range src {
+			field := fmt.Sprintf("%s[%d]", path, i)
+			if i >= len(dst) {
+				return field
+			}
+			if missing := missingField(src[i], dst[i], field); missing != "" {
+				return missing
+			}
+		}
+	}
+	return ""
+}
+
+func isEmpty(value interface{}) bool {
+	switch value := value.(type) {
+	case nil:
+		return true
+	case map[string]interface{}:
+		return len(value) == 0
+	case []interface{}:
+		return len(value) == 0
+	}
+	return false
+}
+
+// lookup returns the value at a dot separated path.
+func lookup(object map[string]interface{}, path string) (interface{}, bool) {
+	keys := strings.Split(path, ".")
+	for _, key := range keys[:len(keys)-1] {
+		child, ok := object[key].(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		object = child
+	}
+	value, ok := object[keys[len(keys)-1]]
+	return value, ok && value != nil
+}
+
+// store sets the value at a dot separated path, creating the objects on the way.
+func store(object map[string]interface{}, path string, value interface{}) {
+	keys := strings.Split(path, ".")
+	for _, key := range keys[:len(keys)-1] {
+		child, ok := object[key].(map[string]interface{})
+		if !ok {
+			child = map[string]interface{}{}
+			object[key] = child
+		}
+		object = child
+	}
+	object[keys[len(keys)-1]] = value
+}
+
+// discard deletes the value at a dot separated path and the objects it leaves empty.
+func discard(object map[string]interface{}, path string) {
+	keys := strings.SplitN(path, ".", 2)
+	if len(keys) == 1 {
+		delete(object, path)
+		return
+	}
+	if child, ok := object[keys[0]].(map[string]interface{}); ok {
+		discard(child, keys[1])
+		if len(child) == 0 {
+			delete(object, keys[0])
+		}
+	}
+}
+
+// rules applies rules in order.
+func rules(rules ...rule) rule {
+	return func(object map[string]interface{}) {
+		for _, r := range rules {
+			r(object)
+		}
+	}
+}
+
+// rename moves a value, unless the destination is already set.
+func rename(from, to string) rule {
+	return func(object map[string]interface{}) {
+		value, ok := lookup(object, from)
+		if _, taken := lookup(object, to); !ok || taken {
+			return
+		}
+		discard(object, from)
+		store(object, to, value)
+	}
+}
+
+// firstOf moves the only element of a list into a single value field.
+func firstOf(from, to string) rule {
+	return func(object map[string]interface{}) {
+		value, _ := lookup(object, from)
+		if list, ok := value.([]interface{}); ok && len(list) == 1 {
+			discard(object, from)
+			store(object, to, list[0])
+		}
+	}
+}
+
+// listOf moves a single value into a list field.
+func listOf(from, to string) rule {
+	return func(object map[string]interface{}) {
+		if value, ok := lookup(object, from); ok {
+			discard(object, from)
+			store(object, to, []interface{}{value})
+		}
+	}
+}
+
+// each applies a rule to the object at path, or to every object of the list at path.
+func each(path string, convert rule) rule {
+	return func(object map[string]interface{}) {
+		value, _ := lookup(object, path)
+		switch value := value.(type) {
+		case map[string]interface{}:
+			convert(value)
+		case []interface{}:
+			for _, element := range value {
+				if element, ok := element.(map[string]interface{}); ok {
+					convert(element)
+				}
+			}
+		}
+	}
+}
+
+// toNumber parses a string into a number, dropping it if it is not one.
+func toNumber(path string) rule {
+	return func(object map[string]interface{}) {
+		value, _ := lookup(object, path)
+		if s, ok := value.(string); ok {
+			if number, err := strconv.ParseFloat(strings.TrimSpace(s), 64); err == nil {
+				store(object, path, number)
+			} else {
+				discard(object, path)
+			}
+		}
+	}
+}
+
+// toNumbers parses a string of comma or space separated numbers into a list, dropping it if it is not one.
+func toNumbers(path string) rule {
+	return func(object map[string]interface{}) {
+		value, _ := lookup(object, path)
+		s, ok := value.(string)
+		if !ok {
+			return
+		}
+		numbers := []interface{}{}
+		for _, field := range strings.FieldsFunc(s, func(r rune) bool { return r == ',' || r == ' ' }) {
+			number, err := strconv.ParseFloat(field, 64)
+			if err != nil {
+				discard(object, path)
+				return
+			}
+			numbers = append(numbers, number)
+		}
+		store(object, path, numbers)
+	}
+}
+
+// toString formats a value as a string.
+func toString(path string) rule {
+	return func(object map[string]interface{}) {
+		if value, ok := lookup(object, path); ok {
+			store(object, path, fmt.Sprint(value))
+		}
+	}
+}
+
+// stringOnly drops a value that is not a string.
+func stringOnly(path string) rule {
+	return func(object map[string]interface{}) {
+		if value, ok := lookup(object, path); ok {
+			if _, ok := value.(string); !ok {
+				discard(object, path)
+			}
+		}
+	}
+}
+
+// componentFromV2 moves the certificates API version 2 returns at the top of a component into its "msp" field.
+var componentFromV2 = rules(
+	rename("ecert.cert", "msp.component.ecert"),
+	listOf("ecert.cacert", "msp.ca.root_certs"),
+	rename("tls_cert", "msp.component.tls_cert"),
+	rename("admin_certs", "msp.component.admin_certs"),
+	rename("ca_name", "msp.ca.name"),
+)
+
+var componentsFromV2 = each("components", componentFromV2)
+
+// createdOrdererFromV2 converts the node API version 2 returns when creating an orderer into the "created" list of
+// API version 3.
+func createdOrdererFromV2(object map[string]interface{}) {
+	componentFromV2(object)
+	node := map[string]interface{}{}
+	for key, value := range object {
+		node[key] = value
+		delete(object, key)
+	}
+	object["created"] = []interface{}{node}
+}
+
+var settingsFromV2 = rules(toNumber("PORT"), stringOnly("PROXY_TLS_WS_URL"))
+
+var healthFromV2 = rules(each("OS.cpus", toNumber("speed")), toNumbers("OS.loadavg"))
+
+// cryptoObjectToV2 converts an API version 3 crypto object into an API version 2 config object.
+var cryptoObjectToV2 = rules(
+	rename("enrollment.ca.host", "enrollment.component.cahost"),
+	rename("enrollment.ca.port", "enrollment.component.caport"),
+	rename("enrollment.ca.name", "enrollment.component.caname"),
+	rename("enrollment.ca.tls_cert", "enrollment.component.catls.cacert"),
+	rename("enrollment.ca.enroll_id", "enrollment.component.enrollid"),
+	rename("enrollment.ca.enroll_secret", "enrollment.component.enrollsecret"),
+	rename("enrollment.tlsca.host", "enrollment.tls.cahost"),
+	rename("enrollment.tlsca.port", "enrollment.tls.caport"),
+	rename("enrollment.tlsca.name", "enrollment.tls.caname"),
+	rename("enrollment.tlsca.tls_cert", "enrollment.tls.catls.cacert"),
+	rename("enrollment.tlsca.enroll_id", "enrollment.tls.enrollid"),
+	rename("enrollment.tlsca.enroll_secret", "enrollment.tls.enrollsecret"),
+	rename("enrollment.tlsca.csr_hosts", "enrollment.tls.csr.hosts"),
+	rename("msp.component.ekey", "msp.component.keystore"),
+	rename("msp.component.ecert", "msp.component.signcerts"),
+	rename("msp.component.admin_certs", "msp.component.admincerts"),
+	rename("msp.component.tls_key", "msp.tls.keystore"),
+	rename("msp.component.tls_cert", "msp.tls.signcerts"),
+	rename("msp.ca.root_certs", "msp.component.cacerts"),
+	rename("msp.ca.ca_intermediate_certs", "msp.component.intermediatecerts"),
+	rename("msp.tlsca.root_certs", "msp.tls.cacerts"),
+	rename("msp.tlsca.ca_intermediate_certs", "msp.tls.intermediatecerts"),
+)
+
+var cryptoToV2 = rules(rename("crypto", "config"), each("config", cryptoObjectToV2))
+
+var createOrdererToV2 = rules(cryptoToV2, toString("external_append"))
+
+var importCaToV2 = rules(
+	rename("msp.ca.name", "ca_name"),
+	rename("msp.tlsca.name", "tlsca_name"),
+	rename("msp.component.tls_cert", "tls_cert"),
+)
+
+var importNodeToV2 = rules(
+	rename("msp.component.tls_cert", "tls_cert"),
+	firstOf("msp.tlsca.root_certs", "tls_ca_root_cert"),
+)
@@ -0,0 +1,413 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package console
+
+import (
+	"context"
+	"github.com/IBM-Blockchain/ibp-go-sdk/blockchainv2"
+	"github.com/IBM-Blockchain/ibp-go-sdk/blockchainv3"
+	"github.com/IBM/go-sdk-core/v4/core"
+)
+
+// v2Client : A Client backed by the API version 2 client.
+// Options and results are translated between the two versions' models, see convert.go.
+type v2Client struct {
+	service *blockchainv2.BlockchainV2
+}
+
+// NewV2Client : Wrap an API version 2 client in a Client.
+func NewV2Client(service *blockchainv2.BlockchainV2) Client {
+	return &v2Client{service: service}
+}
+
+// APIVersion returns APIVersion2.
+func (client *v2Client) APIVersion() int {
+	return APIVersion2
+}
+
+// GetComponent : Get component data
+func (client *v2Client) GetComponent(ctx context.Context, getComponentOptions *blockchainv3.GetComponentOptions) (result *blockchainv3.GenericComponentResponse, response *core.DetailedResponse, err error) {
+	var v2Options *blockchainv2.GetComponentOptions
+	if err = toV2("GetComponent", getComponentOptions, &v2Options, nil); err != nil {
+		return
+	}
+	v2Result, response, err := client.service.GetComponentWithContext(ctx, v2Options)
+	err = fromV2("GetComponent", v2Result, &result, blockchainv3.UnmarshalGenericComponentResponse, response, err, componentFromV2)
+	return
+}
+
+// ListComponents : Get all components
+func (client *v2Client) ListComponents(ctx context.Context, listComponentsOptions *blockchainv3.ListComponentsOptions) (result *blockchainv3.GetMultiComponentsResponse, response *core.DetailedResponse, err error) {
+	var v2Options *blockchainv2.ListComponentsOptions
+	if err = toV2("ListComponents", listComponentsOptions, &v2Options, nil); err != nil {
+		return
+	}
+	v2Result, response, err := client.service.ListComponentsWithContext(ctx, v2Options)
+	err = fromV2("ListComponents", v2Result, &result, blockchainv3.UnmarshalGetMultiComponentsResponse, response, err, componentsFromV2)
+	return
+}
+
+// GetComponentsByType : Get components of a type
+func (client *v2Client) GetComponentsByType(ctx context.Context, getComponentsByTypeOptions *blockchainv3.GetComponentsByTypeOptions) (result *blockchainv3.GetMultiComponentsResponse, response *core.DetailedResponse, err error) {
+	var v2Options *blockchainv2.GetComponentsByTypeOptions
+	if err = toV2("GetComponentsByType", getComponentsByTypeOptions, &v2Options, rename("type", "component-type")); err != nil {
+		return
+	}
+	v2Result, response, err := client.service.GetComponentsByTypeWithContext(ctx, v2Options)
+	err = fromV2("GetComponentsByType", v2Result, &result, blockchainv3.UnmarshalGetMultiComponentsResponse, response, err, componentsFromV2)
+	return
+}
+
+// GetComponentsByTag : Get components with tag
+func (client *v2Client) GetComponentsByTag(ctx context.Context, getComponentsByTagOptions *blockchainv3.GetComponentsByTagOptions) (result *blockchainv3.GetMultiComponentsResponse, response *core.DetailedResponse, err error) {
+	var v2Options *blockchainv2.GetComponentByTagOptions
+	if err = toV2("GetComponentsByTag", getComponentsByTagOptions, &v2Options, nil); err != nil {
+		return
+	}
+	v2Result, response, err := client.service.GetComponentByTagWithContext(ctx, v2Options)
+	err = fromV2("GetComponentsByTag", v2Result, &result, blockchainv3.UnmarshalGetMultiComponentsResponse, response, err, componentsFromV2)
+	return
+}
+
+// RemoveComponent : Remove imported component
+func (client *v2Client) RemoveComponent(ctx context.Context, removeComponentOptions *blockchainv3.RemoveComponentOptions) (result *blockchainv3.DeleteComponentResponse, response *core.DetailedResponse, err error) {
+	var v2Options *blockchainv2.RemoveComponentOptions
+	if err = toV2("RemoveComponent", removeComponentOptions, &v2Options, nil); err != nil {
+		return
+	}
+	v2Result, response, err := client.service.RemoveComponentWithContext(ctx, v2Options)
+	err = fromV2("RemoveComponent", v2Result, &result, blockchainv3.UnmarshalDeleteComponentResponse, response, err, nil)
+	return
+}
+
+// DeleteComponent : Delete component
+func (client *v2Client) DeleteComponent(ctx context.Context, deleteComponentOptions *blockchainv3.DeleteComponentOptions) (result *blockchainv3.DeleteComponentResponse, response *core.DetailedResponse, err error) {
+	var v2Options *blockchainv2.DeleteComponentOptions
+	if err = toV2("DeleteComponent", deleteComponentOptions, &v2Options, nil); err != nil {
+		return
+	}
+	v2Result, response, err := client.service.DeleteComponentWithContext(ctx, v2Options)
+	err = fromV2("DeleteComponent", v2Result, &result, blockchainv3.UnmarshalDeleteComponentResponse, response, err, nil)
+	return
+}
+
+// RemoveComponentsByTag : Remove components with tag
+func (client *v2Client) RemoveComponentsByTag(ctx context.Context, removeComponentsByTagOptions *blockchainv3.RemoveComponentsByTagOptions) (result *blockchainv3.RemoveMultiComponentsResponse, response *core.DetailedResponse, err error) {
+	var v2Options *blockchainv2.RemoveComponentsByTagOptions
+	if err = toV2("RemoveComponentsByTag", removeComponentsByTagOptions, &v2Options, nil); err != nil {
+		return
+	}
+	v2Result, response, err := client.service.RemoveComponentsByTagWithContext(ctx, v2Options)
+	err = fromV2("RemoveComponentsByTag", v2Result, &result, blockchainv3.UnmarshalRemoveMultiComponentsResponse, response, err, nil)
+	return
+}
+
+// DeleteComponentsByTag : Delete components with tag
+func (client *v2Client) DeleteComponentsByTag(ctx context.Context, deleteComponentsByTagOptions *blockchainv3.DeleteComponentsByTagOptions) (result *blockchainv3.DeleteMultiComponentsResponse, response *core.DetailedResponse, err error) {
+	var v2Options *blockchainv2.DeleteComponentsByTagOptions
+	if err = toV2("DeleteComponentsByTag", deleteComponentsByTagOptions, &v2Options, nil); err != nil {
+		return
+	}
+	v2Result, response, err := client.service.DeleteComponentsByTagWithContext(ctx, v2Options)
+	err = fromV2("DeleteComponentsByTag", v2Result, &result, blockchainv3.UnmarshalDeleteMultiComponentsResponse, response, err, nil)
+	return
+}
+
+// CreateCa : Create a CA
+func (client *v2Client) CreateCa(ctx context.Context, createCaOptions *blockchainv3.CreateCaOptions) (result *blockchainv3.CaResponse, response *core.DetailedResponse, err error) {
+	var v2Options *blockchainv2.CreateCaOptions
+	if err = toV2("CreateCa", createCaOptions, &v2Options, nil); err != nil {
+		return
+	}
+	v2Result, response, err := client.service.CreateCaWithContext(ctx, v2Options)
+	err = fromV2("CreateCa", v2Result, &result, blockchainv3.UnmarshalCaResponse, response, err, componentFromV2)
+	return
+}
+
+// ImportCa : Import a CA
+func (client *v2Client) ImportCa(ctx context.Context, importCaOptions *blockchainv3.ImportCaOptions) (result *blockchainv3.CaResponse, response *core.DetailedResponse, err error) {
+	var v2Options *blockchainv2.ImportCaOptions
+	if err = toV2("ImportCa", importCaOptions, &v2Options, importCaToV2); err != nil {
+		return
+	}
+	v2Result, response, err := client.service.ImportCaWithContext(ctx, v2Options)
+	err = fromV2("ImportCa", v2Result, &result, blockchainv3.UnmarshalCaResponse, response, err, componentFromV2)
+	return
+}
+
+// UpdateCa : Update a CA
+func (client *v2Client) UpdateCa(ctx context.Context, updateCaOptions *blockchainv3.UpdateCaOptions) (result *blockchainv3.CaResponse, response *core.DetailedResponse, err error) {
+	var v2Options *blockchainv2.UpdateCaOptions
+	if err = toV2("UpdateCa", updateCaOptions, &v2Options, nil); err != nil {
+		return
+	}
+	v2Result, response, err := client.service.UpdateCaWithContext(ctx, v2Options)
+	err = fromV2("UpdateCa", v2Result, &result, blockchainv3.UnmarshalCaResponse, response, err, componentFromV2)
+	return
+}
+
+// EditCa : Edit data about a CA
+func (client *v2Client) EditCa(ctx context.Context, editCaOptions *blockchainv3.EditCaOptions) (result *blockchainv3.CaResponse, response *core.DetailedResponse, err error) {
+	var v2Options *blockchainv2.EditCaOptions
+	if err = toV2("EditCa", editCaOptions, &v2Options, nil); err != nil {
+		return
+	}
+	v2Result, response, err := client.service.EditCaWithContext(ctx, v2Options)
+	err = fromV2("EditCa", v2Result, &result, blockchainv3.UnmarshalCaResponse, response, err, componentFromV2)
+	return
+}
+
+// CaAction : Submit action to a CA
+// Not supported by API version 2.
+func (client *v2Client) CaAction(ctx context.Context, caActionOptions *blockchainv3.CaActionOptions) (result *blockchainv3.ActionsResponse, response *core.DetailedResponse, err error) {
+	err = &UnsupportedError{Operation: "CaAction", APIVersion: APIVersion2}
+	return
+}
+
+// CreatePeer : Create a peer
+func (client *v2Client) CreatePeer(ctx context.Context, createPeerOptions *blockchainv3.CreatePeerOptions) (result *blockchainv3.PeerResponse, response *core.DetailedResponse, err error) {
+	var v2Options *blockchainv2.CreatePeerOptions
+	if err = toV2("CreatePeer", createPeerOptions, &v2Options, cryptoToV2); err != nil {
+		return
+	}
+	v2Result, response, err := client.service.CreatePeerWithContext(ctx, v2Options)
+	err = fromV2("CreatePeer", v2Result, &result, blockchainv3.UnmarshalPeerResponse, response, err, componentFromV2)
+	return
+}
+
+// ImportPeer : Import a peer
+func (client *v2Client) ImportPeer(ctx context.Context, importPeerOptions *blockchainv3.ImportPeerOptions) (result *blockchainv3.PeerResponse, response *core.DetailedResponse, err error) {
+	var v2Options *blockchainv2.ImportPeerOptions
+	if err = toV2("ImportPeer", importPeerOptions, &v2Options, importNodeToV2); err != nil {
+		return
+	}
+	v2Result, response, err := client.service.ImportPeerWithContext(ctx, v2Options)
+	err = fromV2("ImportPeer", v2Result, &result, blockchainv3.UnmarshalPeerResponse, response, err, componentFromV2)
+	return
+}
+
+// UpdatePeer : Update a peer
+func (client *v2Client) UpdatePeer(ctx context.Context, updatePeerOptions *blockchainv3.UpdatePeerOptions) (result *blockchainv3.PeerResponse, response *core.DetailedResponse, err error) {
+	var v2Options *blockchainv2.UpdatePeerOptions
+	if err = toV2("UpdatePeer", updatePeerOptions, &v2Options, nil); err != nil {
+		return
+	}
+	v2Result, response, err := client.service.UpdatePeerWithContext(ctx, v2Options)
+	err = fromV2("UpdatePeer", v2Result, &result, blockchainv3.UnmarshalPeerResponse, response, err, componentFromV2)
+	return
+}
+
+// EditPeer : Edit data about a peer
+func (client *v2Client) EditPeer(ctx context.Context, editPeerOptions *blockchainv3.EditPeerOptions) (result *blockchainv3.PeerResponse, response *core.DetailedResponse, err error) {
+	var v2Options *blockchainv2.EditPeerOptions
+	if err = toV2("EditPeer", editPeerOptions, &v2Options, nil); err != nil {
+		return
+	}
+	v2Result, response, err := client.service.EditPeerWithContext(ctx, v2Options)
+	err = fromV2("EditPeer", v2Result, &result, blockchainv3.UnmarshalPeerResponse, response, err, componentFromV2)
+	return
+}
+
+// PeerAction : Submit action to a peer
+// Not supported by API version 2.
+func (client *v2Client) PeerAction(ctx context.Context, peerActionOptions *blockchainv3.PeerActionOptions) (result *blockchainv3.ActionsResponse, response *core.DetailedResponse, err error) {
+	err = &UnsupportedError{Operation: "PeerAction", APIVersion: APIVersion2}
+	return
+}
+
+// CreateOrderer : Create an ordering service
+func (client *v2Client) CreateOrderer(ctx context.Context, createOrdererOptions *blockchainv3.CreateOrdererOptions) (result *blockchainv3.CreateOrdererResponse, response *core.DetailedResponse, err error) {
+	var v2Options *blockchainv2.CreateOrdererOptions
+	if err = toV2("CreateOrderer", createOrdererOptions, &v2Options, createOrdererToV2); err != nil {
+		return
+	}
+	v2Result, response, err := client.service.CreateOrdererWithContext(ctx, v2Options)
+	err = fromV2("CreateOrderer", v2Result, &result, blockchainv3.UnmarshalCreateOrdererResponse, response, err, createdOrdererFromV2)
+	return
+}
+
+// ImportOrderer : Import an ordering service
+func (client *v2Client) ImportOrderer(ctx context.Context, importOrdererOptions *blockchainv3.ImportOrdererOptions) (result *blockchainv3.OrdererResponse, response *core.DetailedResponse, err error) {
+	var v2Options *blockchainv2.ImportOrdererOptions
+	if err = toV2("ImportOrderer", importOrdererOptions, &v2Options, importNodeToV2); err != nil {
+		return
+	}
+	v2Result, response, err := client.service.ImportOrdererWithContext(ctx, v2Options)
+	err = fromV2("ImportOrderer", v2Result, &result, blockchainv3.UnmarshalOrdererResponse, response, err, componentFromV2)
+	return
+}
+
+// UpdateOrderer : Update an orderer node
+func (client *v2Client) UpdateOrderer(ctx context.Context, updateOrdererOptions *blockchainv3.UpdateOrdererOptions) (result *blockchainv3.OrdererResponse, response *core.DetailedResponse, err error) {
+	var v2Options *blockchainv2.UpdateOrdererOptions
+	if err = toV2("UpdateOrderer", updateOrdererOptions, &v2Options, nil); err != nil {
+		return
+	}
+	v2Result, response, err := client.service.UpdateOrdererWithContext(ctx, v2Options)
+	err = fromV2("UpdateOrderer", v2Result, &result, blockchainv3.UnmarshalOrdererResponse, response, err, componentFromV2)
+	return
+}
+
+// EditOrderer : Edit data about an orderer
+func (client *v2Client) EditOrderer(ctx context.Context, editOrdererOptions *blockchainv3.EditOrdererOptions) (result *blockchainv3.OrdererResponse, response *core.DetailedResponse, err error) {
+	var v2Options *blockchainv2.EditOrdererOptions
+	if err = toV2("EditOrderer", editOrdererOptions, &v2Options, nil); err != nil {
+		return
+	}
+	v2Result, response, err := client.service.EditOrdererWithContext(ctx, v2Options)
+	err = fromV2("EditOrderer", v2Result, &result, blockchainv3.UnmarshalOrdererResponse, response, err, componentFromV2)
+	return
+}
+
+// OrdererAction : Submit action to an orderer
+// Not supported by API version 2.
+func (client *v2Client) OrdererAction(ctx context.Context, ordererActionOptions *blockchainv3.OrdererActionOptions) (result *blockchainv3.ActionsResponse, response *core.DetailedResponse, err error) {
+	err = &UnsupportedError{Operation: "OrdererAction", APIVersion: APIVersion2}
+	return
+}
+
+// SubmitBlock : Submit config block to orderer
+func (client *v2Client) SubmitBlock(ctx context.Context, submitBlockOptions *blockchainv3.SubmitBlockOptions) (result *blockchainv3.GenericComponentResponse, response *core.DetailedResponse, err error) {
+	var v2Options *blockchainv2.SubmitBlockOptions
+	if err = toV2("SubmitBlock", submitBlockOptions, &v2Options, nil); err != nil {
+		return
+	}
+	v2Result, response, err := client.service.SubmitBlockWithContext(ctx, v2Options)
+	err = fromV2("SubmitBlock", v2Result, &result, blockchainv3.UnmarshalGenericComponentResponse, response, err, componentFromV2)
+	return
+}
+
+// ImportMsp : Import an MSP
+func (client *v2Client) ImportMsp(ctx context.Context, importMspOptions *blockchainv3.ImportMspOptions) (result *blockchainv3.MspResponse, response *core.DetailedResponse, err error) {
+	var v2Options *blockchainv2.ImportMspOptions
+	if err = toV2("ImportMsp", importMspOptions, &v2Options, nil); err != nil {
+		return
+	}
+	v2Result, response, err := client.service.ImportMspWithContext(ctx, v2Options)
+	err = fromV2("ImportMsp", v2Result, &result, blockchainv3.UnmarshalMspResponse, response, err, nil)
+	return
+}
+
+// EditMsp : Edit an MSP
+func (client *v2Client) EditMsp(ctx context.Context, editMspOptions *blockchainv3.EditMspOptions) (result *blockchainv3.MspResponse, response *core.DetailedResponse, err error) {
+	var v2Options *blockchainv2.EditMspOptions
+	if err = toV2("EditMsp", editMspOptions, &v2Options, nil); err != nil {
+		return
+	}
+	v2Result, response, err := client.service.EditMspWithContext(ctx, v2Options)
+	err = fromV2("EditMsp", v2Result, &result, blockchainv3.UnmarshalMspResponse, response, err, nil)
+	return
+}
+
+// GetMspCertificate : Get MSP's public certificates
+func (client *v2Client) GetMspCertificate(ctx context.Context, getMspCertificateOptions *blockchainv3.GetMspCertificateOptions) (result *blockchainv3.GetMSPCertificateResponse, response *core.DetailedResponse, err error) {
+	var v2Options *blockchainv2.GetMspCertificateOptions
+	if err = toV2("GetMspCertificate", getMspCertificateOptions, &v2Options, nil); err != nil {
+		return
+	}
+	v2Result, response, err := client.service.GetMspCertificateWithContext(ctx, v2Options)
+	err = fromV2("GetMspCertificate", v2Result, &result, blockchainv3.UnmarshalGetMSPCertificateResponse, response, err, nil)
+	return
+}
+
+// EditAdminCerts : Edit admin certs on a component
+func (client *v2Client) EditAdminCerts(ctx context.Context, editAdminCertsOptions *blockchainv3.EditAdminCertsOptions) (result *blockchainv3.EditAdminCertsResponse, response *core.DetailedResponse, err error) {
+	var v2Options *blockchainv2.EditAdminCertsOptions
+	if err = toV2("EditAdminCerts", editAdminCertsOptions, &v2Options, nil); err != nil {
+		return
+	}
+	v2Result, response, err := client.service.EditAdminCertsWithContext(ctx, v2Options)
+	err = fromV2("EditAdminCerts", v2Result, &result, blockchainv3.UnmarshalEditAdminCertsResponse, response, err, nil)
+	return
+}
+
+// GetSettings : Get public IBP console settings
+func (client *v2Client) GetSettings(ctx context.Context, getSettingsOptions *blockchainv3.GetSettingsOptions) (result *blockchainv3.GetPublicSettingsResponse, response *core.DetailedResponse, err error) {
+	var v2Options *blockchainv2.GetSettingsOptions
+	if err = toV2("GetSettings", getSettingsOptions, &v2Options, nil); err != nil {
+		return
+	}
+	v2Result, response, err := client.service.GetSettingsWithContext(ctx, v2Options)
+	err = fromV2("GetSettings", v2Result, &result, blockchainv3.UnmarshalGetPublicSettingsResponse, response, err, settingsFromV2)
+	return
+}
+
+// EditSettings : Change IBP console settings
+func (client *v2Client) EditSettings(ctx context.Context, editSettingsOptions *blockchainv3.EditSettingsOptions) (result *blockchainv3.GetPublicSettingsResponse, response *core.DetailedResponse, err error) {
+	var v2Options *blockchainv2.EditSettingsOptions
+	if err = toV2("EditSettings", editSettingsOptions, &v2Options, nil); err != nil {
+		return
+	}
+	v2Result, response, err := client.service.EditSettingsWithContext(ctx, v2Options)
+	err = fromV2("EditSettings", v2Result, &result, blockchainv3.UnmarshalGetPublicSettingsResponse, response, err, settingsFromV2)
+	return
+}
+
+// GetFabVersions : Get supported Fabric versions
+func (client *v2Client) GetFabVersions(ctx context.Context, getFabVersionsOptions *blockchainv3.GetFabVersionsOptions) (result *blockchainv3.GetFabricVersionsResponse, response *core.DetailedResponse, err error) {
+	var v2Options *blockchainv2.GetFabVersionsOptions
+	if err = toV2("GetFabVersions", getFabVersionsOptions, &v2Options, nil); err != nil {
+		return
+	}
+	v2Result, response, err := client.service.GetFabVersionsWithContext(ctx, v2Options)
+	err = fromV2("GetFabVersions", v2Result, &result, blockchainv3.UnmarshalGetFabricVersionsResponse, response, err, nil)
+	return
+}
+
+// GetHealth : Get IBP console health stats
+func (client *v2Client) GetHealth(ctx context.Context, getHealthOptions *blockchainv3.GetHealthOptions) (result *blockchainv3.GetAthenaHealthStatsResponse, response *core.DetailedResponse, err error) {
+	var v2Options *blockchainv2.GetHealthOptions
+	if err = toV2("GetHealth", getHealthOptions, &v2Options, nil); err != nil {
+		return
+	}
+	v2Result, response, err := client.service.GetHealthWithContext(ctx, v2Options)
+	err = fromV2("GetHealth", v2Result, &result, blockchainv3.UnmarshalGetAthenaHealthStatsResponse, response, err, healthFromV2)
+	return
+}
+
+// ListNotifications : Get all notifications
+func (client *v2Client) ListNotifications(ctx context.Context, listNotificationsOptions *blockchainv3.ListNotificationsOptions) (result *blockchainv3.GetNotificationsResponse, response *core.DetailedResponse, err error) {
+	var v2Options *blockchainv2.ListNotificationsOptions
+	if err = toV2("ListNotifications", listNotificationsOptions, &v2Options, nil); err != nil {
+		return
+	}
+	v2Result, response, err := client.service.ListNotificationsWithContext(ctx, v2Options)
+	err = fromV2("ListNotifications", v2Result, &result, blockchainv3.UnmarshalGetNotificationsResponse, response, err, nil)
+	return
+}
+
+// ArchiveNotifications : Archive notifications
+func (client *v2Client) ArchiveNotifications(ctx context.Context, archiveNotificationsOptions *blockchainv3.ArchiveNotificationsOptions) (result *blockchainv3.ArchiveResponse, response *core.DetailedResponse, err error) {
+	var v2Options *blockchainv2.ArchiveNotificationsOptions
+	if err = toV2("ArchiveNotifications", archiveNotificationsOptions, &v2Options, nil); err != nil {
+		return
+	}
+	v2Result, response, err := client.service.ArchiveNotificationsWithContext(ctx, v2Options)
+	err = fromV2("ArchiveNotifications", v2Result, &result, blockchainv3.UnmarshalArchiveResponse, response, err, nil)
+	return
+}
+
+// DeleteSigTx : Delete a signature collection tx
+func (client *v2Client) DeleteSigTx(ctx context.Context, deleteSigTxOptions *blockchainv3.DeleteSigTxOptions) (result *blockchainv3.DeleteSignatureCollectionResponse, response *core.DetailedResponse, err error) {
+	var v2Options *blockchainv2.DeleteSigTxOptions
+	if err = toV2("DeleteSigTx", deleteSigTxOptions, &v2Options, nil); err != nil {
+		return
+	}
+	v2Result, response, err := client.service.DeleteSigTxWithContext(ctx, v2Options)
+	err = fromV2("DeleteSigTx", v2Result, &result, blockchainv3.UnmarshalDeleteSignatureCollectionResponse, response, err, nil)
+	return
+}
@@ -0,0 +1,181 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package console_test
+
+import (
+	"context"
+	"errors"
+	"github.com/IBM-Blockchain/ibp-go-sdk/blockchainv3"
+	"github.com/IBM-Blockchain/ibp-go-sdk/console"
+	"github.com/IBM-Blockchain/ibp-go-sdk/internal/testutil"
+	"github.com/IBM/go-sdk-core/v4/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`API version 2 client`, func() {
+	var mock *testutil.MockConsole
+	var client console.Client
+	service := &blockchainv3.BlockchainV3{}
+
+	BeforeEach(func() {
+		mock = testutil.NewMockConsole()
+		client = newClient(mock, console.APIVersion2)
+	})
+	AfterEach(func() {
+		mock.Close()
+	})
+
+	It(`Moves the certificates of components into their msp field`, func() {
+		mock.Responses["GET /ak/api/v2/components"] = `{"components": [{"id": "peer1", "type": "fabric-peer", "tls_cert": "dGxz",
+			"admin_certs": ["YWRtaW4="], "ecert": {"cert": "ZWNlcnQ=", "cacert": "Y2E="}}]}`
+		result, response, err := client.ListComponents(context.Background(), service.NewListComponentsOptions())
+		Expect(err).To(BeNil())
+		Expect(response.Result).To(Equal(result))
+		Expect(result.Components).To(HaveLen(1))
+		msp := result.Components[0].Msp
+		Expect(*msp.Component.TlsCert).To(Equal("dGxz"))
+		Expect(*msp.Component.Ecert).To(Equal("ZWNlcnQ="))
+		Expect(msp.Component.AdminCerts).To(Equal([]string{"YWRtaW4="}))
+		Expect(msp.Ca.RootCerts).To(Equal([]string{"Y2E="}))
+	})
+	It(`Renames the path parameters of operations`, func() {
+		mock.Responses["GET /ak/api/v2/components/types/fabric-peer"] = `{"components": [{"id": "peer1"}]}`
+		mock.Responses["GET /ak/api/v2/components/tags/org1"] = `{"components": [{"id": "peer1"}]}`
+		result, _, err := client.GetComponentsByType(context.Background(), service.NewGetComponentsByTypeOptions("fabric-peer"))
+		Expect(err).To(BeNil())
+		Expect(*result.Components[0].ID).To(Equal("peer1"))
+		result, _, err = client.GetComponentsByTag(context.Background(), service.NewGetComponentsByTagOptions("org1"))
+		Expect(err).To(BeNil())
+		Expect(*result.Components[0].ID).To(Equal("peer1"))
+	})
+	It(`Converts crypto objects into config objects`, func() {
+		mock.Responses["POST /ak/api/v2/kubernetes/components/fabric-peer"] = `{"id": "peer1"}`
+		crypto := &blockchainv3.CryptoObject{
+			Enrollment: &blockchainv3.CryptoObjectEnrollment{
+				Component: &blockchainv3.CryptoEnrollmentComponent{Admincerts: []string{"YWRtaW4="}},
+				Ca: &blockchainv3.CryptoObjectEnrollmentCa{
+					Host: core.StringPtr("ca.example.com"), Port: core.Float64Ptr(7054), Name: core.StringPtr("ca"),
+					TlsCert: core.StringPtr("dGxz"), EnrollID: core.StringPtr("peer1"), EnrollSecret: core.StringPtr("peer1pw"),
+				},
+				Tlsca: &blockchainv3.CryptoObjectEnrollmentTlsca{
+					Host: core.StringPtr("ca.example.com"), Port: core.Float64Ptr(7054), Name: core.StringPtr("tlsca"),
+					TlsCert: core.StringPtr("dGxz"), EnrollID: core.StringPtr("peer1"), EnrollSecret: core.StringPtr("peer1pw"),
+					CsrHosts: []string{"peer1.example.com"},
+				},
+			},
+		}
+		peer, _, err := client.CreatePeer(context.Background(), service.NewCreatePeerOptions("org1msp", "Peer1", crypto))
+		Expect(err).To(BeNil())
+		Expect(*peer.ID).To(Equal("peer1"))
+
+		body := mock.Bodies["POST /ak/api/v2/kubernetes/components/fabric-peer"][0]
+		Expect(body).ToNot(HaveKey("crypto"))
+		Expect(body["config"]).To(Equal(map[string]interface{}{
+			"enrollment": map[string]interface{}{
+				"component": map[string]interface{}{
+					"cahost": "ca.example.com", "caport": 7054.0, "caname": "ca", "catls": map[string]interface{}{"cacert": "dGxz"},
+					"enrollid": "peer1", "enrollsecret": "peer1pw", "admincerts": []interface{}{"YWRtaW4="},
+				},
+				"tls": map[string]interface{}{
+					"cahost": "ca.example.com", "caport": 7054.0, "caname": "tlsca", "catls": map[string]interface{}{"cacert": "dGxz"},
+					"enrollid": "peer1", "enrollsecret": "peer1pw", "csr": map[string]interface{}{"hosts": []interface{}{"peer1.example.com"}},
+				},
+			},
+		}))
+	})
+	It(`Wraps the created orderer in a list`, func() {
+		mock.Responses["POST /ak/api/v2/kubernetes/components/fabric-orderer"] = `{"id": "os1", "tls_cert": "dGxz"}`
+		crypto := blockchainv3.CryptoObject{Msp: &blockchainv3.CryptoObjectMsp{
+			Component: &blockchainv3.MspCryptoComp{Ekey: core.StringPtr("a2V5"), Ecert: core.StringPtr("Y2VydA==")},
+		}}
+		options := service.NewCreateOrdererOptions("raft", "org1msp", "Ordering Service", []blockchainv3.CryptoObject{crypto})
+		result, _, err := client.CreateOrderer(context.Background(), options)
+		Expect(err).To(BeNil())
+		Expect(result.Created).To(HaveLen(1))
+		Expect(*result.Created[0].ID).To(Equal("os1"))
+		Expect(*result.Created[0].Msp.Component.TlsCert).To(Equal("dGxz"))
+
+		body := mock.Bodies["POST /ak/api/v2/kubernetes/components/fabric-orderer"][0]
+		Expect(body["config"]).To(HaveLen(1))
+		config := body["config"].([]interface{})[0].(map[string]interface{})
+		component := config["msp"].(map[string]interface{})["component"].(map[string]interface{})
+		Expect(component["keystore"]).To(Equal("a2V5"))
+		Expect(component["signcerts"]).To(Equal("Y2VydA=="))
+	})
+	It(`Returns an *UnsupportedError for option fields API version 2 does not have`, func() {
+		options := service.NewImportCaOptions("CA", "https://ca.example.com:7054", &blockchainv3.ImportCaBodyMsp{
+			Ca:    &blockchainv3.ImportCaBodyMspCa{Name: core.StringPtr("ca"), RootCerts: []string{"cm9vdA=="}},
+			Tlsca: &blockchainv3.ImportCaBodyMspTlsca{Name: core.StringPtr("tlsca")},
+		})
+		_, _, err := client.ImportCa(context.Background(), options)
+		var unsupported *console.UnsupportedError
+		Expect(errors.As(err, &unsupported)).To(BeTrue())
+		Expect(unsupported.Field).To(Equal("msp.ca.root_certs"))
+		Expect(err.Error()).To(Equal("ImportCa: field msp.ca.root_certs is not supported on API version 2"))
+		Expect(mock.Calls).To(BeEmpty())
+
+		mock.Responses["POST /ak/api/v2/components/fabric-ca"] = `{"id": "ca1", "ca_name": "ca"}`
+		options.Msp.Ca.RootCerts = nil
+		options.Msp.Component = &blockchainv3.ImportCaBodyMspComponent{TlsCert: core.StringPtr("dGxz")}
+		ca, _, err := client.ImportCa(context.Background(), options)
+		Expect(err).To(BeNil())
+		Expect(*ca.Msp.Ca.Name).To(Equal("ca"))
+		body := mock.Bodies["POST /ak/api/v2/components/fabric-ca"][0]
+		Expect(body["ca_name"]).To(Equal("ca"))
+		Expect(body["tlsca_name"]).To(Equal("tlsca"))
+		Expect(body["tls_cert"]).To(Equal("dGxz"))
+	})
+	It(`Returns an *UnsupportedError for operations API version 2 does not have`, func() {
+		_, _, err := client.PeerAction(context.Background(), service.NewPeerActionOptions("peer1"))
+		var unsupported *console.UnsupportedError
+		Expect(errors.As(err, &unsupported)).To(BeTrue())
+		Expect(unsupported.Operation).To(Equal("PeerAction"))
+		Expect(unsupported.APIVersion).To(Equal(console.APIVersion2))
+		Expect(err.Error()).To(Equal("PeerAction is not supported on API version 2"))
+	})
+	It(`Returns the blockchainv3 error types`, func() {
+		_, _, err := client.GetComponent(context.Background(), service.NewGetComponentOptions("missing"))
+		var notFound *blockchainv3.NotFoundError
+		Expect(errors.As(err, &notFound)).To(BeTrue())
+		Expect(notFound.Operation).To(Equal("GetComponent"))
+		Expect(notFound.Message).To(Equal("not found"))
+	})
+	It(`Passes nil options on to be rejected`, func() {
+		_, _, err := client.GetComponent(context.Background(), nil)
+		Expect(err).To(MatchError("getComponentOptions cannot be nil"))
+	})
+	It(`Keeps the additional properties of models`, func() {
+		mock.Responses["GET /ak/api/v2/kubernetes/fabric/versions"] = `{"versions": {"peer": {"2.2.1-4": {"default": true, "version": "2.2.1-4"}}}}`
+		versions, _, err := client.GetFabVersions(context.Background(), service.NewGetFabVersionsOptions())
+		Expect(err).To(BeNil())
+		Expect(versions.Versions.Peer.GetProperty("2.2.1-4")).ToNot(BeNil())
+	})
+	It(`Converts settings and health stats`, func() {
+		mock.Responses["GET /ak/api/v2/settings"] = `{"PORT": "3000", "PROXY_TLS_WS_URL": {"url": "x"}}`
+		mock.Responses["GET /ak/api/v2/health"] = `{"OS": {"cpus": [{"model": "x", "speed": "2400"}], "loadavg": "0.1, 0.2, 0.3"}}`
+		settings, _, err := client.GetSettings(context.Background(), service.NewGetSettingsOptions())
+		Expect(err).To(BeNil())
+		Expect(*settings.PORT).To(Equal(3000.0))
+		Expect(settings.PROXYTLSWSURL).To(BeNil())
+
+		health, _, err := client.GetHealth(context.Background(), service.NewGetHealthOptions())
+		Expect(err).To(BeNil())
+		Expect(*health.OS.Cpus[0].Speed).To(Equal(2400.0))
+		Expect(health.OS.Loadavg).To(Equal([]float64{0.1, 0.2, 0.3}))
+	})
+})
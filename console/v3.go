@@ -0,0 +1,213 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package console
+
+import (
+	"context"
+	"github.com/IBM-Blockchain/ibp-go-sdk/blockchainv3"
+	"github.com/IBM/go-sdk-core/v4/core"
+)
+
+// v3Client : A Client backed by the API version 3 client, which the Client interface is modeled after.
+type v3Client struct {
+	service blockchainv3.BlockchainV3API
+}
+
+// NewV3Client : Wrap an API version 3 client, or any other BlockchainV3API such as a fake console, in a Client.
+func NewV3Client(service blockchainv3.BlockchainV3API) Client {
+	return &v3Client{service: service}
+}
+
+// APIVersion returns APIVersion3.
+func (client *v3Client) APIVersion() int {
+	return APIVersion3
+}
+
+// GetComponent : Get component data
+func (client *v3Client) GetComponent(ctx context.Context, getComponentOptions *blockchainv3.GetComponentOptions) (result *blockchainv3.GenericComponentResponse, response *core.DetailedResponse, err error) {
+	return client.service.GetComponentWithContext(ctx, getComponentOptions)
+}
+
+// ListComponents : Get all components
+func (client *v3Client) ListComponents(ctx context.Context, listComponentsOptions *blockchainv3.ListComponentsOptions) (result *blockchainv3.GetMultiComponentsResponse, response *core.DetailedResponse, err error) {
+	return client.service.ListComponentsWithContext(ctx, listComponentsOptions)
+}
+
+// GetComponentsByType : Get components of a type
+func (client *v3Client) GetComponentsByType(ctx context.Context, getComponentsByTypeOptions *blockchainv3.GetComponentsByTypeOptions) (result *blockchainv3.GetMultiComponentsResponse, response *core.DetailedResponse, err error) {
+	return client.service.GetComponentsByTypeWithContext(ctx, getComponentsByTypeOptions)
+}
+
+// GetComponentsByTag : Get components with tag
+func (client *v3Client) GetComponentsByTag(ctx context.Context, getComponentsByTagOptions *blockchainv3.GetComponentsByTagOptions) (result *blockchainv3.GetMultiComponentsResponse, response *core.DetailedResponse, err error) {
+	return client.service.GetComponentsByTagWithContext(ctx, getComponentsByTagOptions)
+}
+
+// RemoveComponent : Remove imported component
+func (client *v3Client) RemoveComponent(ctx context.Context, removeComponentOptions *blockchainv3.RemoveComponentOptions) (result *blockchainv3.DeleteComponentResponse, response *core.DetailedResponse, err error) {
+	return client.service.RemoveComponentWithContext(ctx, removeComponentOptions)
+}
+
+// DeleteComponent : Delete component
+func (client *v3Client) DeleteComponent(ctx context.Context, deleteComponentOptions *blockchainv3.DeleteComponentOptions) (result *blockchainv3.DeleteComponentResponse, response *core.DetailedResponse, err error) {
+	return client.service.DeleteComponentWithContext(ctx, deleteComponentOptions)
+}
+
+// RemoveComponentsByTag : Remove components with tag
+func (client *v3Client) RemoveComponentsByTag(ctx context.Context, removeComponentsByTagOptions *blockchainv3.RemoveComponentsByTagOptions) (result *blockchainv3.RemoveMultiComponentsResponse, response *core.DetailedResponse, err error) {
+	return client.service.RemoveComponentsByTagWithContext(ctx, removeComponentsByTagOptions)
+}
+
+// DeleteComponentsByTag : Delete components with tag
+func (client *v3Client) DeleteComponentsByTag(ctx context.Context, deleteComponentsByTagOptions *blockchainv3.DeleteComponentsByTagOptions) (result *blockchainv3.DeleteMultiComponentsResponse, response *core.DetailedResponse, err error) {
+	return client.service.DeleteComponentsByTagWithContext(ctx, deleteComponentsByTagOptions)
+}
+
+// CreateCa : Create a CA
+func (client *v3Client) CreateCa(ctx context.Context, createCaOptions *blockchainv3.CreateCaOptions) (result *blockchainv3.CaResponse, response *core.DetailedResponse, err error) {
+	return client.service.CreateCaWithContext(ctx, createCaOptions)
+}
+
+// ImportCa : Import a CA
+func (client *v3Client) ImportCa(ctx context.Context, importCaOptions *blockchainv3.ImportCaOptions) (result *blockchainv3.CaResponse, response *core.DetailedResponse, err error) {
+	return client.service.ImportCaWithContext(ctx, importCaOptions)
+}
+
+// UpdateCa : Update a CA
+func (client *v3Client) UpdateCa(ctx context.Context, updateCaOptions *blockchainv3.UpdateCaOptions) (result *blockchainv3.CaResponse, response *core.DetailedResponse, err error) {
+	return client.service.UpdateCaWithContext(ctx, updateCaOptions)
+}
+
+// EditCa : Edit data about a CA
+func (client *v3Client) EditCa(ctx context.Context, editCaOptions *blockchainv3.EditCaOptions) (result *blockchainv3.CaResponse, response *core.DetailedResponse, err error) {
+	return client.service.EditCaWithContext(ctx, editCaOptions)
+}
+
+// CaAction : Submit action to a CA
+func (client *v3Client) CaAction(ctx context.Context, caActionOptions *blockchainv3.CaActionOptions) (result *blockchainv3.ActionsResponse, response *core.DetailedResponse, err error) {
+	return client.service.CaActionWithContext(ctx, caActionOptions)
+}
+
+// CreatePeer : Create a peer
+func (client *v3Client) CreatePeer(ctx context.Context, createPeerOptions *blockchainv3.CreatePeerOptions) (result *blockchainv3.PeerResponse, response *core.DetailedResponse, err error) {
+	return client.service.CreatePeerWithContext(ctx, createPeerOptions)
+}
+
+// ImportPeer : Import a peer
+func (client *v3Client) ImportPeer(ctx context.Context, importPeerOptions *blockchainv3.ImportPeerOptions) (result *blockchainv3.PeerResponse, response *core.DetailedResponse, err error) {
+	return client.service.ImportPeerWithContext(ctx, importPeerOptions)
+}
+
+// UpdatePeer : Update a peer
+func (client *v3Client) UpdatePeer(ctx context.Context, updatePeerOptions *blockchainv3.UpdatePeerOptions) (result *blockchainv3.PeerResponse, response *core.DetailedResponse, err error) {
+	return client.service.UpdatePeerWithContext(ctx, updatePeerOptions)
+}
+
+// EditPeer : Edit data about a peer
+func (client *v3Client) EditPeer(ctx context.Context, editPeerOptions *blockchainv3.EditPeerOptions) (result *blockchainv3.PeerResponse, response *core.DetailedResponse, err error) {
+	return client.service.EditPeerWithContext(ctx, editPeerOptions)
+}
+
+// PeerAction : Submit action to a peer
+func (client *v3Client) PeerAction(ctx context.Context, peerActionOptions *blockchainv3.PeerActionOptions) (result *blockchainv3.ActionsResponse, response *core.DetailedResponse, err error) {
+	return client.service.PeerActionWithContext(ctx, peerActionOptions)
+}
+
+// CreateOrderer : Create an ordering service
+func (client *v3Client) CreateOrderer(ctx context.Context, createOrdererOptions *blockchainv3.CreateOrdererOptions) (result *blockchainv3.CreateOrdererResponse, response *core.DetailedResponse, err error) {
+	return client.service.CreateOrdererWithContext(ctx, createOrdererOptions)
+}
+
+// ImportOrderer : Import an ordering service
+func (client *v3Client) ImportOrderer(ctx context.Context, importOrdererOptions *blockchainv3.ImportOrdererOptions) (result *blockchainv3.OrdererResponse, response *core.DetailedResponse, err error) {
+	return client.service.ImportOrdererWithContext(ctx, importOrdererOptions)
+}
+
+// UpdateOrderer : Update an orderer node
+func (client *v3Client) UpdateOrderer(ctx context.Context, updateOrdererOptions *blockchainv3.UpdateOrdererOptions) (result *blockchainv3.OrdererResponse, response *core.DetailedResponse, err error) {
+	return client.service.UpdateOrdererWithContext(ctx, updateOrdererOptions)
+}
+
+// EditOrderer : Edit data about an orderer
+func (client *v3Client) EditOrderer(ctx context.Context, editOrdererOptions *blockchainv3.EditOrdererOptions) (result *blockchainv3.OrdererResponse, response *core.DetailedResponse, err error) {
+	return client.service.EditOrdererWithContext(ctx, editOrdererOptions)
+}
+
+// OrdererAction : Submit action to an orderer
+func (client *v3Client) OrdererAction(ctx context.Context, ordererActionOptions *blockchainv3.OrdererActionOptions) (result *blockchainv3.ActionsResponse, response *core.DetailedResponse, err error) {
+	return client.service.OrdererActionWithContext(ctx, ordererActionOptions)
+}
+
+// SubmitBlock : Submit config block to orderer
+func (client *v3Client) SubmitBlock(ctx context.Context, submitBlockOptions *blockchainv3.SubmitBlockOptions) (result *blockchainv3.GenericComponentResponse, response *core.DetailedResponse, err error) {
+	return client.service.SubmitBlockWithContext(ctx, submitBlockOptions)
+}
+
+// ImportMsp : Import an MSP
+func (client *v3Client) ImportMsp(ctx context.Context, importMspOptions *blockchainv3.ImportMspOptions) (result *blockchainv3.MspResponse, response *core.DetailedResponse, err error) {
+	return client.service.ImportMspWithContext(ctx, importMspOptions)
+}
+
+// EditMsp : Edit an MSP
+func (client *v3Client) EditMsp(ctx context.Context, editMspOptions *blockchainv3.EditMspOptions) (result *blockchainv3.MspResponse, response *core.DetailedResponse, err error) {
+	return client.service.EditMspWithContext(ctx, editMspOptions)
+}
+
+// GetMspCertificate : Get MSP's public certificates
+func (client *v3Client) GetMspCertificate(ctx context.Context, getMspCertificateOptions *blockchainv3.GetMspCertificateOptions) (result *blockchainv3.GetMSPCertificateResponse, response *core.DetailedResponse, err error) {
+	return client.service.GetMspCertificateWithContext(ctx, getMspCertificateOptions)
+}
+
+// EditAdminCerts : Edit admin certs on a component
+func (client *v3Client) EditAdminCerts(ctx context.Context, editAdminCertsOptions *blockchainv3.EditAdminCertsOptions) (result *blockchainv3.EditAdminCertsResponse, response *core.DetailedResponse, err error) {
+	return client.service.EditAdminCertsWithContext(ctx, editAdminCertsOptions)
+}
+
+// GetSettings : Get public IBP console settings
+func (client *v3Client) GetSettings(ctx context.Context, getSettingsOptions *blockchainv3.GetSettingsOptions) (result *blockchainv3.GetPublicSettingsResponse, response *core.DetailedResponse, err error) {
+	return client.service.GetSettingsWithContext(ctx, getSettingsOptions)
+}
+
+// EditSettings : Change IBP console settings
+func (client *v3Client) EditSettings(ctx context.Context, editSettingsOptions *blockchainv3.EditSettingsOptions) (result *blockchainv3.GetPublicSettingsResponse, response *core.DetailedResponse, err error) {
+	return client.service.EditSettingsWithContext(ctx, editSettingsOptions)
+}
+
+// GetFabVersions : Get supported Fabric versions
+func (client *v3Client) GetFabVersions(ctx context.Context, getFabVersionsOptions *blockchainv3.GetFabVersionsOptions) (result *blockchainv3.GetFabricVersionsResponse, response *core.DetailedResponse, err error) {
+	return client.service.GetFabVersionsWithContext(ctx, getFabVersionsOptions)
+}
+
+// GetHealth : Get IBP console health stats
+func (client *v3Client) GetHealth(ctx context.Context, getHealthOptions *blockchainv3.GetHealthOptions) (result *blockchainv3.GetAthenaHealthStatsResponse, response *core.DetailedResponse, err error) {
+	return client.service.GetHealthWithContext(ctx, getHealthOptions)
+}
+
+// ListNotifications : Get all notifications
+func (client *v3Client) ListNotifications(ctx context.Context, listNotificationsOptions *blockchainv3.ListNotificationsOptions) (result *blockchainv3.GetNotificationsResponse, response *core.DetailedResponse, err error) {
+	return client.service.ListNotificationsWithContext(ctx, listNotificationsOptions)
+}
+
+// ArchiveNotifications : Archive notifications
+func (client *v3Client) ArchiveNotifications(ctx context.Context, archiveNotificationsOptions *blockchainv3.ArchiveNotificationsOptions) (result *blockchainv3.ArchiveResponse, response *core.DetailedResponse, err error) {
+	return client.service.ArchiveNotificationsWithContext(ctx, archiveNotificationsOptions)
+}
+
+// DeleteSigTx : Delete a signature collection tx
+func (client *v3Client) DeleteSigTx(ctx context.Context, deleteSigTxOptions *blockchainv3.DeleteSigTxOptions) (result *blockchainv3.DeleteSignatureCollectionResponse, response *core.DetailedResponse, err error) {
+	return client.service.DeleteSigTxWithContext(ctx, deleteSigTxOptions)
+}
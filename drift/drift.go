@@ -0,0 +1,338 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package drift detects the components whose deployment no longer matches the desired one, e.g. after manual changes
+// made in the console UI, and generates the updates that reconcile them. Storage drift is reported, but cannot be
+// reconciled: the update options of the console do not change the storage of a deployed component.
+package drift
+
+import (
+	"context"
+	"fmt"
+	"github.com/IBM-Blockchain/ibp-go-sdk/blockchainv3"
+	"github.com/IBM/go-sdk-core/v4/core"
+	"strings"
+)
+
+// The component types that can drift.
+const (
+	TypeCA      = blockchainv3.GenericComponentResponse_Type_FabricCa
+	TypePeer    = blockchainv3.GenericComponentResponse_Type_FabricPeer
+	TypeOrderer = blockchainv3.GenericComponentResponse_Type_FabricOrderer
+)
+
+// Spec : The desired deployment of a component. Empty fields are not checked.
+// Only the containers the console reports resources for are compared: ca for CAs, peer, proxy and statedb for peers,
+// and orderer and proxy for orderers.
+type Spec struct {
+	ComponentID string `json:"component_id"`
+
+	// The Hyperledger Fabric version.
+	Version string `json:"version,omitempty"`
+
+	// The Kubernetes zone.
+	Zone string `json:"zone,omitempty"`
+
+	// The resources of a CA.
+	CaResources *blockchainv3.UpdateCaBodyResources `json:"ca_resources,omitempty"`
+
+	// The resources of a peer.
+	PeerResources *blockchainv3.PeerResources `json:"peer_resources,omitempty"`
+
+	// The resources of an orderer.
+	OrdererResources *blockchainv3.UpdateOrdererBodyResources `json:"orderer_resources,omitempty"`
+
+	// The storage of the containers: ca for CAs, peer and statedb for peers, and orderer for orderers.
+	Storage *blockchainv3.GenericComponentResponseStorage `json:"storage,omitempty"`
+}
+
+// Change : A field whose deployed value differs from the desired one.
+type Change struct {
+	// The path of the field, e.g. "version" or "resources.peer.requests.cpu".
+	Field string `json:"field"`
+
+	Current string `json:"current"`
+
+	Desired string `json:"desired"`
+
+	// Set when the change cannot be made through the Update*Options of the component, e.g. for storage.
+	Unreconcilable bool `json:"unreconcilable,omitempty"`
+}
+
+// Report : The drift of a component.
+type Report struct {
+	ComponentID string `json:"component_id"`
+
+	DisplayName string `json:"display_name,omitempty"`
+
+	// The component type, one of TypeCA, TypePeer and TypeOrderer.
+	Type string `json:"type,omitempty"`
+
+	// The fields that drifted. Empty if the component matches its spec.
+	Changes []Change `json:"changes,omitempty"`
+
+	// Why the component could not be checked.
+	Err error `json:"-"`
+
+	spec *Spec
+}
+
+// Drifted returns true if the component does not match its spec.
+func (report *Report) Drifted() bool {
+	return len(report.Changes) > 0
+}
+
+// Reconcilable returns true if some of the changes can be made through the Update*Options of the component.
+func (report *Report) Reconcilable() bool {
+	for _, change := range report.Changes {
+		if !change.Unreconcilable {
+			return true
+		}
+	}
+	return false
+}
+
+// String renders the report for humans, one line per change.
+func (report *Report) String() string {
+	var b strings.Builder
+	switch {
+	case report.Err != nil:
+		fmt.Fprintf(&b, "%s: %s\n", report.ComponentID, report.Err)
+	case !report.Drifted():
+		fmt.Fprintf(&b, "%s: no drift\n", report.ComponentID)
+	default:
+		fmt.Fprintf(&b, "%s:\n", report.ComponentID)
+		for _, change := range report.Changes {
+			fmt.Fprintf(&b, "  %s: %q -> %q", change.Field, change.Current, change.Desired)
+			if change.Unreconcilable {
+				b.WriteString(" (not reconcilable)")
+			}
+			b.WriteString("\n")
+		}
+	}
+	return b.String()
+}
+
+// Options : The Check options.
+type Options struct {
+	// Have the console read the deployment attributes from Kubernetes instead of its cache.
+	SkipCache bool
+}
+
+// Check compares the deployment attributes of the components of the specs with the specs, and returns a report per
+// spec, in order. A component that could not be checked has a report with Err set.
+func Check(ctx context.Context, service blockchainv3.BlockchainV3API, specs []Spec, options *Options) []*Report {
+	reports := make([]*Report, len(specs))
+	for i := range specs {
+		reports[i] = CheckComponent(ctx, service, &specs[i], options)
+	}
+	return reports
+}
+
+// CheckComponent compares the deployment attributes of a component with its spec.
+func CheckComponent(ctx context.Context, service blockchainv3.BlockchainV3API, spec *Spec, options *Options) *Report {
+	getOptions := service.NewGetComponentOptions(spec.ComponentID)
+	getOptions.SetDeploymentAttrs(blockchainv3.GetComponentOptions_DeploymentAttrs_Included)
+	if options != nil && options.SkipCache {
+		getOptions.SetCache(blockchainv3.GetComponentOptions_Cache_Skip)
+	}
+	component, _, err := service.GetComponentWithContext(ctx, getOptions)
+	if err != nil {
+		return &Report{ComponentID: spec.ComponentID, Err: err, spec: spec}
+	}
+	return Compare(component, spec)
+}
+
+// Compare compares the deployment attributes of a component, as returned by GetComponent with the deployment
+// attributes included, with its spec.
+func Compare(component *blockchainv3.GenericComponentResponse, spec *Spec) *Report {
+	report := &Report{
+		ComponentID: spec.ComponentID,
+		DisplayName: core.StringNilMapper(component.DisplayName),
+		Type:        core.StringNilMapper(component.Type),
+		spec:        spec,
+	}
+	if err := spec.check(report.Type); err != nil {
+		report.Err = err
+		return report
+	}
+
+	if spec.Version != "" && spec.Version != core.StringNilMapper(component.Version) {
+		report.Changes = append(report.Changes, Change{Field: "version", Current: core.StringNilMapper(component.Version), Desired: spec.Version})
+	}
+	if spec.Zone != "" && spec.Zone != core.StringNilMapper(component.Zone) {
+		report.Changes = append(report.Changes, Change{Field: "zone", Current: core.StringNilMapper(component.Zone), Desired: spec.Zone})
+	}
+	live := component.Resources
+	if live == nil {
+		live = new(blockchainv3.GenericComponentResponseResources)
+	}
+	for _, container := range spec.containers() {
+		report.Changes = append(report.Changes, diffResources(container.name, container.live(live), container.desired)...)
+	}
+	liveStorage := component.Storage
+	if liveStorage == nil {
+		liveStorage = new(blockchainv3.GenericComponentResponseStorage)
+	}
+	for _, volume := range spec.volumes() {
+		report.Changes = append(report.Changes, diffStorage(volume.name, volume.live(liveStorage), volume.desired)...)
+	}
+	return report
+}
+
+// check verifies that the spec only sets the resources of the component type.
+func (spec *Spec) check(componentType string) error {
+	var resourceTypes []string
+	if spec.CaResources != nil {
+		resourceTypes = append(resourceTypes, TypeCA)
+	}
+	if spec.PeerResources != nil {
+		resourceTypes = append(resourceTypes, TypePeer)
+	}
+	if spec.OrdererResources != nil {
+		resourceTypes = append(resourceTypes, TypeOrderer)
+	}
+	switch componentType {
+	case TypeCA, TypePeer, TypeOrderer:
+	default:
+		return fmt.Errorf("components of type %q cannot be checked for drift", componentType)
+	}
+	for _, resourceType := range resourceTypes {
+		if resourceType != componentType {
+			return fmt.Errorf("the spec sets the resources of a %s, but the component is a %s", resourceType, componentType)
+		}
+	}
+	for _, volume := range spec.volumes() {
+		if volume.componentType != componentType {
+			return fmt.Errorf("the spec sets the %s storage of a %s, but the component is a %s", volume.name, volume.componentType, componentType)
+		}
+	}
+	return nil
+}
+
+// container : A container whose resources the spec sets.
+type container struct {
+	name    string
+	desired *blockchainv3.ResourceObject
+	live    func(*blockchainv3.GenericComponentResponseResources) *blockchainv3.GenericResources
+}
+
+// containers returns the containers of the spec that the console reports resources for.
+func (spec *Spec) containers() []container {
+	var containers []container
+	add := func(name string, desired *blockchainv3.ResourceObject, live func(*blockchainv3.GenericComponentResponseResources) *blockchainv3.GenericResources) {
+		if desired != nil {
+			containers = append(containers, container{name: name, desired: desired, live: live})
+		}
+	}
+	if r := spec.CaResources; r != nil {
+		add("ca", r.Ca, func(l *blockchainv3.GenericComponentResponseResources) *blockchainv3.GenericResources { return l.Ca })
+	}
+	if r := spec.PeerResources; r != nil {
+		add("peer", r.Peer, func(l *blockchainv3.GenericComponentResponseResources) *blockchainv3.GenericResources { return l.Peer })
+		add("proxy", r.Proxy, func(l *blockchainv3.GenericComponentResponseResources) *blockchainv3.GenericResources { return l.Proxy })
+		add("statedb", r.Statedb, func(l *blockchainv3.GenericComponentResponseResources) *blockchainv3.GenericResources {
+			return l.Statedb
+		})
+	}
+	if r := spec.OrdererResources; r != nil {
+		add("orderer", r.Orderer, func(l *blockchainv3.GenericComponentResponseResources) *blockchainv3.GenericResources {
+			return l.Orderer
+		})
+		add("proxy", r.Proxy, func(l *blockchainv3.GenericComponentResponseResources) *blockchainv3.GenericResources { return l.Proxy })
+	}
+	return containers
+}
+
+// diffResources compares the deployed resources of a container with the non-empty desired values.
+func diffResources(name string, live *blockchainv3.GenericResources, desired *blockchainv3.ResourceObject) (changes []Change) {
+	if live == nil {
+		live = new(blockchainv3.GenericResources)
+	}
+	var requests blockchainv3.GenericResourcesRequests
+	if live.Requests != nil {
+		requests = *live.Requests
+	}
+	var limits blockchainv3.GenericResourceLimits
+	if live.Limits != nil {
+		limits = *live.Limits
+	}
+	prefix := "resources." + name
+	if desired.Requests != nil {
+		changes = append(changes, diffQuantity(prefix+".requests.cpu", requests.Cpu, desired.Requests.Cpu)...)
+		changes = append(changes, diffQuantity(prefix+".requests.memory", requests.Memory, desired.Requests.Memory)...)
+	}
+	if desired.Limits != nil {
+		changes = append(changes, diffQuantity(prefix+".limits.cpu", limits.Cpu, desired.Limits.Cpu)...)
+		changes = append(changes, diffQuantity(prefix+".limits.memory", limits.Memory, desired.Limits.Memory)...)
+	}
+	return
+}
+
+func diffQuantity(field string, current, desired *string) []Change {
+	if desired == nil || *desired == "" || equalQuantities(core.StringNilMapper(current), *desired) {
+		return nil
+	}
+	return []Change{{Field: field, Current: core.StringNilMapper(current), Desired: *desired}}
+}
+
+// volume : A container whose storage the spec sets.
+type volume struct {
+	name          string
+	componentType string
+	desired       *blockchainv3.StorageObject
+	live          func(*blockchainv3.GenericComponentResponseStorage) *blockchainv3.StorageObject
+}
+
+// volumes returns the containers of the spec that have storage.
+func (spec *Spec) volumes() []volume {
+	r := spec.Storage
+	if r == nil {
+		return nil
+	}
+	var volumes []volume
+	add := func(name, componentType string, desired *blockchainv3.StorageObject, live func(*blockchainv3.GenericComponentResponseStorage) *blockchainv3.StorageObject) {
+		if desired != nil {
+			volumes = append(volumes, volume{name: name, componentType: componentType, desired: desired, live: live})
+		}
+	}
+	add("ca", TypeCA, r.Ca, func(l *blockchainv3.GenericComponentResponseStorage) *blockchainv3.StorageObject { return l.Ca })
+	add("peer", TypePeer, r.Peer, func(l *blockchainv3.GenericComponentResponseStorage) *blockchainv3.StorageObject { return l.Peer })
+	add("statedb", TypePeer, r.Statedb, func(l *blockchainv3.GenericComponentResponseStorage) *blockchainv3.StorageObject {
+		return l.Statedb
+	})
+	add("orderer", TypeOrderer, r.Orderer, func(l *blockchainv3.GenericComponentResponseStorage) *blockchainv3.StorageObject {
+		return l.Orderer
+	})
+	return volumes
+}
+
+// diffStorage compares the deployed storage of a container with the non-empty desired values. The changes are
+// unreconcilable.
+func diffStorage(name string, live, desired *blockchainv3.StorageObject) (changes []Change) {
+	if live == nil {
+		live = new(blockchainv3.StorageObject)
+	}
+	prefix := "storage." + name
+	changes = append(changes, diffQuantity(prefix+".size", live.Size, desired.Size)...)
+	if desired.Class != nil && *desired.Class != "" && *desired.Class != core.StringNilMapper(live.Class) {
+		changes = append(changes, Change{Field: prefix + ".class", Current: core.StringNilMapper(live.Class), Desired: *desired.Class})
+	}
+	for i := range changes {
+		changes[i].Unreconcilable = true
+	}
+	return
+}
@@ -0,0 +1,175 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package drift_test
+
+import (
+	"context"
+	"errors"
+	"github.com/IBM-Blockchain/ibp-go-sdk/blockchainv3"
+	"github.com/IBM-Blockchain/ibp-go-sdk/blockchainv3fake"
+	"github.com/IBM-Blockchain/ibp-go-sdk/drift"
+	"github.com/IBM-Blockchain/ibp-go-sdk/internal/testutil"
+	"github.com/IBM/go-sdk-core/v4/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func resources(cpu, memory string) *blockchainv3.ResourceObject {
+	return &blockchainv3.ResourceObject{
+		Requests: &blockchainv3.ResourceRequests{Cpu: core.StringPtr(cpu), Memory: core.StringPtr(memory)},
+	}
+}
+
+var _ = Describe(`Drift`, func() {
+	var console *blockchainv3fake.Console
+
+	BeforeEach(func() {
+		console = blockchainv3fake.NewConsole()
+		options := console.NewCreatePeerOptions("org1msp", "Peer", testutil.CryptoObject())
+		options.SetID("peer")
+		options.SetZone("dal10")
+		options.SetVersion("2.2.1-4")
+		options.SetResources(&blockchainv3.PeerResources{
+			Peer:  resources("100m", "512Mi"),
+			Proxy: resources("0.1", "128Mi"),
+		})
+		options.SetStorage(&blockchainv3.CreatePeerBodyStorage{
+			Peer: &blockchainv3.StorageObject{Size: core.StringPtr("100Gi"), Class: core.StringPtr("default")},
+		})
+		_, _, err := console.CreatePeer(options)
+		Expect(err).To(BeNil())
+	})
+
+	It(`Reports no drift when the deployment matches the spec`, func() {
+		reports := drift.Check(context.Background(), console, []drift.Spec{{
+			ComponentID: "peer",
+			Version:     "2.2.1-4",
+			Zone:        "dal10",
+			PeerResources: &blockchainv3.PeerResources{
+				Peer:  resources("0.1", "0.5Gi"),
+				Proxy: resources("100m", "128Mi"),
+			},
+			Storage: &blockchainv3.GenericComponentResponseStorage{
+				Peer: &blockchainv3.StorageObject{Size: core.StringPtr("102400Mi")},
+			},
+		}}, nil)
+		Expect(reports).To(HaveLen(1))
+		Expect(reports[0].Err).To(BeNil())
+		Expect(reports[0].Drifted()).To(BeFalse())
+		Expect(reports[0].UpdatePeerOptions()).To(BeNil())
+		Expect(reports[0].String()).To(Equal("peer: no drift\n"))
+	})
+	It(`Reports the drifted fields and reconciles them`, func() {
+		spec := drift.Spec{
+			ComponentID: "peer",
+			Version:     "2.2.1-5",
+			Zone:        "dal10",
+			PeerResources: &blockchainv3.PeerResources{
+				Peer:  resources("200m", "512Mi"),
+				Proxy: resources("100m", "128Mi"),
+			},
+		}
+		report := drift.CheckComponent(context.Background(), console, &spec, &drift.Options{SkipCache: true})
+		Expect(report.Err).To(BeNil())
+		Expect(report.DisplayName).To(Equal("Peer"))
+		Expect(report.Type).To(Equal(drift.TypePeer))
+		Expect(report.Changes).To(Equal([]drift.Change{
+			{Field: "version", Current: "2.2.1-4", Desired: "2.2.1-5"},
+			{Field: "resources.peer.requests.cpu", Current: "100m", Desired: "200m"},
+		}))
+
+		options := report.UpdatePeerOptions()
+		Expect(options).ToNot(BeNil())
+		Expect(*options.ID).To(Equal("peer"))
+		Expect(*options.Version).To(Equal("2.2.1-5"))
+		Expect(options.Zone).To(BeNil())
+		Expect(options.Resources.Peer).To(Equal(spec.PeerResources.Peer))
+		Expect(options.Resources.Proxy).To(BeNil())
+		Expect(report.UpdateCaOptions()).To(BeNil())
+		Expect(report.UpdateOrdererOptions()).To(BeNil())
+
+		Expect(drift.Reconcile(context.Background(), console, report)).To(Succeed())
+		report = drift.CheckComponent(context.Background(), console, &spec, nil)
+		Expect(report.Err).To(BeNil())
+		Expect(report.Drifted()).To(BeFalse())
+	})
+	It(`Generates orderer and CA updates`, func() {
+		created, _, err := console.CreateOrderer(console.NewCreateOrdererOptions("raft", "osmsp", "Orderer",
+			[]blockchainv3.CryptoObject{*testutil.CryptoObject()}))
+		Expect(err).To(BeNil())
+		orderer := drift.CheckComponent(context.Background(), console, &drift.Spec{
+			ComponentID:      *created.Created[0].ID,
+			Zone:             "dal12",
+			OrdererResources: &blockchainv3.UpdateOrdererBodyResources{Orderer: resources("1", "1Gi")},
+		}, nil)
+		Expect(orderer.Err).To(BeNil())
+		options := orderer.UpdateOrdererOptions()
+		Expect(*options.Zone).To(Equal("dal12"))
+		Expect(options.Resources.Orderer.Requests.Cpu).To(Equal(core.StringPtr("1")))
+		Expect(drift.Reconcile(context.Background(), console, orderer)).To(Succeed())
+
+		ca := drift.Compare(&blockchainv3.GenericComponentResponse{
+			ID:        core.StringPtr("ca"),
+			Type:      core.StringPtr(drift.TypeCA),
+			Resources: &blockchainv3.GenericComponentResponseResources{},
+		}, &drift.Spec{ComponentID: "ca", CaResources: &blockchainv3.UpdateCaBodyResources{Ca: resources("100m", "")}})
+		Expect(ca.Changes).To(Equal([]drift.Change{{Field: "resources.ca.requests.cpu", Current: "", Desired: "100m"}}))
+		Expect(ca.UpdateCaOptions().Resources.Ca).To(Equal(resources("100m", "")))
+	})
+	It(`Reports storage drift without reconciling it`, func() {
+		spec := drift.Spec{
+			ComponentID: "peer",
+			Zone:        "dal12",
+			Storage: &blockchainv3.GenericComponentResponseStorage{
+				Peer:    &blockchainv3.StorageObject{Size: core.StringPtr("200Gi"), Class: core.StringPtr("default")},
+				Statedb: &blockchainv3.StorageObject{Class: core.StringPtr("fast")},
+			},
+		}
+		report := drift.CheckComponent(context.Background(), console, &spec, nil)
+		Expect(report.Err).To(BeNil())
+		Expect(report.Changes).To(Equal([]drift.Change{
+			{Field: "zone", Current: "dal10", Desired: "dal12"},
+			{Field: "storage.peer.size", Current: "100Gi", Desired: "200Gi", Unreconcilable: true},
+			{Field: "storage.statedb.class", Current: "", Desired: "fast", Unreconcilable: true},
+		}))
+		Expect(report.String()).To(Equal("peer:\n" +
+			"  zone: \"dal10\" -> \"dal12\"\n" +
+			"  storage.peer.size: \"100Gi\" -> \"200Gi\" (not reconcilable)\n" +
+			"  storage.statedb.class: \"\" -> \"fast\" (not reconcilable)\n"))
+
+		err := drift.Reconcile(context.Background(), console, report)
+		Expect(err).To(MatchError("peer: storage.peer.size, storage.statedb.class cannot be reconciled through an update"))
+		report = drift.CheckComponent(context.Background(), console, &spec, nil)
+		Expect(report.Changes).To(HaveLen(2))
+		Expect(report.Reconcilable()).To(BeFalse())
+		Expect(report.UpdatePeerOptions()).To(BeNil())
+	})
+	It(`Reports the components that cannot be checked`, func() {
+		reports := drift.Check(context.Background(), console, []drift.Spec{
+			{ComponentID: "missing", Version: "2.2.1-5"},
+			{ComponentID: "peer", CaResources: &blockchainv3.UpdateCaBodyResources{Ca: resources("1", "1Gi")}},
+			{ComponentID: "peer", Storage: &blockchainv3.GenericComponentResponseStorage{
+				Orderer: &blockchainv3.StorageObject{Size: core.StringPtr("10Gi")},
+			}},
+		}, nil)
+		var notFound *blockchainv3.NotFoundError
+		Expect(errors.As(reports[0].Err, &notFound)).To(BeTrue())
+		Expect(reports[1].Err).To(MatchError("the spec sets the resources of a fabric-ca, but the component is a fabric-peer"))
+		Expect(reports[2].Err).To(MatchError("the spec sets the orderer storage of a fabric-orderer, but the component is a fabric-peer"))
+		Expect(drift.Reconcile(context.Background(), console, reports[0])).ToNot(Succeed())
+	})
+})
@@ -0,0 +1,63 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package drift
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// quantitySuffixes are the multipliers of the Kubernetes quantity suffixes.
+var quantitySuffixes = []struct {
+	suffix     string
+	multiplier float64
+}{
+	{"Ki", 1 << 10}, {"Mi", 1 << 20}, {"Gi", 1 << 30}, {"Ti", 1 << 40}, {"Pi", 1 << 50}, {"Ei", 1 << 60},
+	{"m", 1e-3}, {"k", 1e3}, {"M", 1e6}, {"G", 1e9}, {"T", 1e12}, {"P", 1e15}, {"E", 1e18},
+}
+
+// parseQuantity parses a Kubernetes quantity such as "100m", "0.5", "256Mi" or "1G".
+func parseQuantity(quantity string) (float64, bool) {
+	quantity = strings.TrimSpace(quantity)
+	multiplier := 1.0
+	for _, s := range quantitySuffixes {
+		if strings.HasSuffix(quantity, s.suffix) {
+			quantity, multiplier = strings.TrimSuffix(quantity, s.suffix), s.multiplier
+			break
+		}
+	}
+	value, err := strconv.ParseFloat(quantity, 64)
+	if err != nil {
+		return 0, false
+	}
+	return value * multiplier, true
+}
+
+// equalQuantities returns true if two quantities have the same value, e.g. "100m" and "0.1", or "1Gi" and "1024Mi".
+// Quantities that cannot be parsed are compared as strings.
+func equalQuantities(a, b string) bool {
+	if a == b {
+		return true
+	}
+	x, okA := parseQuantity(a)
+	y, okB := parseQuantity(b)
+	if !okA || !okB {
+		return false
+	}
+	return math.Abs(x-y) <= 1e-9*math.Max(math.Abs(x), math.Abs(y))
+}
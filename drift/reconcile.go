@@ -0,0 +1,141 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package drift
+
+import (
+	"context"
+	"fmt"
+	"github.com/IBM-Blockchain/ibp-go-sdk/blockchainv3"
+	"github.com/IBM/go-sdk-core/v4/core"
+	"strings"
+)
+
+// UpdateCaOptions returns the options of the UpdateCa call that reconciles a drifted CA, or nil if the report is not
+// about a CA with reconcilable drift. Only the drifted fields are set.
+func (report *Report) UpdateCaOptions() *blockchainv3.UpdateCaOptions {
+	if report.Type != TypeCA || !report.Reconcilable() {
+		return nil
+	}
+	options := &blockchainv3.UpdateCaOptions{ID: core.StringPtr(report.ComponentID)}
+	options.Version, options.Zone = report.changedVersion(), report.changedZone()
+	if report.changed("resources.ca") {
+		options.Resources = &blockchainv3.UpdateCaBodyResources{Ca: report.spec.CaResources.Ca}
+	}
+	return options
+}
+
+// UpdatePeerOptions returns the options of the UpdatePeer call that reconciles a drifted peer, or nil if the report is
+// not about a peer with reconcilable drift. Only the drifted fields are set.
+func (report *Report) UpdatePeerOptions() *blockchainv3.UpdatePeerOptions {
+	if report.Type != TypePeer || !report.Reconcilable() {
+		return nil
+	}
+	options := &blockchainv3.UpdatePeerOptions{ID: core.StringPtr(report.ComponentID)}
+	options.Version, options.Zone = report.changedVersion(), report.changedZone()
+	resources := new(blockchainv3.PeerResources)
+	if report.changed("resources.peer") {
+		resources.Peer = report.spec.PeerResources.Peer
+	}
+	if report.changed("resources.proxy") {
+		resources.Proxy = report.spec.PeerResources.Proxy
+	}
+	if report.changed("resources.statedb") {
+		resources.Statedb = report.spec.PeerResources.Statedb
+	}
+	if resources.Peer != nil || resources.Proxy != nil || resources.Statedb != nil {
+		options.Resources = resources
+	}
+	return options
+}
+
+// UpdateOrdererOptions returns the options of the UpdateOrderer call that reconciles a drifted orderer, or nil if the
+// report is not about an orderer with reconcilable drift. Only the drifted fields are set.
+func (report *Report) UpdateOrdererOptions() *blockchainv3.UpdateOrdererOptions {
+	if report.Type != TypeOrderer || !report.Reconcilable() {
+		return nil
+	}
+	options := &blockchainv3.UpdateOrdererOptions{ID: core.StringPtr(report.ComponentID)}
+	options.Version, options.Zone = report.changedVersion(), report.changedZone()
+	resources := new(blockchainv3.UpdateOrdererBodyResources)
+	if report.changed("resources.orderer") {
+		resources.Orderer = report.spec.OrdererResources.Orderer
+	}
+	if report.changed("resources.proxy") {
+		resources.Proxy = report.spec.OrdererResources.Proxy
+	}
+	if resources.Orderer != nil || resources.Proxy != nil {
+		options.Resources = resources
+	}
+	return options
+}
+
+// Reconcile updates a drifted component to match its spec. It does nothing if the component did not drift. The
+// unreconcilable changes are left as they are, and reported in the returned error once the others are made.
+func Reconcile(ctx context.Context, service blockchainv3.BlockchainV3API, report *Report) error {
+	if report.Err != nil {
+		return fmt.Errorf("%s was not checked: %w", report.ComponentID, report.Err)
+	}
+	if report.Reconcilable() {
+		var err error
+		switch report.Type {
+		case TypeCA:
+			_, _, err = service.UpdateCaWithContext(ctx, report.UpdateCaOptions())
+		case TypePeer:
+			_, _, err = service.UpdatePeerWithContext(ctx, report.UpdatePeerOptions())
+		case TypeOrderer:
+			_, _, err = service.UpdateOrdererWithContext(ctx, report.UpdateOrdererOptions())
+		default:
+			err = fmt.Errorf("components of type %q cannot be reconciled", report.Type)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	var unreconcilable []string
+	for _, change := range report.Changes {
+		if change.Unreconcilable {
+			unreconcilable = append(unreconcilable, change.Field)
+		}
+	}
+	if len(unreconcilable) > 0 {
+		return fmt.Errorf("%s: %s cannot be reconciled through an update", report.ComponentID, strings.Join(unreconcilable, ", "))
+	}
+	return nil
+}
+
+func (report *Report) changed(prefix string) bool {
+	for _, change := range report.Changes {
+		if change.Field == prefix || strings.HasPrefix(change.Field, prefix+".") {
+			return true
+		}
+	}
+	return false
+}
+
+func (report *Report) changedVersion() *string {
+	if report.changed("version") {
+		return core.StringPtr(report.spec.Version)
+	}
+	return nil
+}
+
+func (report *Report) changedZone() *string {
+	if report.changed("zone") {
+		return core.StringPtr(report.spec.Zone)
+	}
+	return nil
+}
@@ -0,0 +1,155 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package health monitors an IBP console and its components, and exposes their health as Prometheus metrics.
+// A Monitor periodically scrapes the console's health stats and probes the /healthz endpoint of the operations URL of
+// every component, so that one metrics endpoint covers the console and the nodes.
+package health
+
+import (
+	"context"
+	"github.com/IBM-Blockchain/ibp-go-sdk/blockchainv3"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Defaults of the Monitor settings.
+const (
+	DefaultInterval     = 30 * time.Second
+	DefaultProbeTimeout = 5 * time.Second
+)
+
+// Monitor : Scrapes the health of a console and of its components. It is a prometheus.Collector that reports the
+// result of the last scrape.
+type Monitor struct {
+	Service blockchainv3.BlockchainV3API
+
+	// The delay between two scrapes. Defaults to DefaultInterval.
+	Interval time.Duration
+
+	// The client of the /healthz probes. Set it to trust the TLS certificates of the operations endpoints. Defaults
+	// to a client with a DefaultProbeTimeout timeout.
+	Client *http.Client
+
+	// Called with the errors of the scrapes, if set. Failed probes are reported as metrics instead.
+	OnError func(err error)
+
+	mu   sync.Mutex
+	last *Scrape
+}
+
+// Scrape : The health of a console and of its components at one point in time.
+type Scrape struct {
+	Time time.Time
+
+	// The console health stats. Nil when GetHealth failed.
+	Console *blockchainv3.GetAthenaHealthStatsResponse
+
+	// The probes of the components that have an operations URL. Nil when the components could not be listed.
+	Components []*ComponentHealth
+}
+
+// ComponentHealth : The result of the /healthz probe of a component.
+type ComponentHealth struct {
+	ComponentID string
+
+	DisplayName string
+
+	// The component type, e.g. "fabric-peer".
+	Type string
+
+	// The operations URL of the component.
+	OperationsURL string
+
+	// Whether /healthz answered with 200 OK.
+	Up bool
+
+	// The HTTP status code of the response. Zero when no response was received.
+	StatusCode int
+
+	// The components whose checks failed, by component (e.g. "couchdb"), as reported by the node.
+	FailedChecks map[string]string
+
+	// Why the probe failed, when no response was received.
+	Error string
+
+	Duration time.Duration
+}
+
+// Run scrapes the console at once and then every interval until the context is done, and returns the error of the
+// context.
+func (monitor *Monitor) Run(ctx context.Context) error {
+	interval := monitor.Interval
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for ctx.Err() == nil {
+		if _, err := monitor.Scrape(ctx); err != nil && ctx.Err() == nil && monitor.OnError != nil {
+			monitor.OnError(err)
+		}
+		select {
+		case <-ctx.Done():
+		case <-ticker.C:
+		}
+	}
+	return ctx.Err()
+}
+
+// Scrape gets the health stats of the console, lists its components and probes them, and keeps the result for the
+// metrics. It returns the first error of GetHealth and ListComponents; the scrape is kept either way.
+func (monitor *Monitor) Scrape(ctx context.Context) (*Scrape, error) {
+	service := monitor.Service
+	scrape := &Scrape{Time: time.Now()}
+	var firstErr error
+	consoleHealth, _, err := service.GetHealthWithContext(ctx, service.NewGetHealthOptions())
+	if err != nil {
+		firstErr = err
+	} else {
+		scrape.Console = consoleHealth
+	}
+	list, _, err := service.ListComponentsWithContext(ctx, service.NewListComponentsOptions())
+	if err != nil {
+		if firstErr == nil {
+			firstErr = err
+		}
+	} else {
+		scrape.Components = monitor.probeAll(ctx, list.Components)
+	}
+
+	monitor.mu.Lock()
+	monitor.last = scrape
+	monitor.mu.Unlock()
+	return scrape, firstErr
+}
+
+// Last returns the result of the last scrape, or nil before the first one.
+func (monitor *Monitor) Last() *Scrape {
+	monitor.mu.Lock()
+	defer monitor.mu.Unlock()
+	return monitor.last
+}
+
+// Handler returns an HTTP handler serving the metrics of the monitor, e.g. on /metrics.
+func (monitor *Monitor) Handler() http.Handler {
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(monitor)
+	return promhttp.HandlerFor(registry, promhttp.HandlerOpts{})
+}
@@ -0,0 +1,205 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package health_test
+
+import (
+	"context"
+	"github.com/IBM-Blockchain/ibp-go-sdk/blockchainv3"
+	"github.com/IBM-Blockchain/ibp-go-sdk/blockchainv3fake"
+	"github.com/IBM-Blockchain/ibp-go-sdk/health"
+	"github.com/IBM-Blockchain/ibp-go-sdk/internal/testutil"
+	"github.com/IBM/go-sdk-core/v4/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"time"
+)
+
+// failingConsole wraps a console so that GetHealth fails.
+func failingConsole(console *blockchainv3fake.Console) *testutil.FailingConsole {
+	failing := testutil.NewFailingConsole(console)
+	failing.Fail("GetHealth")
+	return failing
+}
+
+var _ = Describe(`Monitor`, func() {
+	var console *blockchainv3fake.Console
+	var healthyServer, failingServer, closedServer *httptest.Server
+
+	importPeer := func(name, operationsURL string) string {
+		options := console.NewImportPeerOptions(name, "https://proxy", &blockchainv3.MspCryptoField{
+			Tlsca:     &blockchainv3.MspCryptoFieldTlsca{RootCerts: []string{"tlsroot"}},
+			Component: &blockchainv3.MspCryptoFieldComponent{TlsCert: core.StringPtr("tlscert")},
+		}, "org1msp")
+		if operationsURL != "" {
+			options.SetOperationsURL(operationsURL)
+		}
+		peer, _, err := console.ImportPeer(options)
+		Expect(err).To(BeNil())
+		return *peer.ID
+	}
+	metrics := func(monitor *health.Monitor) string {
+		server := httptest.NewServer(monitor.Handler())
+		defer server.Close()
+		res, err := http.Get(server.URL)
+		Expect(err).To(BeNil())
+		defer res.Body.Close()
+		body, err := ioutil.ReadAll(res.Body)
+		Expect(err).To(BeNil())
+		return string(body)
+	}
+
+	BeforeEach(func() {
+		console = blockchainv3fake.NewConsole()
+		console.SetHealth(&blockchainv3.GetAthenaHealthStatsResponse{
+			OPTOOLS: &blockchainv3.GetAthenaHealthStatsResponseOPTOOLS{
+				InstanceID: core.StringPtr("console1"),
+				Born:       core.Float64Ptr(1000000),
+				Now:        core.Float64Ptr(4600000),
+				MemoryUsage: &blockchainv3.GetAthenaHealthStatsResponseOPTOOLSMemoryUsage{
+					Rss:      core.StringPtr("56.5 MB"),
+					HeapUsed: core.StringPtr("2 KB"),
+				},
+				SessionCacheStats: &blockchainv3.CacheData{Hits: core.Float64Ptr(42), Misses: core.Float64Ptr(3), Keys: core.Float64Ptr(5), CacheSize: core.StringPtr("1.5 KB")},
+				IamCacheStats:     &blockchainv3.CacheData{Hits: core.Float64Ptr(7)},
+			},
+			OS: &blockchainv3.GetAthenaHealthStatsResponseOS{
+				Arch:        core.StringPtr("x64"),
+				Type:        core.StringPtr("Linux"),
+				Loadavg:     []float64{0.5, 0.25, 0.125},
+				TotalMemory: core.StringPtr("2 GB"),
+				FreeMemory:  core.StringPtr("512 MB"),
+				Cpus: []blockchainv3.CpuHealthStats{{
+					Speed: core.Float64Ptr(2400),
+					Times: &blockchainv3.CpuHealthStatsTimes{Idle: core.Float64Ptr(90000), User: core.Float64Ptr(1500)},
+				}},
+			},
+		})
+
+		healthyServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			Expect(req.URL.Path).To(Equal("/healthz"))
+			res.Write([]byte(`{"status": "OK", "time": "2021-06-01T00:00:00Z"}`))
+		}))
+		failingServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			res.WriteHeader(http.StatusServiceUnavailable)
+			res.Write([]byte(`{"status": "Service Unavailable", "failed_checks": [{"component": "couchdb", "reason": "failed to connect"}]}`))
+		}))
+		closedServer = httptest.NewServer(http.NotFoundHandler())
+		closedServer.Close()
+	})
+
+	AfterEach(func() {
+		healthyServer.Close()
+		failingServer.Close()
+	})
+
+	It(`exports the console health stats`, func() {
+		monitor := &health.Monitor{Service: console}
+		Expect(metrics(monitor)).To(BeEmpty())
+
+		scrape, err := monitor.Scrape(context.Background())
+		Expect(err).To(BeNil())
+		Expect(scrape.Console).ToNot(BeNil())
+		Expect(scrape.Components).To(BeEmpty())
+
+		body := metrics(monitor)
+		for _, line := range []string{
+			`ibp_console_up 1`,
+			`ibp_console_info{arch="x64",instance_id="console1",os_type="Linux"} 1`,
+			`ibp_console_uptime_seconds 3600`,
+			`ibp_console_memory_bytes{type="rss"} 5.9244544e+07`,
+			`ibp_console_memory_bytes{type="heap_used"} 2048`,
+			`ibp_console_cache_hits_total{cache="session"} 42`,
+			`ibp_console_cache_hits_total{cache="iam"} 7`,
+			`ibp_console_cache_misses_total{cache="session"} 3`,
+			`ibp_console_cache_keys{cache="session"} 5`,
+			`ibp_console_cache_size_bytes{cache="session"} 1536`,
+			`ibp_console_os_load_average{period="15m"} 0.125`,
+			`ibp_console_os_memory_total_bytes 2.147483648e+09`,
+			`ibp_console_os_memory_free_bytes 5.36870912e+08`,
+			`ibp_console_os_cpu_seconds_total{cpu="0",mode="idle"} 90`,
+			`ibp_console_os_cpu_seconds_total{cpu="0",mode="user"} 1.5`,
+			`ibp_console_os_cpu_speed_mhz{cpu="0"} 2400`,
+		} {
+			Expect(body).To(ContainSubstring(line + "\n"))
+		}
+		Expect(body).ToNot(ContainSubstring(`ibp_console_memory_bytes{type="external"}`))
+		Expect(body).ToNot(ContainSubstring(`cache="couch"`))
+	})
+
+	It(`probes the /healthz endpoint of the components`, func() {
+		healthy := importPeer("Healthy", healthyServer.URL+"/")
+		failing := importPeer("Failing", failingServer.URL)
+		unreachable := importPeer("Unreachable", closedServer.URL)
+		importPeer("No operations", "")
+
+		monitor := &health.Monitor{Service: console}
+		scrape, err := monitor.Scrape(context.Background())
+		Expect(err).To(BeNil())
+		Expect(scrape.Components).To(HaveLen(3))
+		Expect(scrape.Components[0].Up).To(BeTrue())
+		Expect(scrape.Components[1].StatusCode).To(Equal(503))
+		Expect(scrape.Components[1].FailedChecks).To(Equal(map[string]string{"couchdb": "failed to connect"}))
+		Expect(scrape.Components[2].StatusCode).To(BeZero())
+		Expect(scrape.Components[2].Error).ToNot(BeEmpty())
+
+		body := metrics(monitor)
+		Expect(body).To(ContainSubstring(`ibp_component_up{component_id="` + healthy + `",display_name="Healthy",type="fabric-peer"} 1` + "\n"))
+		Expect(body).To(ContainSubstring(`ibp_component_up{component_id="` + failing + `",display_name="Failing",type="fabric-peer"} 0` + "\n"))
+		Expect(body).To(ContainSubstring(`ibp_component_up{component_id="` + unreachable + `",display_name="Unreachable",type="fabric-peer"} 0` + "\n"))
+		Expect(body).To(ContainSubstring(`ibp_component_health_check_failed{check="couchdb",component_id="` + failing + `",display_name="Failing",type="fabric-peer"} 1` + "\n"))
+		Expect(body).To(ContainSubstring(`ibp_component_probe_duration_seconds{component_id="` + healthy + `"`))
+		Expect(body).ToNot(ContainSubstring(`No operations`))
+	})
+
+	It(`reports a console that cannot be scraped`, func() {
+		importPeer("Healthy", healthyServer.URL)
+		monitor := &health.Monitor{Service: failingConsole(console)}
+		scrape, err := monitor.Scrape(context.Background())
+		Expect(err).To(MatchError("connection refused"))
+		Expect(scrape.Console).To(BeNil())
+		Expect(monitor.Last()).To(Equal(scrape))
+
+		body := metrics(monitor)
+		Expect(body).To(ContainSubstring("ibp_console_up 0\n"))
+		Expect(body).ToNot(ContainSubstring("ibp_console_info"))
+		Expect(body).To(ContainSubstring(`display_name="Healthy",type="fabric-peer"} 1` + "\n"))
+	})
+
+	It(`scrapes periodically until the context is done`, func() {
+		var failures int32
+		monitor := &health.Monitor{
+			Service:  failingConsole(console),
+			Interval: 10 * time.Millisecond,
+			OnError: func(err error) {
+				atomic.AddInt32(&failures, 1)
+			},
+		}
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan error)
+		go func() {
+			done <- monitor.Run(ctx)
+		}()
+		Eventually(func() int32 { return atomic.LoadInt32(&failures) }).Should(BeNumerically(">=", 2))
+		cancel()
+		Eventually(done).Should(Receive(Equal(context.Canceled)))
+	})
+})
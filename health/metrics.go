@@ -0,0 +1,213 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package health
+
+import (
+	"github.com/IBM-Blockchain/ibp-go-sdk/blockchainv3"
+	"github.com/IBM/go-sdk-core/v4/core"
+	"github.com/prometheus/client_golang/prometheus"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// componentLabels are the labels of the metrics of the components.
+var componentLabels = []string{"component_id", "display_name", "type"}
+
+// The metrics of the monitor.
+var (
+	consoleUpDesc = prometheus.NewDesc("ibp_console_up",
+		"Whether the last GetHealth call succeeded.", nil, nil)
+	consoleInfoDesc = prometheus.NewDesc("ibp_console_info",
+		"The console server process and its operating system, always 1.", []string{"instance_id", "arch", "os_type"}, nil)
+	consoleUptimeDesc = prometheus.NewDesc("ibp_console_uptime_seconds",
+		"How long the console server has been running.", nil, nil)
+	consoleMemoryDesc = prometheus.NewDesc("ibp_console_memory_bytes",
+		"The memory usage of the console server, by type (rss, heap_total, heap_used, external).", []string{"type"}, nil)
+	cacheHitsDesc = prometheus.NewDesc("ibp_console_cache_hits_total",
+		"The hits of the console caches (session, couch, iam, proxy).", []string{"cache"}, nil)
+	cacheMissesDesc = prometheus.NewDesc("ibp_console_cache_misses_total",
+		"The misses of the console caches (session, couch, iam, proxy).", []string{"cache"}, nil)
+	cacheKeysDesc = prometheus.NewDesc("ibp_console_cache_keys",
+		"The number of entries in the console caches.", []string{"cache"}, nil)
+	cacheSizeDesc = prometheus.NewDesc("ibp_console_cache_size_bytes",
+		"The approximate size of the console caches.", []string{"cache"}, nil)
+	osLoadDesc = prometheus.NewDesc("ibp_console_os_load_average",
+		"The CPU load of the console's operating system, over 1, 5 and 15 minutes.", []string{"period"}, nil)
+	osMemoryTotalDesc = prometheus.NewDesc("ibp_console_os_memory_total_bytes",
+		"The total memory of the console's operating system.", nil, nil)
+	osMemoryFreeDesc = prometheus.NewDesc("ibp_console_os_memory_free_bytes",
+		"The free memory of the console's operating system.", nil, nil)
+	osCPUSecondsDesc = prometheus.NewDesc("ibp_console_os_cpu_seconds_total",
+		"The time each CPU of the console's operating system spent in each mode (idle, irq, nice, sys, user).", []string{"cpu", "mode"}, nil)
+	osCPUSpeedDesc = prometheus.NewDesc("ibp_console_os_cpu_speed_mhz",
+		"The speed of each CPU of the console's operating system.", []string{"cpu"}, nil)
+	componentUpDesc = prometheus.NewDesc("ibp_component_up",
+		"Whether the /healthz endpoint of the component answered with 200 OK.", componentLabels, nil)
+	componentCheckFailedDesc = prometheus.NewDesc("ibp_component_health_check_failed",
+		"The checks reported as failed by the /healthz endpoint of the component, always 1.", append(componentLabels, "check"), nil)
+	componentProbeDurationDesc = prometheus.NewDesc("ibp_component_probe_duration_seconds",
+		"How long the /healthz probe of the component took.", componentLabels, nil)
+)
+
+// Describe sends the descriptions of every metric of the monitor.
+func (monitor *Monitor) Describe(ch chan<- *prometheus.Desc) {
+	for _, desc := range []*prometheus.Desc{
+		consoleUpDesc, consoleInfoDesc, consoleUptimeDesc, consoleMemoryDesc,
+		cacheHitsDesc, cacheMissesDesc, cacheKeysDesc, cacheSizeDesc,
+		osLoadDesc, osMemoryTotalDesc, osMemoryFreeDesc, osCPUSecondsDesc, osCPUSpeedDesc,
+		componentUpDesc, componentCheckFailedDesc, componentProbeDurationDesc,
+	} {
+		ch <- desc
+	}
+}
+
+// Collect sends the metrics of the last scrape. Nothing is sent before the first scrape; the values the console did
+// not report are left out.
+func (monitor *Monitor) Collect(ch chan<- prometheus.Metric) {
+	scrape := monitor.Last()
+	if scrape == nil {
+		return
+	}
+	if scrape.Console == nil {
+		ch <- prometheus.MustNewConstMetric(consoleUpDesc, prometheus.GaugeValue, 0)
+	} else {
+		ch <- prometheus.MustNewConstMetric(consoleUpDesc, prometheus.GaugeValue, 1)
+		collectOptools(ch, scrape.Console.OPTOOLS)
+		collectOS(ch, scrape.Console.OS)
+		if scrape.Console.OPTOOLS != nil || scrape.Console.OS != nil {
+			info := []string{"", "", ""}
+			if optools := scrape.Console.OPTOOLS; optools != nil {
+				info[0] = core.StringNilMapper(optools.InstanceID)
+			}
+			if os := scrape.Console.OS; os != nil {
+				info[1], info[2] = core.StringNilMapper(os.Arch), core.StringNilMapper(os.Type)
+			}
+			ch <- prometheus.MustNewConstMetric(consoleInfoDesc, prometheus.GaugeValue, 1, info...)
+		}
+	}
+	for _, component := range scrape.Components {
+		labels := []string{component.ComponentID, component.DisplayName, component.Type}
+		up := 0.0
+		if component.Up {
+			up = 1
+		}
+		ch <- prometheus.MustNewConstMetric(componentUpDesc, prometheus.GaugeValue, up, labels...)
+		ch <- prometheus.MustNewConstMetric(componentProbeDurationDesc, prometheus.GaugeValue, component.Duration.Seconds(), labels...)
+		for check := range component.FailedChecks {
+			ch <- prometheus.MustNewConstMetric(componentCheckFailedDesc, prometheus.GaugeValue, 1, append(labels, check)...)
+		}
+	}
+}
+
+func collectOptools(ch chan<- prometheus.Metric, optools *blockchainv3.GetAthenaHealthStatsResponseOPTOOLS) {
+	if optools == nil {
+		return
+	}
+	if optools.Now != nil && optools.Born != nil {
+		ch <- prometheus.MustNewConstMetric(consoleUptimeDesc, prometheus.GaugeValue, (*optools.Now-*optools.Born)/1000)
+	}
+	if memory := optools.MemoryUsage; memory != nil {
+		for memoryType, size := range map[string]*string{
+			"rss":        memory.Rss,
+			"heap_total": memory.HeapTotal,
+			"heap_used":  memory.HeapUsed,
+			"external":   memory.External,
+		} {
+			if bytes, ok := parseBytes(size); ok {
+				ch <- prometheus.MustNewConstMetric(consoleMemoryDesc, prometheus.GaugeValue, bytes, memoryType)
+			}
+		}
+	}
+	for cache, stats := range map[string]*blockchainv3.CacheData{
+		"session": optools.SessionCacheStats,
+		"couch":   optools.CouchCacheStats,
+		"iam":     optools.IamCacheStats,
+		"proxy":   optools.ProxyCache,
+	} {
+		if stats == nil {
+			continue
+		}
+		if stats.Hits != nil {
+			ch <- prometheus.MustNewConstMetric(cacheHitsDesc, prometheus.CounterValue, *stats.Hits, cache)
+		}
+		if stats.Misses != nil {
+			ch <- prometheus.MustNewConstMetric(cacheMissesDesc, prometheus.CounterValue, *stats.Misses, cache)
+		}
+		if stats.Keys != nil {
+			ch <- prometheus.MustNewConstMetric(cacheKeysDesc, prometheus.GaugeValue, *stats.Keys, cache)
+		}
+		if bytes, ok := parseBytes(stats.CacheSize); ok {
+			ch <- prometheus.MustNewConstMetric(cacheSizeDesc, prometheus.GaugeValue, bytes, cache)
+		}
+	}
+}
+
+func collectOS(ch chan<- prometheus.Metric, os *blockchainv3.GetAthenaHealthStatsResponseOS) {
+	if os == nil {
+		return
+	}
+	for i, load := range os.Loadavg {
+		if i < len(loadPeriods) {
+			ch <- prometheus.MustNewConstMetric(osLoadDesc, prometheus.GaugeValue, load, loadPeriods[i])
+		}
+	}
+	if bytes, ok := parseBytes(os.TotalMemory); ok {
+		ch <- prometheus.MustNewConstMetric(osMemoryTotalDesc, prometheus.GaugeValue, bytes)
+	}
+	if bytes, ok := parseBytes(os.FreeMemory); ok {
+		ch <- prometheus.MustNewConstMetric(osMemoryFreeDesc, prometheus.GaugeValue, bytes)
+	}
+	for i, cpu := range os.Cpus {
+		id := strconv.Itoa(i)
+		if cpu.Speed != nil {
+			ch <- prometheus.MustNewConstMetric(osCPUSpeedDesc, prometheus.GaugeValue, *cpu.Speed, id)
+		}
+		if times := cpu.Times; times != nil {
+			for mode, ms := range map[string]*float64{"idle": times.Idle, "irq": times.Irq, "nice": times.Nice, "sys": times.Sys, "user": times.User} {
+				if ms != nil {
+					ch <- prometheus.MustNewConstMetric(osCPUSecondsDesc, prometheus.CounterValue, *ms/1000, id, mode)
+				}
+			}
+		}
+	}
+}
+
+// loadPeriods are the periods of the load averages reported by the console, in order.
+var loadPeriods = []string{"1m", "5m", "15m"}
+
+// sizeRegexp matches the sizes the console reports, e.g. "56.1 MB".
+var sizeRegexp = regexp.MustCompile(`^([0-9.]+)\s*([KMGT]?B)?$`)
+
+// sizeUnits are the multipliers of the units of sizeRegexp. The console counts in powers of 1024.
+var sizeUnits = map[string]float64{"": 1, "B": 1, "KB": 1 << 10, "MB": 1 << 20, "GB": 1 << 30, "TB": 1 << 40}
+
+// parseBytes converts a size reported by the console to bytes.
+func parseBytes(size *string) (float64, bool) {
+	if size == nil {
+		return 0, false
+	}
+	match := sizeRegexp.FindStringSubmatch(strings.ToUpper(strings.TrimSpace(*size)))
+	if match == nil {
+		return 0, false
+	}
+	value, err := strconv.ParseFloat(match[1], 64)
+	if err != nil {
+		return 0, false
+	}
+	return value * sizeUnits[match[2]], true
+}
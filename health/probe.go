@@ -0,0 +1,97 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/IBM-Blockchain/ibp-go-sdk/blockchainv3"
+	"github.com/IBM/go-sdk-core/v4/core"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// healthzResponse is the body of the /healthz endpoint of Fabric nodes and CAs.
+type healthzResponse struct {
+	Status       string `json:"status"`
+	FailedChecks []struct {
+		Component string `json:"component"`
+		Reason    string `json:"reason"`
+	} `json:"failed_checks"`
+}
+
+// probeAll probes the components that have an operations URL concurrently, and returns the probes in the order of
+// the components.
+func (monitor *Monitor) probeAll(ctx context.Context, components []blockchainv3.GenericComponentResponse) []*ComponentHealth {
+	client := monitor.Client
+	if client == nil {
+		client = &http.Client{Timeout: DefaultProbeTimeout}
+	}
+	probes := []*ComponentHealth{}
+	var wg sync.WaitGroup
+	for _, component := range components {
+		operationsURL := core.StringNilMapper(component.OperationsURL)
+		if operationsURL == "" {
+			continue
+		}
+		probe := &ComponentHealth{
+			ComponentID:   core.StringNilMapper(component.ID),
+			DisplayName:   core.StringNilMapper(component.DisplayName),
+			Type:          core.StringNilMapper(component.Type),
+			OperationsURL: operationsURL,
+		}
+		probes = append(probes, probe)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			probeHealthz(ctx, client, probe)
+		}()
+	}
+	wg.Wait()
+	return probes
+}
+
+// probeHealthz calls the /healthz endpoint of a component and records the result in probe.
+func probeHealthz(ctx context.Context, client *http.Client, probe *ComponentHealth) {
+	start := time.Now()
+	defer func() {
+		probe.Duration = time.Since(start)
+	}()
+	req, err := http.NewRequest(http.MethodGet, strings.TrimSuffix(probe.OperationsURL, "/")+"/healthz", nil)
+	if err != nil {
+		probe.Error = err.Error()
+		return
+	}
+	res, err := client.Do(req.WithContext(ctx))
+	if err != nil {
+		probe.Error = err.Error()
+		return
+	}
+	defer res.Body.Close()
+	probe.StatusCode = res.StatusCode
+	probe.Up = res.StatusCode == http.StatusOK
+
+	var body healthzResponse
+	if json.NewDecoder(res.Body).Decode(&body) == nil && len(body.FailedChecks) > 0 {
+		probe.FailedChecks = map[string]string{}
+		for _, check := range body.FailedChecks {
+			probe.FailedChecks[check.Component] = check.Reason
+		}
+	}
+}
@@ -0,0 +1,241 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package importer imports the component files exported by the IBP console into a console.
+package importer
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// The component types of export files.
+const (
+	TypeCA      = "fabric-ca"
+	TypePeer    = "fabric-peer"
+	TypeOrderer = "fabric-orderer"
+	TypeMSP     = "msp"
+)
+
+// File : A console export file: a JSON component definition, or a JSON array of them.
+type File struct {
+	// The path of the file. Files read from a zip bundle are named "<bundle>/<entry>".
+	Name string
+
+	Data []byte
+}
+
+// Component : A component definition read from an export file.
+type Component struct {
+	// The file the component was read from.
+	File string
+
+	// The position of the component in the file, for files holding an array of components.
+	Index int
+
+	// The detected component type, one of TypeCA, TypePeer, TypeOrderer and TypeMSP.
+	Type string
+
+	// The component ID given by the file, if any.
+	ID string
+
+	DisplayName string
+
+	// The component definition, with the fields of older exports renamed to the ones of the Import* APIs.
+	Definition map[string]json.RawMessage
+}
+
+// ReadPath reads the export files at a path: a JSON file, a zip bundle, or a directory holding JSON files and zip
+// bundles. The files of a directory are read in name order; subdirectories are not read.
+func ReadPath(name string) ([]File, error) {
+	info, err := os.Stat(name)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return readFile(name)
+	}
+
+	entries, err := ioutil.ReadDir(name)
+	if err != nil {
+		return nil, err
+	}
+	var files []File
+	for _, entry := range entries {
+		ext := strings.ToLower(filepath.Ext(entry.Name()))
+		if entry.IsDir() || (ext != ".json" && ext != ".zip") {
+			continue
+		}
+		read, err := readFile(filepath.Join(name, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, read...)
+	}
+	return files, nil
+}
+
+func readFile(name string) ([]File, error) {
+	data, err := ioutil.ReadFile(name)
+	if err != nil {
+		return nil, err
+	}
+	if strings.ToLower(filepath.Ext(name)) == ".zip" {
+		return ReadZip(name, data)
+	}
+	return []File{{Name: name, Data: data}}, nil
+}
+
+// ReadZip reads the JSON files of a zip bundle, in name order.
+func ReadZip(name string, data []byte) ([]File, error) {
+	reader, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, fmt.Errorf("could not read %s: %w", name, err)
+	}
+	var files []File
+	for _, entry := range reader.File {
+		if entry.FileInfo().IsDir() || strings.HasPrefix(entry.Name, "__MACOSX/") ||
+			strings.HasPrefix(path.Base(entry.Name), ".") || strings.ToLower(path.Ext(entry.Name)) != ".json" {
+			continue
+		}
+		rc, err := entry.Open()
+		if err != nil {
+			return nil, fmt.Errorf("could not read %s/%s: %w", name, entry.Name, err)
+		}
+		entryData, err := ioutil.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			return nil, fmt.Errorf("could not read %s/%s: %w", name, entry.Name, err)
+		}
+		files = append(files, File{Name: name + "/" + entry.Name, Data: entryData})
+	}
+	sort.Slice(files, func(i, j int) bool { return files[i].Name < files[j].Name })
+	return files, nil
+}
+
+// Parse reads the component definitions of an export file and detects their type.
+func Parse(file File) ([]*Component, error) {
+	var definitions []map[string]json.RawMessage
+	data := bytes.TrimSpace(file.Data)
+	if bytes.HasPrefix(data, []byte("[")) {
+		if err := json.Unmarshal(data, &definitions); err != nil {
+			return nil, fmt.Errorf("%s is not a component file: %w", file.Name, err)
+		}
+	} else {
+		var definition map[string]json.RawMessage
+		if err := json.Unmarshal(data, &definition); err != nil {
+			return nil, fmt.Errorf("%s is not a component file: %w", file.Name, err)
+		}
+		definitions = append(definitions, definition)
+	}
+
+	components := make([]*Component, 0, len(definitions))
+	for i, definition := range definitions {
+		componentType := DetectType(definition)
+		if componentType == "" {
+			return nil, fmt.Errorf("%s: could not detect the type of component %d", file.Name, i)
+		}
+		upgrade(componentType, definition)
+		components = append(components, &Component{
+			File:        file.Name,
+			Index:       i,
+			Type:        componentType,
+			ID:          stringField(definition, "id"),
+			DisplayName: stringField(definition, "display_name"),
+			Definition:  definition,
+		})
+	}
+	return components, nil
+}
+
+// DetectType returns the type of a component definition, from its "type" field or, for files that do not have one,
+// from the fields that only a type has. It returns "" if the type cannot be told.
+func DetectType(definition map[string]json.RawMessage) string {
+	switch strings.ToLower(stringField(definition, "type")) {
+	case "fabric-ca", "ca":
+		return TypeCA
+	case "fabric-peer", "peer":
+		return TypePeer
+	case "fabric-orderer", "orderer":
+		return TypeOrderer
+	case "msp":
+		return TypeMSP
+	}
+
+	switch {
+	case has(definition, "cluster_name") || has(definition, "cluster_id") || has(definition, "system_channel_id"):
+		return TypeOrderer
+	case has(definition, "grpcwp_url"):
+		return TypePeer
+	case has(definition, "ca_url") || has(definition, "ca_name"):
+		return TypeCA
+	case has(definition, "root_certs") && has(definition, "msp_id"):
+		return TypeMSP
+	case has(definition, "api_url") && has(definition, "msp"):
+		var msp map[string]json.RawMessage
+		if json.Unmarshal(definition["msp"], &msp) == nil && has(msp, "ca") && !has(definition, "msp_id") {
+			return TypeCA
+		}
+	}
+	return ""
+}
+
+// upgrade renames the fields of older exports to the ones of the Import* APIs: "ca_url" to "api_url", and the
+// "ca_name", "tlsca_name" and "tls_cert" (or "pem") of CAs to their "msp" field.
+func upgrade(componentType string, definition map[string]json.RawMessage) {
+	if componentType != TypeCA {
+		return
+	}
+	if !has(definition, "api_url") && has(definition, "ca_url") {
+		definition["api_url"] = definition["ca_url"]
+	}
+	if has(definition, "msp") {
+		return
+	}
+	tlsCert := stringField(definition, "tls_cert")
+	if tlsCert == "" {
+		tlsCert = stringField(definition, "pem")
+	}
+	msp := map[string]interface{}{
+		"ca":        map[string]interface{}{"name": stringField(definition, "ca_name")},
+		"tlsca":     map[string]interface{}{"name": stringField(definition, "tlsca_name")},
+		"component": map[string]interface{}{"tls_cert": tlsCert},
+	}
+	if data, err := json.Marshal(msp); err == nil {
+		definition["msp"] = data
+	}
+}
+
+func has(definition map[string]json.RawMessage, key string) bool {
+	value, ok := definition[key]
+	return ok && string(value) != "null"
+}
+
+func stringField(definition map[string]json.RawMessage, key string) string {
+	var value string
+	if raw, ok := definition[key]; ok {
+		_ = json.Unmarshal(raw, &value)
+	}
+	return value
+}
@@ -0,0 +1,248 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package importer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/IBM-Blockchain/ibp-go-sdk/blockchainv3"
+	"sync"
+)
+
+// DefaultConcurrency is how many components Import imports at once, unless Options.Concurrency says otherwise.
+const DefaultConcurrency = 4
+
+// Status : The outcome of importing a component.
+type Status string
+
+// The outcomes of importing a component.
+const (
+	StatusImported Status = "imported"
+	StatusSkipped  Status = "skipped"
+	StatusFailed   Status = "failed"
+)
+
+// Options : The Import options.
+type Options struct {
+	// How many components are imported at once. Defaults to DefaultConcurrency.
+	Concurrency int
+}
+
+// Result : The outcome of importing a component, or of reading a file that holds no valid component definition.
+type Result struct {
+	// The file the component was read from.
+	File string
+
+	// The position of the component in the file.
+	Index int
+
+	// The component type, or "" if the file could not be parsed.
+	Type string
+
+	DisplayName string
+
+	// The ID of the imported component, or of the existing component it was skipped for.
+	ID string
+
+	Status Status
+
+	// Why the component was skipped.
+	Reason string
+
+	// Why the component could not be imported.
+	Err error
+}
+
+// Import imports the components of export files into the console, DefaultConcurrency at a time. A component is
+// skipped if the console already has a component with its ID, or a component of its type with its display name, or if
+// an earlier file of the same import defines it.
+// The results are in file order. An error is only returned if the existing components could not be listed; a
+// component that could not be parsed or imported has a StatusFailed result.
+func Import(ctx context.Context, service blockchainv3.BlockchainV3API, files []File, options *Options) ([]*Result, error) {
+	concurrency := DefaultConcurrency
+	if options != nil && options.Concurrency > 0 {
+		concurrency = options.Concurrency
+	}
+	existing, _, err := service.ListComponentsWithContext(ctx, service.NewListComponentsOptions())
+	if err != nil {
+		return nil, fmt.Errorf("could not list the components of the console: %w", err)
+	}
+	known := newIndex()
+	for _, component := range existing.Components {
+		known.add(value(component.ID), value(component.Type), value(component.DisplayName), "the console")
+	}
+
+	var results []*Result
+	var pending []*Component
+	var pendingResults []*Result
+	for _, file := range files {
+		components, err := Parse(file)
+		if err != nil {
+			results = append(results, &Result{File: file.Name, Status: StatusFailed, Err: err})
+			continue
+		}
+		for _, component := range components {
+			result := &Result{
+				File:        component.File,
+				Index:       component.Index,
+				Type:        component.Type,
+				DisplayName: component.DisplayName,
+				ID:          component.ID,
+			}
+			results = append(results, result)
+			if id, reason := known.find(component); reason != "" {
+				result.Status, result.ID, result.Reason = StatusSkipped, id, reason
+				continue
+			}
+			known.add(component.ID, component.Type, component.DisplayName, component.File)
+			pending = append(pending, component)
+			pendingResults = append(pendingResults, result)
+		}
+	}
+
+	var wg sync.WaitGroup
+	slots := make(chan struct{}, concurrency)
+	for i := range pending {
+		wg.Add(1)
+		slots <- struct{}{}
+		go func(component *Component, result *Result) {
+			defer func() { <-slots; wg.Done() }()
+			id, err := importComponent(ctx, service, component)
+			if err != nil {
+				result.Status, result.Err = StatusFailed, err
+				return
+			}
+			result.Status, result.ID = StatusImported, id
+		}(pending[i], pendingResults[i])
+	}
+	wg.Wait()
+	return results, nil
+}
+
+// ImportPath reads the export files at a path with ReadPath and imports them.
+func ImportPath(ctx context.Context, service blockchainv3.BlockchainV3API, name string, options *Options) ([]*Result, error) {
+	files, err := ReadPath(name)
+	if err != nil {
+		return nil, err
+	}
+	return Import(ctx, service, files, options)
+}
+
+// importComponent calls the Import API of the component type and returns the ID of the imported component.
+func importComponent(ctx context.Context, service blockchainv3.BlockchainV3API, component *Component) (string, error) {
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+	switch component.Type {
+	case TypeCA:
+		options := new(blockchainv3.ImportCaOptions)
+		if err := decode(component, options); err != nil {
+			return "", err
+		}
+		result, _, err := service.ImportCaWithContext(ctx, options)
+		if err != nil {
+			return "", err
+		}
+		return value(result.ID), nil
+	case TypePeer:
+		options := new(blockchainv3.ImportPeerOptions)
+		if err := decode(component, options); err != nil {
+			return "", err
+		}
+		result, _, err := service.ImportPeerWithContext(ctx, options)
+		if err != nil {
+			return "", err
+		}
+		return value(result.ID), nil
+	case TypeOrderer:
+		options := new(blockchainv3.ImportOrdererOptions)
+		if err := decode(component, options); err != nil {
+			return "", err
+		}
+		result, _, err := service.ImportOrdererWithContext(ctx, options)
+		if err != nil {
+			return "", err
+		}
+		return value(result.ID), nil
+	case TypeMSP:
+		options := new(blockchainv3.ImportMspOptions)
+		if err := decode(component, options); err != nil {
+			return "", err
+		}
+		result, _, err := service.ImportMspWithContext(ctx, options)
+		if err != nil {
+			return "", err
+		}
+		return value(result.ID), nil
+	}
+	return "", fmt.Errorf("unknown component type %q", component.Type)
+}
+
+// decode maps a component definition onto the options of its Import API, whose fields have the same JSON names.
+func decode(component *Component, options interface{}) error {
+	data, err := json.Marshal(component.Definition)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(data, options); err != nil {
+		return fmt.Errorf("invalid %s definition: %w", component.Type, err)
+	}
+	return nil
+}
+
+// index finds the components that already exist, or that an earlier file defines.
+type index struct {
+	ids   map[string]string
+	names map[string]string
+	where map[string]string
+}
+
+func newIndex() *index {
+	return &index{ids: map[string]string{}, names: map[string]string{}, where: map[string]string{}}
+}
+
+func (i *index) add(id, componentType, displayName, where string) {
+	if id != "" {
+		i.ids[id] = id
+		i.where[id] = where
+	}
+	if displayName != "" {
+		key := componentType + "/" + displayName
+		i.names[key] = id
+		i.where[key] = where
+	}
+}
+
+// find returns the ID of the component a definition duplicates, and why it is a duplicate, or "" if it is not one.
+func (i *index) find(component *Component) (string, string) {
+	if id, ok := i.ids[component.ID]; ok && component.ID != "" {
+		return id, fmt.Sprintf("a component with ID %q is defined by %s", component.ID, i.where[id])
+	}
+	key := component.Type + "/" + component.DisplayName
+	if id, ok := i.names[key]; ok && component.DisplayName != "" {
+		return id, fmt.Sprintf("a %s named %q is defined by %s", component.Type, component.DisplayName, i.where[key])
+	}
+	return "", ""
+}
+
+func value(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
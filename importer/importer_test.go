@@ -0,0 +1,200 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package importer_test
+
+import (
+	"archive/zip"
+	"context"
+	"github.com/IBM-Blockchain/ibp-go-sdk/blockchainv3"
+	"github.com/IBM-Blockchain/ibp-go-sdk/blockchainv3fake"
+	"github.com/IBM-Blockchain/ibp-go-sdk/importer"
+	"github.com/IBM/go-sdk-core/v4/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+const caFile = `{
+	"display_name": "Org1 CA",
+	"type": "fabric-ca",
+	"api_url": "https://org1ca.example.com:7054",
+	"operations_url": "https://org1ca.example.com:9443",
+	"msp": {
+		"ca": {"name": "ca", "root_certs": ["cm9vdA=="]},
+		"tlsca": {"name": "tlsca", "root_certs": ["dGxzcm9vdA=="]},
+		"component": {"tls_cert": "dGxz"}
+	}
+}`
+
+const legacyCAFile = `{
+	"display_name": "Org2 CA",
+	"ca_url": "https://org2ca.example.com:7054",
+	"ca_name": "ca",
+	"tlsca_name": "tlsca",
+	"pem": "dGxz"
+}`
+
+const peerFile = `{
+	"id": "org1peer1",
+	"display_name": "Org1 Peer1",
+	"grpcwp_url": "https://org1peer1-proxy.example.com:8084",
+	"api_url": "grpcs://org1peer1.example.com:7051",
+	"msp_id": "org1msp",
+	"msp": {
+		"tlsca": {"root_certs": ["dGxzcm9vdA=="]},
+		"component": {"tls_cert": "dGxz"}
+	}
+}`
+
+const mspsFile = `[
+	{"type": "msp", "msp_id": "org1msp", "display_name": "Org1 MSP", "root_certs": ["cm9vdA=="], "admins": ["YWRtaW4="]},
+	{"msp_id": "ordermsp", "display_name": "Orderer MSP", "root_certs": ["cm9vdA=="]}
+]`
+
+const ordererFile = `{
+	"display_name": "Orderer_1",
+	"cluster_name": "Orderer",
+	"grpcwp_url": "https://orderer1-proxy.example.com:443",
+	"api_url": "grpcs://orderer1.example.com:7050",
+	"msp_id": "ordermsp",
+	"msp": {
+		"tlsca": {"root_certs": ["dGxzcm9vdA=="]},
+		"component": {"tls_cert": "dGxz"}
+	}
+}`
+
+var _ = Describe(`Importer`, func() {
+	var dir string
+	var console *blockchainv3fake.Console
+
+	write := func(name, data string) {
+		Expect(ioutil.WriteFile(filepath.Join(dir, name), []byte(data), 0644)).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "importer")
+		Expect(err).To(BeNil())
+		console = blockchainv3fake.NewConsole()
+	})
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	It(`Detects the component types`, func() {
+		for data, expected := range map[string]string{
+			caFile:       importer.TypeCA,
+			legacyCAFile: importer.TypeCA,
+			peerFile:     importer.TypePeer,
+			ordererFile:  importer.TypeOrderer,
+		} {
+			components, err := importer.Parse(importer.File{Name: "file.json", Data: []byte(data)})
+			Expect(err).To(BeNil())
+			Expect(components).To(HaveLen(1))
+			Expect(components[0].Type).To(Equal(expected))
+		}
+		components, err := importer.Parse(importer.File{Name: "msps.json", Data: []byte(mspsFile)})
+		Expect(err).To(BeNil())
+		Expect(components).To(HaveLen(2))
+		Expect(components[0].Type).To(Equal(importer.TypeMSP))
+		Expect(components[1].Type).To(Equal(importer.TypeMSP))
+		Expect(components[1].Index).To(Equal(1))
+
+		_, err = importer.Parse(importer.File{Name: "other.json", Data: []byte(`{"name": "x"}`)})
+		Expect(err).To(MatchError(ContainSubstring("could not detect the type")))
+	})
+	It(`Imports a directory of files and zip bundles`, func() {
+		write("ca.json", caFile)
+		write("legacy.json", legacyCAFile)
+		write("notes.txt", "not a component")
+		bundle, err := os.Create(filepath.Join(dir, "bundle.zip"))
+		Expect(err).To(BeNil())
+		archive := zip.NewWriter(bundle)
+		for name, data := range map[string]string{"peer.json": peerFile, "msps.json": mspsFile, "orderer.json": ordererFile} {
+			entry, err := archive.Create("export/" + name)
+			Expect(err).To(BeNil())
+			_, err = entry.Write([]byte(data))
+			Expect(err).To(BeNil())
+		}
+		Expect(archive.Close()).To(Succeed())
+		Expect(bundle.Close()).To(Succeed())
+
+		results, err := importer.ImportPath(context.Background(), console, dir, &importer.Options{Concurrency: 2})
+		Expect(err).To(BeNil())
+		Expect(results).To(HaveLen(6))
+		var files []string
+		for _, result := range results {
+			Expect(result.Err).To(BeNil())
+			Expect(result.Status).To(Equal(importer.StatusImported))
+			Expect(result.ID).ToNot(BeEmpty())
+			files = append(files, filepath.Base(result.File))
+		}
+		Expect(files).To(Equal([]string{"msps.json", "msps.json", "orderer.json", "peer.json", "ca.json", "legacy.json"}))
+
+		peer, _, err := console.GetComponent(console.NewGetComponentOptions("org1peer1"))
+		Expect(err).To(BeNil())
+		Expect(*peer.MspID).To(Equal("org1msp"))
+		ca, _, err := console.GetComponent(console.NewGetComponentOptions(results[5].ID))
+		Expect(err).To(BeNil())
+		Expect(*ca.ApiURL).To(Equal("https://org2ca.example.com:7054"))
+	})
+	It(`Skips components that already exist`, func() {
+		_, _, err := console.ImportCa(console.NewImportCaOptions("Org1 CA", "https://other.example.com:7054",
+			&blockchainv3.ImportCaBodyMsp{
+				Ca:        &blockchainv3.ImportCaBodyMspCa{Name: core.StringPtr("ca")},
+				Tlsca:     &blockchainv3.ImportCaBodyMspTlsca{Name: core.StringPtr("tlsca")},
+				Component: &blockchainv3.ImportCaBodyMspComponent{TlsCert: core.StringPtr("dGxz")},
+			}))
+		Expect(err).To(BeNil())
+		write("ca.json", caFile)
+		write("peer.json", peerFile)
+		write("peer_copy.json", peerFile)
+
+		results, err := importer.ImportPath(context.Background(), console, dir, nil)
+		Expect(err).To(BeNil())
+		Expect(results).To(HaveLen(3))
+		Expect(results[0].Status).To(Equal(importer.StatusSkipped))
+		Expect(results[0].Reason).To(ContainSubstring(`named "Org1 CA" is defined by the console`))
+		Expect(results[1].Status).To(Equal(importer.StatusImported))
+		Expect(results[2].Status).To(Equal(importer.StatusSkipped))
+		Expect(results[2].ID).To(Equal("org1peer1"))
+		Expect(results[2].Reason).To(ContainSubstring("peer.json"))
+
+		results, err = importer.ImportPath(context.Background(), console, dir, nil)
+		Expect(err).To(BeNil())
+		for _, result := range results {
+			Expect(result.Status).To(Equal(importer.StatusSkipped))
+		}
+	})
+	It(`Reports the files that could not be imported`, func() {
+		write("bad.json", `{"display_name": `)
+		write("invalid.json", `{"type": "fabric-peer", "display_name": "Peer"}`)
+		write("peer.json", peerFile)
+
+		results, err := importer.ImportPath(context.Background(), console, dir, nil)
+		Expect(err).To(BeNil())
+		Expect(results).To(HaveLen(3))
+		Expect(results[0].Status).To(Equal(importer.StatusFailed))
+		Expect(results[0].Err).To(MatchError(ContainSubstring("is not a component file")))
+		Expect(results[1].Status).To(Equal(importer.StatusFailed))
+		Expect(results[1].Type).To(Equal(importer.TypePeer))
+		Expect(results[1].Err).ToNot(BeNil())
+		Expect(results[2].Status).To(Equal(importer.StatusImported))
+	})
+})
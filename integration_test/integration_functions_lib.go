@@ -1,19 +1,15 @@
 package integration
 
 import (
-	"crypto/tls"
+	"context"
 	"encoding/base64"
-	"errors"
 	"github.com/IBM-Blockchain/ibp-go-sdk/blockchainv3"
 	"github.com/IBM/go-sdk-core/v4/core"
 	"github.com/hyperledger/fabric-ca/api"
 	"github.com/hyperledger/fabric-ca/lib"
 	catls "github.com/hyperledger/fabric-ca/lib/tls"
 	"log"
-	"net/http"
-	"net/url"
 	"os"
-	"strconv"
 	"strings"
 	"time"
 )
@@ -69,7 +65,8 @@ func CreateCA(service *blockchainv3.BlockchainV3, displayName, username, passwor
 	Logger.Println("**SUCCESS** - CA created")
 
 	// as a last step, we'll wait on the CA to come up before allowing anything else to happen
-	err = waitForCaToComeUp(*result.ApiURL)
+	Logger.Println("waiting for the CA to come up")
+	err = service.WaitForComponentReady(context.Background(), *result.ID, nil)
 	return *result.ID, *result.Msp.Component.TlsCert, *result.ApiURL, err
 }
 
@@ -197,53 +194,25 @@ func CreateOrImportMSP(tlsCert []byte, identity *lib.Identity, service *blockcha
 
 func CreateCryptoObject(apiUrl, enrollID, enrollSecret string, tlsCert []byte, identity *lib.Identity,
 	service *blockchainv3.BlockchainV3) (*blockchainv3.CryptoObject, error) {
-	caName := "ca"
-	tlsName := "tlsca"
 	caTlsCert := base64.StdEncoding.EncodeToString(tlsCert)
-
-	parsedUrl, err := url.Parse(apiUrl)
-	if err != nil {
-		Logger.Println("**ERROR** - problem parsing the api url while creating an instance of cryptoObjectEnrollmentsCa: ", err)
-		return nil, err
-	}
-	hostname := strings.Split(parsedUrl.Host, ":")[0]
-	port, err := strconv.ParseFloat(parsedUrl.Port(), 64)
-	if err != nil {
-		Logger.Println("**ERROR** - problem getting the port from the url. url: ", apiUrl)
-		return nil, err
-	}
-
-	// - create the arguments
-	cryptoObjectEnrollmentCa := &blockchainv3.CryptoObjectEnrollmentCa{
-		Host:         &hostname,
-		Port:         &port,
-		Name:         &caName,
-		TlsCert:      &caTlsCert,
-		EnrollID:     &enrollID,
-		EnrollSecret: &enrollSecret,
-	}
-
-	cryptoObjectEnrollmentTlsca := &blockchainv3.CryptoObjectEnrollmentTlsca{
-		Host:         &hostname,
-		Port:         &port,
-		Name:         &tlsName,
-		TlsCert:      &caTlsCert,
-		EnrollID:     &enrollID,
-		EnrollSecret: &enrollSecret,
-		CsrHosts:     nil,
+	ca := &blockchainv3.GenericComponentResponse{
+		ApiURL: &apiUrl,
+		Msp: &blockchainv3.GenericComponentResponseMsp{
+			Ca:        &blockchainv3.GenericComponentResponseMspCa{Name: core.StringPtr("ca")},
+			Tlsca:     &blockchainv3.GenericComponentResponseMspTlsca{Name: core.StringPtr("tlsca")},
+			Component: &blockchainv3.GenericComponentResponseMspComponent{TlsCert: &caTlsCert},
+		},
 	}
 
-	cryptoEnrollmentComponent := &blockchainv3.CryptoEnrollmentComponent{
-		Admincerts: []string{base64.StdEncoding.EncodeToString(identity.GetECert().Cert())}}
-
-	// enroll the crypto object
-	cryptoObjectEnrollment, err := service.NewCryptoObjectEnrollment(cryptoEnrollmentComponent, cryptoObjectEnrollmentCa, cryptoObjectEnrollmentTlsca)
+	cryptoObject, err := service.NewCryptoObjectBuilder().
+		FromCA(ca).
+		SetEnrollment(enrollID, enrollSecret).
+		AddAdminCertPEM(identity.GetECert().Cert()).
+		Build()
 	if err != nil {
-		Logger.Println("**ERROR** - problem enrolling the crypto object: ", err)
+		Logger.Println("**ERROR** - problem building the crypto object: ", err)
 		return nil, err
 	}
-
-	cryptoObject := &blockchainv3.CryptoObject{Enrollment: cryptoObjectEnrollment}
 	return cryptoObject, nil
 }
 
@@ -639,48 +608,6 @@ func ConstructImportCABodyMsp() {
 // Helper/Aux functions
 //----------------------------------------------------------------------------------------------
 
-func waitForCaToComeUp(apiUrl string) error {
-	Logger.Println("waiting for the CA to come up")
-	// first, set the tls config to allow unsafe responses - WARNING - DO NOT DO THIS IN PRODUCTION
-	http.DefaultTransport.(*http.Transport).TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
-
-	start := time.Now()
-
-	client := http.Client{Timeout: 5 * time.Second} // create a client to handle the Get requests - this allows us the timeout
-
-	deadline := start.Add(10 * 60 * time.Second) // ten minutes
-
-	withinDeadline := false // final check at the end
-
-	for !time.Now().After(deadline) { // make sure we're not past our deadline
-		Logger.Println("CA's cainfo polled ")
-		resp, err := client.Get(apiUrl + "/cainfo")
-		if err != nil {
-			if os.IsTimeout(err) {
-				time.Sleep(2 * time.Second) // let the polling loop rest a little
-				continue
-			} else {
-				Logger.Println("**ERROR** - problem reaching CA - Not a timeout: ", err)
-				return err
-			}
-		} else if resp.StatusCode != 200 {
-			Logger.Println("**ERROR** - problem received a status code other than 200 while polling the CA's cainfo: ", resp)
-			return err
-		} else {
-			elapsedTime := time.Since(start)
-			log.Printf("CA came up - elapsed time was %v: ", elapsedTime)
-			withinDeadline = true
-			break
-		}
-	}
-	if !withinDeadline {
-		Logger.Println("**ERROR** - problem - timed out waiting for the CA to come up. current wait time is seat at ", deadline)
-		err := errors.New("timed out waiting for the CA to come up. current wait time is seat at \", deadline")
-		return err
-	}
-	return nil
-}
-
 func removeIdentity(orgName string, enrollResp *lib.EnrollmentResponse) {
 	removeRequest := &api.RemoveIdentityRequest{
 		ID:    orgName,
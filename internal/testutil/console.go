@@ -0,0 +1,84 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package testutil holds the fixtures shared by the tests of the helper packages.
+package testutil
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/IBM-Blockchain/ibp-go-sdk/blockchainv3"
+	"github.com/IBM/go-sdk-core/v4/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+)
+
+// MockConsole answers requests with the canned JSON registered for "METHOD /path" and records every request body.
+// Requests without a registered response get a 404 error.
+type MockConsole struct {
+	Server    *httptest.Server
+	Responses map[string]string
+	Calls     []string
+	Bodies    map[string][]map[string]interface{}
+}
+
+// NewMockConsole starts a MockConsole. Close it when the test is done.
+func NewMockConsole() *MockConsole {
+	console := &MockConsole{Responses: map[string]string{}, Bodies: map[string][]map[string]interface{}{}}
+	console.Server = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		defer GinkgoRecover()
+		call := req.Method + " " + req.URL.Path
+		console.Calls = append(console.Calls, call)
+		if body, _ := ioutil.ReadAll(req.Body); len(body) > 0 {
+			var decoded map[string]interface{}
+			Expect(json.Unmarshal(body, &decoded)).To(Succeed())
+			console.Bodies[call] = append(console.Bodies[call], decoded)
+		}
+		res.Header().Set("Content-type", "application/json")
+		response, ok := console.Responses[call]
+		if !ok {
+			res.WriteHeader(404)
+			fmt.Fprint(res, `{"statusCode": 404, "msg": "not found"}`)
+			return
+		}
+		res.WriteHeader(200)
+		fmt.Fprint(res, response)
+	}))
+	return console
+}
+
+// URL returns the URL of the console.
+func (console *MockConsole) URL() string {
+	return console.Server.URL
+}
+
+// Service returns a BlockchainV3 client for the console.
+func (console *MockConsole) Service() *blockchainv3.BlockchainV3 {
+	service, err := blockchainv3.NewBlockchainV3(&blockchainv3.BlockchainV3Options{
+		URL:           console.Server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	Expect(err).To(BeNil())
+	return service
+}
+
+// Close shuts the console down.
+func (console *MockConsole) Close() {
+	console.Server.Close()
+}
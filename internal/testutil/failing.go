@@ -0,0 +1,94 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package testutil
+
+import (
+	"context"
+	"errors"
+	"github.com/IBM-Blockchain/ibp-go-sdk/blockchainv3"
+	"github.com/IBM-Blockchain/ibp-go-sdk/blockchainv3fake"
+	"github.com/IBM/go-sdk-core/v4/core"
+)
+
+// ErrConnectionRefused is the error of the calls a FailingConsole fails.
+var ErrConnectionRefused = errors.New("connection refused")
+
+// FailingConsole : A fake console that fails chosen operations with ErrConnectionRefused. Only the operations the tests
+// need to fail are wrapped: EditAdminCerts, GetHealth and DeleteSigTx.
+type FailingConsole struct {
+	*blockchainv3fake.Console
+
+	// The failing calls, keyed by operation name and then by component or collection ID; the "" ID fails every call.
+	failures map[string]map[string]bool
+}
+
+// NewFailingConsole wraps a fake console. No call fails until Fail is called.
+func NewFailingConsole(console *blockchainv3fake.Console) *FailingConsole {
+	return &FailingConsole{Console: console, failures: map[string]map[string]bool{}}
+}
+
+// Fail makes the calls of an operation (e.g. "EditAdminCerts") on the given IDs fail, or every call if no ID is given.
+func (console *FailingConsole) Fail(operation string, ids ...string) {
+	if console.failures[operation] == nil {
+		console.failures[operation] = map[string]bool{}
+	}
+	if len(ids) == 0 {
+		ids = []string{""}
+	}
+	for _, id := range ids {
+		console.failures[operation][id] = true
+	}
+}
+
+// Recover makes the calls of an operation on the given IDs succeed again, or every call if no ID is given.
+func (console *FailingConsole) Recover(operation string, ids ...string) {
+	if len(ids) == 0 {
+		delete(console.failures, operation)
+		return
+	}
+	for _, id := range ids {
+		delete(console.failures[operation], id)
+	}
+}
+
+func (console *FailingConsole) fails(operation, id string) bool {
+	return console.failures[operation][""] || console.failures[operation][id]
+}
+
+// EditAdminCertsWithContext : Edit admin certs on a component, unless Fail chose the call to fail
+func (console *FailingConsole) EditAdminCertsWithContext(ctx context.Context, options *blockchainv3.EditAdminCertsOptions) (*blockchainv3.EditAdminCertsResponse, *core.DetailedResponse, error) {
+	if console.fails("EditAdminCerts", *options.ID) {
+		return nil, nil, ErrConnectionRefused
+	}
+	return console.Console.EditAdminCertsWithContext(ctx, options)
+}
+
+// GetHealthWithContext : Get IBP console health stats, unless Fail chose the call to fail
+func (console *FailingConsole) GetHealthWithContext(ctx context.Context, options *blockchainv3.GetHealthOptions) (*blockchainv3.GetAthenaHealthStatsResponse, *core.DetailedResponse, error) {
+	if console.fails("GetHealth", "") {
+		return nil, nil, ErrConnectionRefused
+	}
+	return console.Console.GetHealthWithContext(ctx, options)
+}
+
+// DeleteSigTxWithContext : Delete a signature collection tx, unless Fail chose the call to fail
+func (console *FailingConsole) DeleteSigTxWithContext(ctx context.Context, options *blockchainv3.DeleteSigTxOptions) (*blockchainv3.DeleteSignatureCollectionResponse, *core.DetailedResponse, error) {
+	if console.fails("DeleteSigTx", *options.ID) {
+		return nil, nil, ErrConnectionRefused
+	}
+	return console.Console.DeleteSigTxWithContext(ctx, options)
+}
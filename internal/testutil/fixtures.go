@@ -0,0 +1,70 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package testutil
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"github.com/IBM-Blockchain/ibp-go-sdk/blockchainv3"
+	"github.com/IBM/go-sdk-core/v4/core"
+	. "github.com/onsi/gomega"
+	"math/big"
+	"time"
+)
+
+// CryptoObject returns a crypto object with placeholder keys and certificates, enough to create a node on a fake
+// console.
+func CryptoObject() *blockchainv3.CryptoObject {
+	return &blockchainv3.CryptoObject{
+		Msp: &blockchainv3.CryptoObjectMsp{
+			Component: &blockchainv3.MspCryptoComp{
+				Ekey:       core.StringPtr("ekey"),
+				Ecert:      core.StringPtr("ecert"),
+				TlsKey:     core.StringPtr("tlskey"),
+				TlsCert:    core.StringPtr("tlscert"),
+				AdminCerts: []string{"admin"},
+			},
+			Ca:    &blockchainv3.MspCryptoCa{RootCerts: []string{"root"}},
+			Tlsca: &blockchainv3.MspCryptoCa{RootCerts: []string{"tlsroot"}},
+		},
+	}
+}
+
+// SelfSignedCertPEM returns a PEM encoded self-signed certificate that is valid for the year before notAfter.
+func SelfSignedCertPEM(subject pkix.Name, serial int64, notAfter time.Time) []byte {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	Expect(err).To(BeNil())
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      subject,
+		NotBefore:    notAfter.AddDate(-1, 0, 0),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	Expect(err).To(BeNil())
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
+
+// SelfSignedCert returns the certificate of SelfSignedCertPEM base 64 encoded, the way the console stores certificates.
+func SelfSignedCert(subject pkix.Name, serial int64, notAfter time.Time) string {
+	return base64.StdEncoding.EncodeToString(SelfSignedCertPEM(subject, serial, notAfter))
+}
@@ -0,0 +1,385 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"github.com/IBM-Blockchain/ibp-go-sdk/blockchainv3"
+	"github.com/IBM/go-sdk-core/v4/core"
+)
+
+// ApplyOptions : Options for Apply.
+type ApplyOptions struct {
+	// Called with the ID of every CA, peer and orderer created by the plan, before any step that depends on it runs.
+	// Defaults to BlockchainV3.WaitForComponentReady with ReadyOptions.
+	WaitForReady func(ctx context.Context, componentID string) error
+
+	// The options used by the default WaitForReady.
+	ReadyOptions *blockchainv3.WaitForComponentReadyOptions
+}
+
+// StepResult : The outcome of a single applied step.
+type StepResult struct {
+	Step *Step
+
+	// The IDs of the components created or updated by the step.
+	ComponentIDs []string
+}
+
+// ApplyResult : The outcome of Apply.
+type ApplyResult struct {
+	// The steps that completed, in the order they were applied.
+	Steps []StepResult
+}
+
+type applier struct {
+	service blockchainv3.BlockchainV3API
+	options *ApplyOptions
+	cas     map[string]*blockchainv3.GenericComponentResponse
+	msps    map[string]*MSPSpec
+}
+
+// Apply runs the steps of the plan in order. It stops at the first failing step and returns the steps completed so far
+// along with the error, so that the plan can be recomputed and applied again once the problem is fixed.
+func Apply(ctx context.Context, service blockchainv3.BlockchainV3API, plan *Plan, options *ApplyOptions) (*ApplyResult, error) {
+	if options == nil {
+		options = new(ApplyOptions)
+	}
+	a := &applier{
+		service: service,
+		options: options,
+		cas:     map[string]*blockchainv3.GenericComponentResponse{},
+		msps:    map[string]*MSPSpec{},
+	}
+	result := new(ApplyResult)
+	for _, step := range plan.Steps {
+		ids, err := a.apply(ctx, step)
+		if err != nil {
+			return result, fmt.Errorf("%s %s: %w", step.Action, step.Key(), err)
+		}
+		result.Steps = append(result.Steps, StepResult{Step: step, ComponentIDs: ids})
+	}
+	return result, nil
+}
+
+func (a *applier) apply(ctx context.Context, step *Step) ([]string, error) {
+	switch spec := step.spec.(type) {
+	case *CASpec:
+		return a.applyCA(ctx, step, spec)
+	case *MSPSpec:
+		a.msps[spec.MspID] = spec
+		return a.applyMSP(ctx, step, spec)
+	case *OrderingServiceSpec:
+		return a.applyOrderingService(ctx, step, spec)
+	case *PeerSpec:
+		return a.applyPeer(ctx, step, spec)
+	}
+	return nil, fmt.Errorf("unknown step type %T", step.spec)
+}
+
+func (a *applier) applyCA(ctx context.Context, step *Step, spec *CASpec) ([]string, error) {
+	if step.Action != ActionCreate {
+		a.cas[spec.DisplayName] = &step.existing[0]
+		if step.Action == ActionNone {
+			return nil, nil
+		}
+		id := step.ComponentIDs[0]
+		if hasChange(step, "tags") {
+			editOptions := a.service.NewEditCaOptions(id)
+			editOptions.SetTags(spec.Tags)
+			if _, _, err := a.service.EditCaWithContext(ctx, editOptions); err != nil {
+				return nil, err
+			}
+		}
+		if hasDeploymentChange(step) {
+			updateOptions := a.service.NewUpdateCaOptions(id)
+			updateOptions.Version = optionalString(spec.Version)
+			updateOptions.Zone = optionalString(spec.Zone)
+			if spec.Resources != nil {
+				updateOptions.SetResources(&blockchainv3.UpdateCaBodyResources{Ca: spec.Resources.resourceObject()})
+			}
+			if _, _, err := a.service.UpdateCaWithContext(ctx, updateOptions); err != nil {
+				return nil, err
+			}
+		}
+		return []string{id}, nil
+	}
+
+	identity, err := a.service.NewConfigCARegistryIdentitiesItem(spec.AdminID, spec.AdminSecret, "client")
+	if err != nil {
+		return nil, err
+	}
+	all := "*"
+	identity.Attrs = &blockchainv3.IdentityAttrs{
+		HfRegistrarRoles:      &all,
+		HfRegistrarAttributes: &all,
+	}
+	registry, err := a.service.NewConfigCARegistry(-1, []blockchainv3.ConfigCARegistryIdentitiesItem{*identity})
+	if err != nil {
+		return nil, err
+	}
+	configCreate, err := a.service.NewConfigCACreate(registry)
+	if err != nil {
+		return nil, err
+	}
+	configOverride, err := a.service.NewCreateCaBodyConfigOverride(configCreate)
+	if err != nil {
+		return nil, err
+	}
+	createOptions := a.service.NewCreateCaOptions(spec.DisplayName, configOverride)
+	createOptions.Tags = spec.Tags
+	createOptions.Version = optionalString(spec.Version)
+	createOptions.Zone = optionalString(spec.Zone)
+	if spec.Resources != nil {
+		createOptions.SetResources(&blockchainv3.CreateCaBodyResources{Ca: spec.Resources.resourceObject()})
+	}
+	ca, _, err := a.service.CreateCaWithContext(ctx, createOptions)
+	if err != nil {
+		return nil, err
+	}
+	if err = a.waitForReady(ctx, *ca.ID); err != nil {
+		return nil, err
+	}
+	component, _, err := a.service.GetComponentWithContext(ctx, a.service.NewGetComponentOptions(*ca.ID))
+	if err != nil {
+		return nil, err
+	}
+	a.cas[spec.DisplayName] = component
+	return []string{*ca.ID}, nil
+}
+
+func (a *applier) applyMSP(ctx context.Context, step *Step, spec *MSPSpec) ([]string, error) {
+	if step.Action == ActionNone {
+		return nil, nil
+	}
+	rootCerts, tlsRootCerts := spec.RootCerts, spec.TlsRootCerts
+	if spec.CA != "" {
+		ca := a.cas[spec.CA]
+		if ca == nil {
+			return nil, fmt.Errorf("CA %q has not been applied", spec.CA)
+		}
+		rootCerts, tlsRootCerts = nil, nil
+		if msp := ca.Msp; msp != nil {
+			if msp.Ca != nil {
+				rootCerts = msp.Ca.RootCerts
+			}
+			if msp.Tlsca != nil {
+				tlsRootCerts = msp.Tlsca.RootCerts
+			}
+		}
+	}
+
+	if step.Action == ActionUpdate {
+		id := step.ComponentIDs[0]
+		editOptions := a.service.NewEditMspOptions(id)
+		if hasChange(step, "root_certs") {
+			editOptions.SetRootCerts(rootCerts)
+		}
+		if hasChange(step, "tls_root_certs") {
+			editOptions.SetTlsRootCerts(tlsRootCerts)
+		}
+		if hasChange(step, "admins") {
+			editOptions.SetAdmins(spec.Admins)
+		}
+		if _, _, err := a.service.EditMspWithContext(ctx, editOptions); err != nil {
+			return nil, err
+		}
+		return []string{id}, nil
+	}
+
+	importOptions := a.service.NewImportMspOptions(spec.MspID, spec.DisplayName, rootCerts)
+	importOptions.SetTlsRootCerts(tlsRootCerts)
+	importOptions.SetAdmins(spec.Admins)
+	msp, _, err := a.service.ImportMspWithContext(ctx, importOptions)
+	if err != nil {
+		return nil, err
+	}
+	return []string{*msp.ID}, nil
+}
+
+func (a *applier) applyOrderingService(ctx context.Context, step *Step, spec *OrderingServiceSpec) ([]string, error) {
+	switch step.Action {
+	case ActionNone:
+		return nil, nil
+	case ActionUpdate:
+		for _, id := range step.ComponentIDs {
+			if hasChange(step, "tags") {
+				editOptions := a.service.NewEditOrdererOptions(id)
+				editOptions.SetTags(spec.Tags)
+				if _, _, err := a.service.EditOrdererWithContext(ctx, editOptions); err != nil {
+					return nil, err
+				}
+			}
+			if hasDeploymentChange(step) {
+				updateOptions := a.service.NewUpdateOrdererOptions(id)
+				updateOptions.Version = optionalString(spec.Version)
+				if spec.Resources != nil {
+					updateOptions.SetResources(&blockchainv3.UpdateOrdererBodyResources{Orderer: spec.Resources.resourceObject()})
+				}
+				if _, _, err := a.service.UpdateOrdererWithContext(ctx, updateOptions); err != nil {
+					return nil, err
+				}
+			}
+		}
+		return step.ComponentIDs, nil
+	}
+
+	crypto := make([]blockchainv3.CryptoObject, spec.Nodes)
+	for i := range crypto {
+		object, err := a.cryptoObject(spec.CA, spec.MspID, spec.EnrollID, spec.EnrollSecret, nil)
+		if err != nil {
+			return nil, err
+		}
+		crypto[i] = *object
+	}
+	createOptions := a.service.NewCreateOrdererOptions(blockchainv3.CreateOrdererOptions_OrdererType_Raft, spec.MspID, spec.ClusterName, crypto)
+	createOptions.SetClusterName(spec.ClusterName)
+	createOptions.SystemChannelID = optionalString(spec.SystemChannelID)
+	createOptions.Tags = spec.Tags
+	createOptions.Version = optionalString(spec.Version)
+	if spec.Resources != nil {
+		createOptions.SetResources(&blockchainv3.CreateOrdererRaftBodyResources{Orderer: spec.Resources.resourceObject()})
+	}
+	result, _, err := a.service.CreateOrdererWithContext(ctx, createOptions)
+	if err != nil {
+		return nil, err
+	}
+	var ids []string
+	for _, node := range result.Created {
+		if err = a.waitForReady(ctx, *node.ID); err != nil {
+			return nil, err
+		}
+		ids = append(ids, *node.ID)
+	}
+	return ids, nil
+}
+
+func (a *applier) applyPeer(ctx context.Context, step *Step, spec *PeerSpec) ([]string, error) {
+	switch step.Action {
+	case ActionNone:
+		return nil, nil
+	case ActionUpdate:
+		id := step.ComponentIDs[0]
+		if hasChange(step, "tags") {
+			editOptions := a.service.NewEditPeerOptions(id)
+			editOptions.SetTags(spec.Tags)
+			if _, _, err := a.service.EditPeerWithContext(ctx, editOptions); err != nil {
+				return nil, err
+			}
+		}
+		if hasDeploymentChange(step) {
+			updateOptions := a.service.NewUpdatePeerOptions(id)
+			updateOptions.Version = optionalString(spec.Version)
+			updateOptions.Zone = optionalString(spec.Zone)
+			if spec.Resources != nil {
+				updateOptions.SetResources(&blockchainv3.PeerResources{Peer: spec.Resources.resourceObject()})
+			}
+			if _, _, err := a.service.UpdatePeerWithContext(ctx, updateOptions); err != nil {
+				return nil, err
+			}
+		}
+		return []string{id}, nil
+	}
+
+	crypto, err := a.cryptoObject(spec.CA, spec.MspID, spec.EnrollID, spec.EnrollSecret, spec.CsrHosts)
+	if err != nil {
+		return nil, err
+	}
+	createOptions := a.service.NewCreatePeerOptions(spec.MspID, spec.DisplayName, crypto)
+	createOptions.StateDb = optionalString(spec.StateDb)
+	createOptions.Tags = spec.Tags
+	createOptions.Version = optionalString(spec.Version)
+	createOptions.Zone = optionalString(spec.Zone)
+	if spec.Resources != nil {
+		createOptions.SetResources(&blockchainv3.PeerResources{Peer: spec.Resources.resourceObject()})
+	}
+	peer, _, err := a.service.CreatePeerWithContext(ctx, createOptions)
+	if err != nil {
+		return nil, err
+	}
+	if err = a.waitForReady(ctx, *peer.ID); err != nil {
+		return nil, err
+	}
+	return []string{*peer.ID}, nil
+}
+
+// cryptoObject builds the enrollment crypto of a peer or orderer from the named CA of the spec.
+// The admin certificates of the node are the admins of the MSP with the node's MSP ID.
+func (a *applier) cryptoObject(caName, mspID, enrollID, enrollSecret string, csrHosts []string) (*blockchainv3.CryptoObject, error) {
+	ca := a.cas[caName]
+	if ca == nil {
+		return nil, fmt.Errorf("CA %q has not been applied", caName)
+	}
+	builder := blockchainv3.NewCryptoObjectBuilderFor(a.service).
+		FromCA(ca).
+		SetEnrollment(enrollID, enrollSecret).
+		SetCsrHosts(csrHosts...)
+	if msp := a.msps[mspID]; msp != nil {
+		builder.AddAdminCerts(msp.Admins...)
+	}
+	return builder.Build()
+}
+
+func (a *applier) waitForReady(ctx context.Context, componentID string) error {
+	if a.options.WaitForReady == nil {
+		return a.service.WaitForComponentReady(ctx, componentID, a.options.ReadyOptions)
+	}
+	return a.options.WaitForReady(ctx, componentID)
+}
+
+// resourceObject converts the spec's resources to the model used by the create and update operations.
+func (resources *ResourceSpec) resourceObject() *blockchainv3.ResourceObject {
+	object := &blockchainv3.ResourceObject{
+		Requests: &blockchainv3.ResourceRequests{
+			Cpu:    optionalString(resources.Requests.Cpu),
+			Memory: optionalString(resources.Requests.Memory),
+		},
+	}
+	if resources.Limits != nil {
+		object.Limits = &blockchainv3.ResourceLimits{
+			Cpu:    optionalString(resources.Limits.Cpu),
+			Memory: optionalString(resources.Limits.Memory),
+		}
+	}
+	return object
+}
+
+func hasChange(step *Step, field string) bool {
+	for _, change := range step.Changes {
+		if change.Field == field {
+			return true
+		}
+	}
+	return false
+}
+
+func hasDeploymentChange(step *Step) bool {
+	for _, change := range step.Changes {
+		if change.Field != "tags" {
+			return true
+		}
+	}
+	return false
+}
+
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return core.StringPtr(s)
+}
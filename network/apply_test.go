@@ -0,0 +1,150 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package network_test
+
+import (
+	"context"
+	"errors"
+	"github.com/IBM-Blockchain/ibp-go-sdk/blockchainv3"
+	"github.com/IBM-Blockchain/ibp-go-sdk/internal/testutil"
+	"github.com/IBM-Blockchain/ibp-go-sdk/network"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"time"
+)
+
+var _ = Describe(`Apply`, func() {
+	var console *testutil.MockConsole
+	var spec *network.Spec
+
+	BeforeEach(func() {
+		console = testutil.NewMockConsole()
+		var err error
+		spec, err = network.ParseSpec([]byte(testSpecYAML))
+		Expect(err).To(BeNil())
+	})
+	AfterEach(func() {
+		console.Close()
+	})
+
+	It(`Creates every component in order and waits for created nodes`, func() {
+		console.Responses["GET /ak/api/v3/components"] = `{"components": []}`
+		console.Responses["POST /ak/api/v3/kubernetes/components/fabric-ca"] = `{"id": "org1ca"}`
+		console.Responses["GET /ak/api/v3/components/org1ca"] = `{"id": "org1ca", "type": "fabric-ca", "api_url": "https://ca.example.com:7054",
+			"msp": {"ca": {"name": "ca", "root_certs": ["cm9vdA=="]}, "tlsca": {"name": "tlsca", "root_certs": ["dGxz"]},
+			"component": {"tls_cert": "dGxzY2VydA=="}}}`
+		console.Responses["POST /ak/api/v3/components/msp"] = `{"id": "org1msp"}`
+		console.Responses["POST /ak/api/v3/kubernetes/components/fabric-orderer"] = `{"created": [{"id": "os1"}, {"id": "os2"}, {"id": "os3"}]}`
+		console.Responses["POST /ak/api/v3/kubernetes/components/fabric-peer"] = `{"id": "peer1"}`
+
+		service := console.Service()
+		plan, err := network.ComputePlan(context.Background(), service, spec)
+		Expect(err).To(BeNil())
+
+		var waited []string
+		result, err := network.Apply(context.Background(), service, plan, &network.ApplyOptions{
+			WaitForReady: func(ctx context.Context, componentID string) error {
+				waited = append(waited, componentID)
+				return nil
+			},
+		})
+		Expect(err).To(BeNil())
+		Expect(result.Steps).To(HaveLen(4))
+		Expect(result.Steps[2].ComponentIDs).To(Equal([]string{"os1", "os2", "os3"}))
+		Expect(waited).To(Equal([]string{"org1ca", "os1", "os2", "os3", "peer1"}))
+
+		msp := console.Bodies["POST /ak/api/v3/components/msp"][0]
+		Expect(msp["root_certs"]).To(Equal([]interface{}{"cm9vdA=="}))
+		Expect(msp["tls_root_certs"]).To(Equal([]interface{}{"dGxz"}))
+
+		peer := console.Bodies["POST /ak/api/v3/kubernetes/components/fabric-peer"][0]
+		enrollment := peer["crypto"].(map[string]interface{})["enrollment"].(map[string]interface{})
+		ca := enrollment["ca"].(map[string]interface{})
+		Expect(ca["host"]).To(Equal("ca.example.com"))
+		Expect(ca["port"]).To(Equal(7054.0))
+		Expect(ca["name"]).To(Equal("ca"))
+		Expect(ca["enroll_id"]).To(Equal("peer1"))
+		Expect(enrollment["tlsca"].(map[string]interface{})["name"]).To(Equal("tlsca"))
+		Expect(enrollment["component"].(map[string]interface{})["admincerts"]).To(Equal([]interface{}{"YWRtaW4="}))
+
+		orderer := console.Bodies["POST /ak/api/v3/kubernetes/components/fabric-orderer"][0]
+		Expect(orderer["orderer_type"]).To(Equal("raft"))
+		Expect(orderer["crypto"]).To(HaveLen(3))
+	})
+	It(`Only updates the fields that drifted`, func() {
+		console.Responses["GET /ak/api/v3/components"] = existingComponents
+		console.Responses["GET /ak/api/v3/components/msps/org1msp"] = `{"msps": [{"msp_id": "org1msp", "admins": ["YWRtaW4="]}]}`
+		console.Responses["POST /ak/api/v3/kubernetes/components/fabric-orderer"] = `{"created": [{"id": "os1"}]}`
+		console.Responses["PUT /ak/api/v3/kubernetes/components/fabric-peer/peer1"] = `{"id": "peer1"}`
+
+		service := console.Service()
+		plan, err := network.ComputePlan(context.Background(), service, spec)
+		Expect(err).To(BeNil())
+		_, err = network.Apply(context.Background(), service, plan, &network.ApplyOptions{
+			WaitForReady: func(ctx context.Context, componentID string) error { return nil },
+		})
+		Expect(err).To(BeNil())
+
+		Expect(console.Calls).ToNot(ContainElement("POST /ak/api/v3/kubernetes/components/fabric-ca"))
+		Expect(console.Calls).ToNot(ContainElement("PUT /ak/api/v3/components/fabric-peer/peer1"))
+		update := console.Bodies["PUT /ak/api/v3/kubernetes/components/fabric-peer/peer1"][0]
+		Expect(update["resources"]).To(Equal(map[string]interface{}{
+			"peer": map[string]interface{}{"requests": map[string]interface{}{"cpu": "200m", "memory": "400Mi"}},
+		}))
+	})
+	It(`Waits for created components with WaitForComponentReady by default`, func() {
+		console.Responses["GET /ak/api/v3/components"] = existingComponents
+		console.Responses["GET /ak/api/v3/components/msps/org1msp"] = `{"msps": [{"msp_id": "org1msp", "admins": ["YWRtaW4="]}]}`
+		console.Responses["POST /ak/api/v3/kubernetes/components/fabric-orderer"] = `{"created": [{"id": "os1"}]}`
+		console.Responses["GET /ak/api/v3/components/os1"] = `{"id": "os1", "type": "fabric-orderer"}`
+
+		service := console.Service()
+		plan, err := network.ComputePlan(context.Background(), service, spec)
+		Expect(err).To(BeNil())
+		_, err = network.Apply(context.Background(), service, plan, &network.ApplyOptions{
+			ReadyOptions: &blockchainv3.WaitForComponentReadyOptions{Timeout: 50 * time.Millisecond, InitialInterval: 10 * time.Millisecond},
+		})
+		var notReady *blockchainv3.ComponentNotReadyError
+		Expect(errors.As(err, &notReady)).To(BeTrue())
+		Expect(notReady.ComponentID).To(Equal("os1"))
+	})
+	It(`Stops at the first failing step`, func() {
+		console.Responses["GET /ak/api/v3/components"] = `{"components": []}`
+		service := console.Service()
+		plan, err := network.ComputePlan(context.Background(), service, spec)
+		Expect(err).To(BeNil())
+
+		result, err := network.Apply(context.Background(), service, plan, nil)
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(HavePrefix("create fabric-ca/Org1 CA: "))
+		Expect(result.Steps).To(BeEmpty())
+	})
+	It(`Fails the steps whose CA was not applied in the same run`, func() {
+		console.Responses["GET /ak/api/v3/components"] = `{"components": []}`
+		service := console.Service()
+		plan, err := network.ComputePlan(context.Background(), service, spec)
+		Expect(err).To(BeNil())
+		Expect(plan.Steps[0].Kind).To(Equal(network.KindCA))
+		plan.Steps = plan.Steps[1:]
+
+		result, err := network.Apply(context.Background(), service, plan, nil)
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(HaveSuffix(`CA "Org1 CA" has not been applied`))
+		Expect(result.Steps).To(BeEmpty())
+		Expect(console.Calls).ToNot(ContainElement("POST /ak/api/v3/components/msp"))
+	})
+})
@@ -0,0 +1,417 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"github.com/IBM-Blockchain/ibp-go-sdk/blockchainv3"
+	"github.com/IBM/go-sdk-core/v4/core"
+	"sort"
+	"strings"
+)
+
+// Kind : The type of a component, as reported by the console.
+type Kind string
+
+// Component types handled by a plan.
+const (
+	KindCA      Kind = "fabric-ca"
+	KindMSP     Kind = "msp"
+	KindOrderer Kind = "fabric-orderer"
+	KindPeer    Kind = "fabric-peer"
+)
+
+// Action : What applying a step does to the console.
+type Action string
+
+// Actions of a step.
+const (
+	ActionNone   Action = "none"
+	ActionCreate Action = "create"
+	ActionUpdate Action = "update"
+)
+
+// Change : A single field whose live value differs from the spec.
+type Change struct {
+	Field   string
+	Current string
+	Desired string
+}
+
+// Step : The work needed to bring one component of the spec in line with the console.
+type Step struct {
+	Kind Kind
+
+	// The display name of the component, or the cluster name of an ordering service.
+	Name string
+
+	Action Action
+
+	// The console IDs of the existing component (every node of an ordering service). Empty for ActionCreate.
+	ComponentIDs []string
+
+	// The fields to change. Only set for ActionUpdate.
+	Changes []Change
+
+	// The keys of the steps that must be applied before this one.
+	DependsOn []string
+
+	// The live component, when it already exists.
+	existing []blockchainv3.GenericComponentResponse
+
+	// One of *CASpec, *MSPSpec, *PeerSpec or *OrderingServiceSpec.
+	spec interface{}
+}
+
+// Key returns the identifier other steps use to depend on this one, e.g. "fabric-ca/Org1 CA".
+func (step *Step) Key() string {
+	return stepKey(step.Kind, step.Name)
+}
+
+func stepKey(kind Kind, name string) string {
+	return string(kind) + "/" + name
+}
+
+// Plan : The ordered steps that bring the console in line with a spec.
+// Steps are sorted so that every step comes after the steps it depends on.
+type Plan struct {
+	Steps []*Step
+}
+
+// HasChanges returns true if applying the plan would change the console.
+func (plan *Plan) HasChanges() bool {
+	for _, step := range plan.Steps {
+		if step.Action != ActionNone {
+			return true
+		}
+	}
+	return false
+}
+
+// String renders the plan for humans, one line per step and one indented line per change.
+func (plan *Plan) String() string {
+	var b strings.Builder
+	for _, step := range plan.Steps {
+		fmt.Fprintf(&b, "%-6s %s\n", step.Action, step.Key())
+		for _, change := range step.Changes {
+			fmt.Fprintf(&b, "         %s: %q -> %q\n", change.Field, change.Current, change.Desired)
+		}
+	}
+	return b.String()
+}
+
+// ComputePlan diffs the spec against the components of the console and returns the steps needed to reconcile them.
+// Components are matched by type and display name (cluster name for ordering services). Components of the console
+// that are not part of the spec are left alone.
+func ComputePlan(ctx context.Context, service blockchainv3.BlockchainV3API, spec *Spec) (*Plan, error) {
+	if err := spec.Validate(); err != nil {
+		return nil, err
+	}
+
+	listOptions := service.NewListComponentsOptions()
+	listOptions.SetDeploymentAttrs(blockchainv3.ListComponentsOptions_DeploymentAttrs_Included)
+	list, _, err := service.ListComponentsWithContext(ctx, listOptions)
+	if err != nil {
+		return nil, err
+	}
+	live := map[string][]blockchainv3.GenericComponentResponse{}
+	for _, component := range list.Components {
+		if component.Type == nil {
+			continue
+		}
+		name := component.DisplayName
+		if Kind(*component.Type) == KindOrderer {
+			name = component.ClusterName
+		}
+		if name == nil {
+			continue
+		}
+		key := stepKey(Kind(*component.Type), *name)
+		live[key] = append(live[key], component)
+	}
+
+	plan := new(Plan)
+	addStep := func(step *Step) {
+		step.existing = live[step.Key()]
+		for _, component := range step.existing {
+			step.ComponentIDs = append(step.ComponentIDs, *component.ID)
+		}
+		if len(step.existing) == 0 {
+			step.Action = ActionCreate
+		}
+		plan.Steps = append(plan.Steps, step)
+	}
+
+	mspNames := map[string]string{}
+	for i := range spec.MSPs {
+		mspNames[spec.MSPs[i].MspID] = spec.MSPs[i].DisplayName
+	}
+
+	for i := range spec.CAs {
+		ca := &spec.CAs[i]
+		step := &Step{Kind: KindCA, Name: ca.DisplayName, spec: ca}
+		addStep(step)
+		if step.Action != ActionCreate {
+			component := step.existing[0]
+			step.Changes = append(step.Changes, diffTags(component.Tags, ca.Tags)...)
+			step.Changes = append(step.Changes, diffDeployment(&component, ca.Version, ca.Zone, ca.Resources, caResources)...)
+		}
+	}
+	for i := range spec.MSPs {
+		msp := &spec.MSPs[i]
+		step := &Step{Kind: KindMSP, Name: msp.DisplayName, spec: msp}
+		if msp.CA != "" {
+			step.DependsOn = []string{stepKey(KindCA, msp.CA)}
+		}
+		addStep(step)
+		if step.Action != ActionCreate {
+			changes, err := diffMsp(ctx, service, msp)
+			if err != nil {
+				return nil, err
+			}
+			step.Changes = changes
+		}
+	}
+	for i := range spec.OrderingServices {
+		os := &spec.OrderingServices[i]
+		step := &Step{
+			Kind:      KindOrderer,
+			Name:      os.ClusterName,
+			DependsOn: []string{stepKey(KindCA, os.CA), stepKey(KindMSP, mspNames[os.MspID])},
+			spec:      os,
+		}
+		addStep(step)
+		if step.Action != ActionCreate {
+			for _, component := range step.existing {
+				changes := diffTags(component.Tags, os.Tags)
+				changes = append(changes, diffDeployment(&component, os.Version, "", os.Resources, ordererResources)...)
+				step.Changes = mergeChanges(step.Changes, changes)
+			}
+		}
+	}
+	for i := range spec.Peers {
+		peer := &spec.Peers[i]
+		step := &Step{
+			Kind:      KindPeer,
+			Name:      peer.DisplayName,
+			DependsOn: []string{stepKey(KindCA, peer.CA), stepKey(KindMSP, mspNames[peer.MspID])},
+			spec:      peer,
+		}
+		addStep(step)
+		if step.Action != ActionCreate {
+			component := step.existing[0]
+			step.Changes = append(step.Changes, diffTags(component.Tags, peer.Tags)...)
+			step.Changes = append(step.Changes, diffDeployment(&component, peer.Version, peer.Zone, peer.Resources, peerResources)...)
+		}
+	}
+
+	for _, step := range plan.Steps {
+		if step.Action == ActionCreate {
+			continue
+		}
+		if len(step.Changes) > 0 {
+			step.Action = ActionUpdate
+		} else {
+			step.Action = ActionNone
+		}
+	}
+
+	plan.Steps, err = sortSteps(plan.Steps)
+	if err != nil {
+		return nil, err
+	}
+	return plan, nil
+}
+
+// sortSteps orders steps so that each one follows its dependencies, keeping the spec order otherwise.
+func sortSteps(steps []*Step) ([]*Step, error) {
+	byKey := map[string]*Step{}
+	for _, step := range steps {
+		byKey[step.Key()] = step
+	}
+	sorted := make([]*Step, 0, len(steps))
+	state := map[string]int{} // 1 = visiting, 2 = done
+	var visit func(step *Step) error
+	visit = func(step *Step) error {
+		switch state[step.Key()] {
+		case 1:
+			return fmt.Errorf("dependency cycle at %s", step.Key())
+		case 2:
+			return nil
+		}
+		state[step.Key()] = 1
+		for _, dep := range step.DependsOn {
+			if depStep, ok := byKey[dep]; ok {
+				if err := visit(depStep); err != nil {
+					return err
+				}
+			}
+		}
+		state[step.Key()] = 2
+		sorted = append(sorted, step)
+		return nil
+	}
+	for _, step := range steps {
+		if err := visit(step); err != nil {
+			return nil, err
+		}
+	}
+	return sorted, nil
+}
+
+// resourcesOf picks the main container's resources out of a component's cached resources.
+type resourcesOf func(*blockchainv3.GenericComponentResponseResources) *blockchainv3.GenericResources
+
+func caResources(r *blockchainv3.GenericComponentResponseResources) *blockchainv3.GenericResources {
+	return r.Ca
+}
+
+func peerResources(r *blockchainv3.GenericComponentResponseResources) *blockchainv3.GenericResources {
+	return r.Peer
+}
+
+func ordererResources(r *blockchainv3.GenericComponentResponseResources) *blockchainv3.GenericResources {
+	return r.Orderer
+}
+
+func diffTags(current, desired []string) []Change {
+	if desired == nil {
+		return nil
+	}
+	currentSorted := append([]string{}, current...)
+	desiredSorted := append([]string{}, desired...)
+	sort.Strings(currentSorted)
+	sort.Strings(desiredSorted)
+	if strings.Join(currentSorted, ",") == strings.Join(desiredSorted, ",") {
+		return nil
+	}
+	return []Change{{Field: "tags", Current: strings.Join(current, ","), Desired: strings.Join(desired, ",")}}
+}
+
+// diffDeployment compares the cached deployment attributes of a component with the non-empty values of the spec.
+func diffDeployment(component *blockchainv3.GenericComponentResponse, version, zone string, resources *ResourceSpec, pick resourcesOf) (changes []Change) {
+	if version != "" && version != stringValue(component.Version) {
+		changes = append(changes, Change{Field: "version", Current: stringValue(component.Version), Desired: version})
+	}
+	if zone != "" && zone != stringValue(component.Zone) {
+		changes = append(changes, Change{Field: "zone", Current: stringValue(component.Zone), Desired: zone})
+	}
+	if resources == nil {
+		return
+	}
+	var live blockchainv3.GenericResources
+	if component.Resources != nil && pick(component.Resources) != nil {
+		live = *pick(component.Resources)
+	}
+	var requests blockchainv3.GenericResourcesRequests
+	if live.Requests != nil {
+		requests = *live.Requests
+	}
+	changes = append(changes, diffValue("resources.requests.cpu", requests.Cpu, resources.Requests.Cpu)...)
+	changes = append(changes, diffValue("resources.requests.memory", requests.Memory, resources.Requests.Memory)...)
+	if resources.Limits != nil {
+		var limits blockchainv3.GenericResourceLimits
+		if live.Limits != nil {
+			limits = *live.Limits
+		}
+		changes = append(changes, diffValue("resources.limits.cpu", limits.Cpu, resources.Limits.Cpu)...)
+		changes = append(changes, diffValue("resources.limits.memory", limits.Memory, resources.Limits.Memory)...)
+	}
+	return
+}
+
+func diffValue(field string, current *string, desired string) []Change {
+	if desired == "" || desired == stringValue(current) {
+		return nil
+	}
+	return []Change{{Field: field, Current: stringValue(current), Desired: desired}}
+}
+
+// diffMsp compares the certificates of an MSP with the spec. Root certificates are only compared when the spec gives
+// them explicitly, since certificates taken from a CA of the spec are not known until the plan is applied.
+func diffMsp(ctx context.Context, service blockchainv3.BlockchainV3API, msp *MSPSpec) ([]Change, error) {
+	live, err := getMspPublicData(ctx, service, msp.MspID)
+	if err != nil {
+		return nil, err
+	}
+	var changes []Change
+	if msp.CA == "" {
+		changes = append(changes, diffCerts("root_certs", live.RootCerts, msp.RootCerts)...)
+		changes = append(changes, diffCerts("tls_root_certs", live.TlsRootCerts, msp.TlsRootCerts)...)
+	}
+	changes = append(changes, diffCerts("admins", live.Admins, msp.Admins)...)
+	return changes, nil
+}
+
+func getMspPublicData(ctx context.Context, service blockchainv3.BlockchainV3API, mspID string) (*blockchainv3.MspPublicData, error) {
+	result, _, err := service.GetMspCertificateWithContext(ctx, service.NewGetMspCertificateOptions(mspID))
+	if err != nil {
+		return nil, err
+	}
+	if len(result.Msps) == 0 {
+		return &blockchainv3.MspPublicData{MspID: core.StringPtr(mspID)}, nil
+	}
+	return &result.Msps[0], nil
+}
+
+func diffCerts(field string, current, desired []string) []Change {
+	if desired == nil {
+		return nil
+	}
+	if len(current) == len(desired) {
+		same := true
+		for i := range current {
+			if current[i] != desired[i] {
+				same = false
+				break
+			}
+		}
+		if same {
+			return nil
+		}
+	}
+	return []Change{{
+		Field:   field,
+		Current: fmt.Sprintf("%d certificate(s)", len(current)),
+		Desired: fmt.Sprintf("%d certificate(s)", len(desired)),
+	}}
+}
+
+// mergeChanges adds the changes not already present, so that identical drift on every node is reported once.
+func mergeChanges(changes, more []Change) []Change {
+	for _, change := range more {
+		found := false
+		for _, existing := range changes {
+			if existing.Field == change.Field && existing.Desired == change.Desired {
+				found = true
+				break
+			}
+		}
+		if !found {
+			changes = append(changes, change)
+		}
+	}
+	return changes
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -0,0 +1,107 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package network_test
+
+import (
+	"context"
+	"github.com/IBM-Blockchain/ibp-go-sdk/blockchainv3fake"
+	"github.com/IBM-Blockchain/ibp-go-sdk/internal/testutil"
+	"github.com/IBM-Blockchain/ibp-go-sdk/network"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+const existingComponents = `{"components": [
+	{"id": "org1ca", "type": "fabric-ca", "display_name": "Org1 CA", "api_url": "https://ca.example.com:7054",
+	 "tags": ["org1"], "msp": {"ca": {"name": "ca", "root_certs": ["cm9vdA=="]}, "tlsca": {"name": "tlsca", "root_certs": ["dGxz"]},
+	 "component": {"tls_cert": "dGxzY2VydA=="}}},
+	{"id": "org1msp", "type": "msp", "display_name": "Org1 MSP", "msp_id": "org1msp"},
+	{"id": "peer1", "type": "fabric-peer", "display_name": "Peer1", "msp_id": "org1msp", "version": "2.2.1",
+	 "resources": {"peer": {"requests": {"cpu": "100m", "memory": "400Mi"}}}},
+	{"id": "unmanaged", "type": "fabric-peer", "display_name": "Someone else's peer"}
+]}`
+
+var _ = Describe(`ComputePlan`, func() {
+	var console *testutil.MockConsole
+	var spec *network.Spec
+
+	BeforeEach(func() {
+		console = testutil.NewMockConsole()
+		var err error
+		spec, err = network.ParseSpec([]byte(testSpecYAML))
+		Expect(err).To(BeNil())
+	})
+	AfterEach(func() {
+		console.Close()
+	})
+
+	It(`Plans to create every component of an empty console in dependency order`, func() {
+		console.Responses["GET /ak/api/v3/components"] = `{"components": []}`
+		plan, err := network.ComputePlan(context.Background(), console.Service(), spec)
+		Expect(err).To(BeNil())
+		Expect(plan.HasChanges()).To(BeTrue())
+
+		var keys []string
+		for _, step := range plan.Steps {
+			Expect(step.Action).To(Equal(network.ActionCreate))
+			keys = append(keys, step.Key())
+		}
+		Expect(keys).To(Equal([]string{
+			"fabric-ca/Org1 CA",
+			"msp/Org1 MSP",
+			"fabric-orderer/Ordering Service",
+			"fabric-peer/Peer1",
+		}))
+		Expect(console.Calls).To(Equal([]string{"GET /ak/api/v3/components"}))
+	})
+	It(`Plans against a fake console`, func() {
+		plan, err := network.ComputePlan(context.Background(), blockchainv3fake.NewConsole(), spec)
+		Expect(err).To(BeNil())
+		Expect(plan.Steps).To(HaveLen(4))
+		for _, step := range plan.Steps {
+			Expect(step.Action).To(Equal(network.ActionCreate))
+		}
+	})
+	It(`Diffs existing components and ignores components outside the spec`, func() {
+		console.Responses["GET /ak/api/v3/components"] = existingComponents
+		console.Responses["GET /ak/api/v3/components/msps/org1msp"] = `{"msps": [{"msp_id": "org1msp", "admins": ["YWRtaW4="]}]}`
+		plan, err := network.ComputePlan(context.Background(), console.Service(), spec)
+		Expect(err).To(BeNil())
+		Expect(plan.Steps).To(HaveLen(4))
+
+		byKey := map[string]*network.Step{}
+		for _, step := range plan.Steps {
+			byKey[step.Key()] = step
+		}
+		Expect(byKey["fabric-ca/Org1 CA"].Action).To(Equal(network.ActionNone))
+		Expect(byKey["fabric-ca/Org1 CA"].ComponentIDs).To(Equal([]string{"org1ca"}))
+		Expect(byKey["msp/Org1 MSP"].Action).To(Equal(network.ActionNone))
+		Expect(byKey["fabric-orderer/Ordering Service"].Action).To(Equal(network.ActionCreate))
+
+		peer := byKey["fabric-peer/Peer1"]
+		Expect(peer.Action).To(Equal(network.ActionUpdate))
+		Expect(peer.Changes).To(Equal([]network.Change{
+			{Field: "resources.requests.cpu", Current: "100m", Desired: "200m"},
+		}))
+		Expect(plan.String()).To(ContainSubstring(`update fabric-peer/Peer1`))
+		Expect(plan.String()).To(ContainSubstring(`resources.requests.cpu: "100m" -> "200m"`))
+	})
+	It(`Returns the console's error`, func() {
+		_, err := network.ComputePlan(context.Background(), console.Service(), spec)
+		Expect(err).ToNot(BeNil())
+	})
+})
@@ -0,0 +1,255 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package network : Declarative network specs that can be planned and applied against an IBP console
+package network
+
+import (
+	"fmt"
+	"gopkg.in/yaml.v2"
+	"io/ioutil"
+)
+
+// Spec : The desired state of an IBP console's components.
+// A spec is written in YAML (or JSON) and names every component by its display name. CAs, MSPs, peers and ordering
+// services refer to each other by those names, e.g. a peer names the CA it enrolls with.
+type Spec struct {
+	CAs []CASpec `json:"cas,omitempty" yaml:"cas,omitempty"`
+
+	MSPs []MSPSpec `json:"msps,omitempty" yaml:"msps,omitempty"`
+
+	Peers []PeerSpec `json:"peers,omitempty" yaml:"peers,omitempty"`
+
+	OrderingServices []OrderingServiceSpec `json:"ordering_services,omitempty" yaml:"ordering_services,omitempty"`
+}
+
+// CASpec : The desired state of a certificate authority.
+type CASpec struct {
+	// The display name of the CA. Must be unique among the CAs of the spec.
+	DisplayName string `json:"display_name" yaml:"display_name"`
+
+	// The enroll ID of the registrar identity created with the CA.
+	AdminID string `json:"admin_id" yaml:"admin_id"`
+
+	// The enroll secret of the registrar identity created with the CA.
+	AdminSecret string `json:"admin_secret" yaml:"admin_secret"`
+
+	Tags []string `json:"tags,omitempty" yaml:"tags,omitempty"`
+
+	// The Hyperledger Fabric version of the CA. Left to the console's default when empty.
+	Version string `json:"version,omitempty" yaml:"version,omitempty"`
+
+	// The Kubernetes zone of the CA.
+	Zone string `json:"zone,omitempty" yaml:"zone,omitempty"`
+
+	Resources *ResourceSpec `json:"resources,omitempty" yaml:"resources,omitempty"`
+}
+
+// MSPSpec : The desired state of an MSP definition.
+type MSPSpec struct {
+	// The display name of the MSP. Must be unique among the MSPs of the spec.
+	DisplayName string `json:"display_name" yaml:"display_name"`
+
+	MspID string `json:"msp_id" yaml:"msp_id"`
+
+	// The display name of the CA (from this spec) whose root and TLS root certificates make up the MSP.
+	CA string `json:"ca,omitempty" yaml:"ca,omitempty"`
+
+	// Base 64 encoded PEM root certificates. Only used when CA is empty.
+	RootCerts []string `json:"root_certs,omitempty" yaml:"root_certs,omitempty"`
+
+	// Base 64 encoded PEM TLS root certificates. Only used when CA is empty.
+	TlsRootCerts []string `json:"tls_root_certs,omitempty" yaml:"tls_root_certs,omitempty"`
+
+	// Base 64 encoded PEM certificates of the organization's administrators.
+	Admins []string `json:"admins,omitempty" yaml:"admins,omitempty"`
+}
+
+// PeerSpec : The desired state of a peer.
+type PeerSpec struct {
+	// The display name of the peer. Must be unique among the peers of the spec.
+	DisplayName string `json:"display_name" yaml:"display_name"`
+
+	// The MSP ID of the peer. An MSP with this ID must be part of the spec.
+	MspID string `json:"msp_id" yaml:"msp_id"`
+
+	// The display name of the CA (from this spec) the peer enrolls with.
+	CA string `json:"ca" yaml:"ca"`
+
+	EnrollID string `json:"enroll_id" yaml:"enroll_id"`
+
+	EnrollSecret string `json:"enroll_secret" yaml:"enroll_secret"`
+
+	// Additional hosts for the TLS certificate's CSR.
+	CsrHosts []string `json:"csr_hosts,omitempty" yaml:"csr_hosts,omitempty"`
+
+	// The type of ledger database, "couchdb" or "leveldb".
+	StateDb string `json:"state_db,omitempty" yaml:"state_db,omitempty"`
+
+	Tags []string `json:"tags,omitempty" yaml:"tags,omitempty"`
+
+	Version string `json:"version,omitempty" yaml:"version,omitempty"`
+
+	Zone string `json:"zone,omitempty" yaml:"zone,omitempty"`
+
+	// The resources of the peer container.
+	Resources *ResourceSpec `json:"resources,omitempty" yaml:"resources,omitempty"`
+}
+
+// OrderingServiceSpec : The desired state of a raft ordering service.
+type OrderingServiceSpec struct {
+	// The name of the ordering service. Must be unique among the ordering services of the spec.
+	ClusterName string `json:"cluster_name" yaml:"cluster_name"`
+
+	// The MSP ID of the ordering nodes. An MSP with this ID must be part of the spec.
+	MspID string `json:"msp_id" yaml:"msp_id"`
+
+	// The display name of the CA (from this spec) the ordering nodes enroll with.
+	CA string `json:"ca" yaml:"ca"`
+
+	EnrollID string `json:"enroll_id" yaml:"enroll_id"`
+
+	EnrollSecret string `json:"enroll_secret" yaml:"enroll_secret"`
+
+	// The number of raft nodes. Only used when the ordering service is created.
+	Nodes int `json:"nodes" yaml:"nodes"`
+
+	SystemChannelID string `json:"system_channel_id,omitempty" yaml:"system_channel_id,omitempty"`
+
+	Tags []string `json:"tags,omitempty" yaml:"tags,omitempty"`
+
+	Version string `json:"version,omitempty" yaml:"version,omitempty"`
+
+	// The resources of each orderer container.
+	Resources *ResourceSpec `json:"resources,omitempty" yaml:"resources,omitempty"`
+}
+
+// ResourceSpec : The Kubernetes resources of a component's main container.
+type ResourceSpec struct {
+	Requests ResourceValues `json:"requests" yaml:"requests"`
+
+	Limits *ResourceValues `json:"limits,omitempty" yaml:"limits,omitempty"`
+}
+
+// ResourceValues : Kubernetes CPU and memory quantities, e.g. "100m" and "256Mi".
+type ResourceValues struct {
+	Cpu string `json:"cpu,omitempty" yaml:"cpu,omitempty"`
+
+	Memory string `json:"memory,omitempty" yaml:"memory,omitempty"`
+}
+
+// LoadSpec reads and validates the spec in the YAML or JSON file at path.
+func LoadSpec(path string) (*Spec, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSpec(data)
+}
+
+// ParseSpec parses and validates a YAML or JSON spec.
+func ParseSpec(data []byte) (*Spec, error) {
+	spec := new(Spec)
+	if err := yaml.UnmarshalStrict(data, spec); err != nil {
+		return nil, fmt.Errorf("invalid network spec: %s", err.Error())
+	}
+	if err := spec.Validate(); err != nil {
+		return nil, err
+	}
+	return spec, nil
+}
+
+// Validate checks that every component is named, that names are unique per component type and that every reference
+// between components resolves.
+func (spec *Spec) Validate() error {
+	cas := map[string]bool{}
+	for _, ca := range spec.CAs {
+		if ca.DisplayName == "" {
+			return fmt.Errorf("every CA needs a display_name")
+		}
+		if cas[ca.DisplayName] {
+			return fmt.Errorf("duplicate CA %q", ca.DisplayName)
+		}
+		if ca.AdminID == "" || ca.AdminSecret == "" {
+			return fmt.Errorf("CA %q needs an admin_id and an admin_secret", ca.DisplayName)
+		}
+		cas[ca.DisplayName] = true
+	}
+
+	msps := map[string]bool{}
+	mspIDs := map[string]bool{}
+	for _, msp := range spec.MSPs {
+		if msp.DisplayName == "" || msp.MspID == "" {
+			return fmt.Errorf("every MSP needs a display_name and an msp_id")
+		}
+		if msps[msp.DisplayName] {
+			return fmt.Errorf("duplicate MSP %q", msp.DisplayName)
+		}
+		if msp.CA != "" && !cas[msp.CA] {
+			return fmt.Errorf("MSP %q refers to unknown CA %q", msp.DisplayName, msp.CA)
+		}
+		if msp.CA == "" && len(msp.RootCerts) == 0 {
+			return fmt.Errorf("MSP %q needs either a ca or root_certs", msp.DisplayName)
+		}
+		msps[msp.DisplayName] = true
+		mspIDs[msp.MspID] = true
+	}
+
+	peers := map[string]bool{}
+	for _, peer := range spec.Peers {
+		if peer.DisplayName == "" {
+			return fmt.Errorf("every peer needs a display_name")
+		}
+		if peers[peer.DisplayName] {
+			return fmt.Errorf("duplicate peer %q", peer.DisplayName)
+		}
+		if err := validateEnrollment("peer", peer.DisplayName, peer.MspID, peer.CA, peer.EnrollID, peer.EnrollSecret, cas, mspIDs); err != nil {
+			return err
+		}
+		peers[peer.DisplayName] = true
+	}
+
+	clusters := map[string]bool{}
+	for _, os := range spec.OrderingServices {
+		if os.ClusterName == "" {
+			return fmt.Errorf("every ordering service needs a cluster_name")
+		}
+		if clusters[os.ClusterName] {
+			return fmt.Errorf("duplicate ordering service %q", os.ClusterName)
+		}
+		if err := validateEnrollment("ordering service", os.ClusterName, os.MspID, os.CA, os.EnrollID, os.EnrollSecret, cas, mspIDs); err != nil {
+			return err
+		}
+		if os.Nodes < 1 {
+			return fmt.Errorf("ordering service %q needs at least one node", os.ClusterName)
+		}
+		clusters[os.ClusterName] = true
+	}
+	return nil
+}
+
+func validateEnrollment(kind, name, mspID, ca, enrollID, enrollSecret string, cas, mspIDs map[string]bool) error {
+	if !mspIDs[mspID] {
+		return fmt.Errorf("%s %q refers to unknown msp_id %q", kind, name, mspID)
+	}
+	if !cas[ca] {
+		return fmt.Errorf("%s %q refers to unknown CA %q", kind, name, ca)
+	}
+	if enrollID == "" || enrollSecret == "" {
+		return fmt.Errorf("%s %q needs an enroll_id and an enroll_secret", kind, name)
+	}
+	return nil
+}
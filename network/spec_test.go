@@ -0,0 +1,119 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package network_test
+
+import (
+	"github.com/IBM-Blockchain/ibp-go-sdk/network"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+const testSpecYAML = `
+cas:
+  - display_name: Org1 CA
+    admin_id: admin
+    admin_secret: adminpw
+    tags: [org1]
+msps:
+  - display_name: Org1 MSP
+    msp_id: org1msp
+    ca: Org1 CA
+    admins: [YWRtaW4=]
+ordering_services:
+  - cluster_name: Ordering Service
+    msp_id: org1msp
+    ca: Org1 CA
+    enroll_id: orderer
+    enroll_secret: ordererpw
+    nodes: 3
+peers:
+  - display_name: Peer1
+    msp_id: org1msp
+    ca: Org1 CA
+    enroll_id: peer1
+    enroll_secret: peer1pw
+    version: 2.2.1
+    resources:
+      requests:
+        cpu: 200m
+        memory: 400Mi
+`
+
+var _ = Describe(`Spec`, func() {
+	It(`Parses a YAML spec`, func() {
+		spec, err := network.ParseSpec([]byte(testSpecYAML))
+		Expect(err).To(BeNil())
+		Expect(spec.CAs).To(HaveLen(1))
+		Expect(spec.CAs[0].Tags).To(Equal([]string{"org1"}))
+		Expect(spec.MSPs[0].CA).To(Equal("Org1 CA"))
+		Expect(spec.OrderingServices[0].Nodes).To(Equal(3))
+		Expect(spec.Peers[0].Resources.Requests.Cpu).To(Equal("200m"))
+	})
+	It(`Parses a JSON spec from a file`, func() {
+		dir, err := ioutil.TempDir("", "network")
+		Expect(err).To(BeNil())
+		defer os.RemoveAll(dir)
+		path := filepath.Join(dir, "spec.json")
+		json := `{"msps": [{"display_name": "Org2 MSP", "msp_id": "org2msp", "root_certs": ["cm9vdA=="]}]}`
+		Expect(ioutil.WriteFile(path, []byte(json), 0600)).To(Succeed())
+
+		spec, err := network.LoadSpec(path)
+		Expect(err).To(BeNil())
+		Expect(spec.MSPs[0].RootCerts).To(Equal([]string{"cm9vdA=="}))
+	})
+	It(`Rejects unknown fields`, func() {
+		_, err := network.ParseSpec([]byte(`cas: [{display_name: ca, admin_id: a, admin_secret: b, colour: red}]`))
+		Expect(err).ToNot(BeNil())
+	})
+	It(`Rejects duplicate names`, func() {
+		_, err := network.ParseSpec([]byte(`
+cas:
+  - {display_name: ca, admin_id: a, admin_secret: b}
+  - {display_name: ca, admin_id: a, admin_secret: b}
+`))
+		Expect(err).To(MatchError(`duplicate CA "ca"`))
+	})
+	It(`Rejects references to unknown components`, func() {
+		_, err := network.ParseSpec([]byte(`
+msps:
+  - {display_name: msp, msp_id: org1msp, ca: missing}
+`))
+		Expect(err).To(MatchError(`MSP "msp" refers to unknown CA "missing"`))
+
+		_, err = network.ParseSpec([]byte(`
+cas:
+  - {display_name: ca, admin_id: a, admin_secret: b}
+peers:
+  - {display_name: peer, msp_id: org9msp, ca: ca, enroll_id: a, enroll_secret: b}
+`))
+		Expect(err).To(MatchError(`peer "peer" refers to unknown msp_id "org9msp"`))
+	})
+	It(`Rejects an ordering service without nodes`, func() {
+		_, err := network.ParseSpec([]byte(`
+cas:
+  - {display_name: ca, admin_id: a, admin_secret: b}
+msps:
+  - {display_name: msp, msp_id: org1msp, ca: ca}
+ordering_services:
+  - {cluster_name: os, msp_id: org1msp, ca: ca, enroll_id: a, enroll_secret: b}
+`))
+		Expect(err).To(MatchError(`ordering service "os" needs at least one node`))
+	})
+})
@@ -0,0 +1,228 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package ordering appends ordering nodes to the raft cluster of an ordering service.
+package ordering
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"github.com/IBM-Blockchain/ibp-go-sdk/blockchainv3"
+	"github.com/golang/protobuf/proto"
+	cb "github.com/hyperledger/fabric-protos-go/common"
+	"github.com/hyperledger/fabric-protos-go/orderer/etcdraft"
+	"net/url"
+	"strconv"
+)
+
+// ConfigSubmitter sends the signed config update of the system channel to the ordering service, e.g. by signing it with
+// NewConfigUpdateTx and broadcasting the transaction, and returns the config block that the update produced.
+type ConfigSubmitter func(ctx context.Context, channelID string, configUpdate *cb.ConfigUpdateEnvelope) (*cb.Block, error)
+
+// AppendRaftNodeOptions : The AppendRaftNode options.
+type AppendRaftNodeOptions struct {
+	// The options that pre-create the node. ClusterID must be the ID of the cluster the node joins and a single node
+	// must be requested. Ignored if NodeID is set.
+	CreateOptions *blockchainv3.CreateOrdererOptions
+
+	// The ID of a node pre-created by an earlier, interrupted run.
+	NodeID string
+
+	// The latest config block of the system channel, a marshaled cb.Block. If it already lists the node as a consenter
+	// the config update is skipped and the block is submitted to the node as is.
+	ConfigBlock []byte
+
+	// Collects the admin signatures of the config update.
+	CollectSignatures SignatureCollector
+
+	// Submits the config update and returns the resulting config block.
+	SubmitConfigUpdate ConfigSubmitter
+}
+
+// AppendRaftNodeResult : The outcome of AppendRaftNode.
+type AppendRaftNodeResult struct {
+	// The ID of the appended node.
+	NodeID string
+
+	// The consenter that was added to the system channel.
+	Consenter *etcdraft.Consenter
+
+	// The config update that added the consenter. Nil if the config block already listed the node.
+	ConfigUpdate *cb.ConfigUpdate
+
+	// The config block that was submitted to the node.
+	ConfigBlock *cb.Block
+
+	// The node as returned by the Submit config block API.
+	Node *blockchainv3.GenericComponentResponse
+}
+
+// node holds what making a consenter out of a pre-created node requires.
+type node struct {
+	id      string
+	mspID   string
+	apiURL  string
+	tlsCert string
+}
+
+// AppendRaftNode appends an ordering node to a raft cluster:
+// - it pre-creates the node with the Create an ordering service API,
+// - computes the config update that adds the node as a consenter of the system channel, from the given config block,
+// - collects the admin signatures of the update and submits it,
+// - and submits the resulting config block to the node with the Submit config block API.
+// The result holds the node ID as soon as the node exists, so that a failed run can be resumed with NodeID set.
+func AppendRaftNode(ctx context.Context, service blockchainv3.BlockchainV3API, options *AppendRaftNodeOptions) (*AppendRaftNodeResult, error) {
+	if len(options.ConfigBlock) == 0 {
+		return nil, errors.New("ConfigBlock is required")
+	}
+	if options.CollectSignatures == nil || options.SubmitConfigUpdate == nil {
+		return nil, errors.New("CollectSignatures and SubmitConfigUpdate are required")
+	}
+	block := new(cb.Block)
+	if err := proto.Unmarshal(options.ConfigBlock, block); err != nil {
+		return nil, fmt.Errorf("could not unmarshal the config block: %w", err)
+	}
+	channelID, config, err := ConfigFromBlock(block)
+	if err != nil {
+		return nil, err
+	}
+
+	n, err := preCreateNode(ctx, service, options)
+	if err != nil {
+		return nil, err
+	}
+	result := &AppendRaftNodeResult{NodeID: n.id}
+	if result.Consenter, err = n.consenter(); err != nil {
+		return result, err
+	}
+
+	exists, err := HasConsenter(config, result.Consenter)
+	if err != nil {
+		return result, err
+	}
+	if !exists {
+		updated, err := AddConsenter(config, result.Consenter, n.mspID)
+		if err != nil {
+			return result, err
+		}
+		if result.ConfigUpdate, err = ComputeConfigUpdate(channelID, config, updated); err != nil {
+			return result, err
+		}
+		configUpdate, err := proto.Marshal(result.ConfigUpdate)
+		if err != nil {
+			return result, err
+		}
+		signatures, err := options.CollectSignatures(ctx, configUpdate)
+		if err != nil {
+			return result, fmt.Errorf("could not collect the signatures of the config update: %w", err)
+		}
+		envelope := &cb.ConfigUpdateEnvelope{ConfigUpdate: configUpdate, Signatures: signatures}
+		if block, err = options.SubmitConfigUpdate(ctx, channelID, envelope); err != nil {
+			return result, fmt.Errorf("could not submit the config update: %w", err)
+		}
+		if _, config, err = ConfigFromBlock(block); err != nil {
+			return result, err
+		}
+		if exists, err = HasConsenter(config, result.Consenter); err != nil {
+			return result, err
+		} else if !exists {
+			return result, errors.New("the config block returned by SubmitConfigUpdate does not list the node as a consenter")
+		}
+	}
+	result.ConfigBlock = block
+
+	marshaled, err := proto.Marshal(block)
+	if err != nil {
+		return result, err
+	}
+	submitOptions := service.NewSubmitBlockOptions(n.id)
+	submitOptions.SetB64Block(base64.StdEncoding.EncodeToString(marshaled))
+	if result.Node, _, err = service.SubmitBlockWithContext(ctx, submitOptions); err != nil {
+		return result, err
+	}
+	return result, nil
+}
+
+// preCreateNode pre-creates the node, or fetches the node pre-created by an earlier run.
+func preCreateNode(ctx context.Context, service blockchainv3.BlockchainV3API, options *AppendRaftNodeOptions) (*node, error) {
+	if options.NodeID != "" {
+		component, _, err := service.GetComponentWithContext(ctx, service.NewGetComponentOptions(options.NodeID))
+		if err != nil {
+			return nil, err
+		}
+		n := &node{id: options.NodeID, mspID: stringValue(component.MspID), apiURL: stringValue(component.ApiURL)}
+		if component.Msp != nil && component.Msp.Component != nil {
+			n.tlsCert = stringValue(component.Msp.Component.TlsCert)
+		}
+		return n, nil
+	}
+
+	createOptions := options.CreateOptions
+	if createOptions == nil || createOptions.ClusterID == nil || *createOptions.ClusterID == "" {
+		return nil, errors.New("CreateOptions with a ClusterID are required to pre-create the node")
+	}
+	if len(createOptions.Crypto) != 1 {
+		return nil, fmt.Errorf("exactly one node must be pre-created, not %d", len(createOptions.Crypto))
+	}
+	created, _, err := service.CreateOrdererWithContext(ctx, createOptions)
+	if err != nil {
+		return nil, err
+	}
+	if len(created.Created) != 1 {
+		return nil, fmt.Errorf("the console created %d nodes instead of one", len(created.Created))
+	}
+	orderer := created.Created[0]
+	n := &node{id: stringValue(orderer.ID), mspID: stringValue(orderer.MspID), apiURL: stringValue(orderer.ApiURL)}
+	if orderer.Msp != nil && orderer.Msp.Component != nil {
+		n.tlsCert = stringValue(orderer.Msp.Component.TlsCert)
+	}
+	return n, nil
+}
+
+// consenter returns the raft consenter of the node. The node's TLS certificate authenticates it both as a client and
+// as a server of the cluster.
+func (n *node) consenter() (*etcdraft.Consenter, error) {
+	apiURL, err := url.Parse(n.apiURL)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse the node's API URL: %w", err)
+	}
+	port, err := strconv.ParseUint(apiURL.Port(), 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("the node has no port in its API URL %q", n.apiURL)
+	}
+	if n.tlsCert == "" {
+		return nil, errors.New("the console has no TLS certificate for the node")
+	}
+	tlsCert, err := base64.StdEncoding.DecodeString(n.tlsCert)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode the node's TLS certificate: %w", err)
+	}
+	return &etcdraft.Consenter{
+		Host:          apiURL.Hostname(),
+		Port:          uint32(port),
+		ClientTlsCert: tlsCert,
+		ServerTlsCert: tlsCert,
+	}, nil
+}
+
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
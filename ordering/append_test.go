@@ -0,0 +1,138 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ordering_test
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"github.com/IBM-Blockchain/ibp-go-sdk/blockchainv3"
+	"github.com/IBM-Blockchain/ibp-go-sdk/internal/testutil"
+	"github.com/IBM-Blockchain/ibp-go-sdk/ordering"
+	"github.com/golang/protobuf/proto"
+	cb "github.com/hyperledger/fabric-protos-go/common"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`AppendRaftNode`, func() {
+	var console *testutil.MockConsole
+	var options *ordering.AppendRaftNodeOptions
+	var submitted *cb.ConfigUpdateEnvelope
+	var signed []byte
+
+	node := fmt.Sprintf(`{"id": "os4", "msp_id": "osmsp", "api_url": "grpcs://os4.example.com:7050",
+		"msp": {"component": {"tls_cert": "%s"}}}`, base64.StdEncoding.EncodeToString([]byte("os4")))
+
+	BeforeEach(func() {
+		console = testutil.NewMockConsole()
+		console.Responses["POST /ak/api/v3/kubernetes/components/fabric-orderer"] = `{"created": [` + node + `]}`
+		console.Responses["PUT /ak/api/v3/kubernetes/components/os4/config"] = `{"id": "os4", "type": "fabric-orderer"}`
+		submitted, signed = nil, nil
+
+		service := console.Service()
+		crypto := []blockchainv3.CryptoObject{*testutil.CryptoObject()}
+		createOptions := service.NewCreateOrdererOptions(blockchainv3.CreateOrdererOptions_OrdererType_Raft, "osmsp", "OS", crypto)
+		createOptions.SetClusterID("cluster1")
+		options = &ordering.AppendRaftNodeOptions{
+			CreateOptions: createOptions,
+			ConfigBlock:   marshal(configBlock("testchainid", testConfig())),
+			CollectSignatures: func(ctx context.Context, configUpdate []byte) ([]*cb.ConfigSignature, error) {
+				signed = configUpdate
+				return []*cb.ConfigSignature{{Signature: []byte("admin")}}, nil
+			},
+			SubmitConfigUpdate: func(ctx context.Context, channelID string, configUpdate *cb.ConfigUpdateEnvelope) (*cb.Block, error) {
+				Expect(channelID).To(Equal("testchainid"))
+				submitted = configUpdate
+				updated, err := ordering.AddConsenter(testConfig(), newConsenter, "osmsp")
+				Expect(err).To(BeNil())
+				return configBlock("testchainid", updated), nil
+			},
+		}
+	})
+	AfterEach(func() {
+		console.Close()
+	})
+
+	It(`Pre-creates the node, adds it as a consenter and submits the new config block`, func() {
+		result, err := ordering.AppendRaftNode(context.Background(), console.Service(), options)
+		Expect(err).To(BeNil())
+		Expect(result.NodeID).To(Equal("os4"))
+		Expect(proto.Equal(result.Consenter, newConsenter)).To(BeTrue())
+		Expect(*result.Node.ID).To(Equal("os4"))
+		Expect(console.Calls).To(Equal([]string{
+			"POST /ak/api/v3/kubernetes/components/fabric-orderer",
+			"PUT /ak/api/v3/kubernetes/components/os4/config",
+		}))
+		Expect(console.Bodies["POST /ak/api/v3/kubernetes/components/fabric-orderer"][0]["cluster_id"]).To(Equal("cluster1"))
+
+		Expect(submitted.ConfigUpdate).To(Equal(signed))
+		Expect(submitted.Signatures).To(HaveLen(1))
+		update := new(cb.ConfigUpdate)
+		Expect(proto.Unmarshal(submitted.ConfigUpdate, update)).To(Succeed())
+		Expect(proto.Equal(update, result.ConfigUpdate)).To(BeTrue())
+		Expect(update.WriteSet.Groups["Orderer"].Values).To(HaveKey("ConsensusType"))
+
+		b64Block := console.Bodies["PUT /ak/api/v3/kubernetes/components/os4/config"][0]["b64_block"].(string)
+		data, err := base64.StdEncoding.DecodeString(b64Block)
+		Expect(err).To(BeNil())
+		block := new(cb.Block)
+		Expect(proto.Unmarshal(data, block)).To(Succeed())
+		_, config, err := ordering.ConfigFromBlock(block)
+		Expect(err).To(BeNil())
+		Expect(ordering.HasConsenter(config, newConsenter)).To(BeTrue())
+	})
+	It(`Resumes with a pre-created node and a block that already lists it`, func() {
+		console.Responses["GET /ak/api/v3/components/os4"] = node
+		updated, err := ordering.AddConsenter(testConfig(), newConsenter, "osmsp")
+		Expect(err).To(BeNil())
+		options.NodeID = "os4"
+		options.ConfigBlock = marshal(configBlock("testchainid", updated))
+
+		result, err := ordering.AppendRaftNode(context.Background(), console.Service(), options)
+		Expect(err).To(BeNil())
+		Expect(result.ConfigUpdate).To(BeNil())
+		Expect(submitted).To(BeNil())
+		Expect(console.Calls).To(Equal([]string{
+			"GET /ak/api/v3/components/os4",
+			"PUT /ak/api/v3/kubernetes/components/os4/config",
+		}))
+	})
+	It(`Returns the node ID when the config update fails`, func() {
+		options.SubmitConfigUpdate = func(ctx context.Context, channelID string, configUpdate *cb.ConfigUpdateEnvelope) (*cb.Block, error) {
+			return nil, errors.New("BAD_REQUEST")
+		}
+		result, err := ordering.AppendRaftNode(context.Background(), console.Service(), options)
+		Expect(err).To(MatchError("could not submit the config update: BAD_REQUEST"))
+		Expect(result.NodeID).To(Equal("os4"))
+		Expect(console.Calls).ToNot(ContainElement("PUT /ak/api/v3/kubernetes/components/os4/config"))
+	})
+	It(`Rejects a returned block that does not list the node`, func() {
+		options.SubmitConfigUpdate = func(ctx context.Context, channelID string, configUpdate *cb.ConfigUpdateEnvelope) (*cb.Block, error) {
+			return configBlock("testchainid", testConfig()), nil
+		}
+		_, err := ordering.AppendRaftNode(context.Background(), console.Service(), options)
+		Expect(err).To(MatchError("the config block returned by SubmitConfigUpdate does not list the node as a consenter"))
+	})
+	It(`Requires a cluster ID to pre-create the node`, func() {
+		options.CreateOptions.ClusterID = nil
+		_, err := ordering.AppendRaftNode(context.Background(), console.Service(), options)
+		Expect(err).To(MatchError("CreateOptions with a ClusterID are required to pre-create the node"))
+		Expect(console.Calls).To(BeEmpty())
+	})
+})
@@ -0,0 +1,311 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ordering
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"github.com/golang/protobuf/proto"
+	cb "github.com/hyperledger/fabric-protos-go/common"
+	mspproto "github.com/hyperledger/fabric-protos-go/msp"
+	ab "github.com/hyperledger/fabric-protos-go/orderer"
+	"github.com/hyperledger/fabric-protos-go/orderer/etcdraft"
+	"net"
+	"strconv"
+)
+
+// Names of the channel config elements a consenter change touches.
+const (
+	ordererGroupKey       = "Orderer"
+	consensusTypeKey      = "ConsensusType"
+	ordererAddressesKey   = "OrdererAddresses"
+	endpointsKey          = "Endpoints"
+	mspKey                = "MSP"
+	etcdraftConsensusType = "etcdraft"
+)
+
+// ConfigFromBlock returns the channel ID and the channel config held by a config block.
+func ConfigFromBlock(block *cb.Block) (string, *cb.Config, error) {
+	if block.Data == nil || len(block.Data.Data) == 0 {
+		return "", nil, errors.New("the block holds no transaction")
+	}
+	envelope := new(cb.Envelope)
+	if err := proto.Unmarshal(block.Data.Data[0], envelope); err != nil {
+		return "", nil, fmt.Errorf("could not unmarshal the block's envelope: %w", err)
+	}
+	payload := new(cb.Payload)
+	if err := proto.Unmarshal(envelope.Payload, payload); err != nil {
+		return "", nil, fmt.Errorf("could not unmarshal the block's payload: %w", err)
+	}
+	if payload.Header == nil {
+		return "", nil, errors.New("the block's payload has no header")
+	}
+	channelHeader := new(cb.ChannelHeader)
+	if err := proto.Unmarshal(payload.Header.ChannelHeader, channelHeader); err != nil {
+		return "", nil, fmt.Errorf("could not unmarshal the block's channel header: %w", err)
+	}
+	if channelHeader.Type != int32(cb.HeaderType_CONFIG) {
+		return "", nil, fmt.Errorf("the block is not a config block, its transaction type is %d", channelHeader.Type)
+	}
+	configEnvelope := new(cb.ConfigEnvelope)
+	if err := proto.Unmarshal(payload.Data, configEnvelope); err != nil {
+		return "", nil, fmt.Errorf("could not unmarshal the block's config envelope: %w", err)
+	}
+	if configEnvelope.Config == nil || configEnvelope.Config.ChannelGroup == nil {
+		return "", nil, errors.New("the config block holds no channel config")
+	}
+	return channelHeader.ChannelId, configEnvelope.Config, nil
+}
+
+// Consenters returns the raft consenters of a channel config.
+func Consenters(config *cb.Config) ([]*etcdraft.Consenter, error) {
+	_, metadata, err := raftMetadata(config)
+	if err != nil {
+		return nil, err
+	}
+	return metadata.Consenters, nil
+}
+
+// HasConsenter returns true if the channel config lists a consenter at the consenter's host and port.
+func HasConsenter(config *cb.Config, consenter *etcdraft.Consenter) (bool, error) {
+	consenters, err := Consenters(config)
+	if err != nil {
+		return false, err
+	}
+	for _, c := range consenters {
+		if c.Host == consenter.Host && c.Port == consenter.Port {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// AddConsenter returns a copy of the channel config with the consenter appended to the raft consenters. The consenter's
+// address is also appended to the channel's orderer addresses and to the endpoints of the orderer organization with the
+// MSP ID, if the config has them.
+func AddConsenter(config *cb.Config, consenter *etcdraft.Consenter, mspID string) (*cb.Config, error) {
+	if exists, err := HasConsenter(config, consenter); err != nil {
+		return nil, err
+	} else if exists {
+		return nil, fmt.Errorf("the channel config already has a consenter at %s:%d", consenter.Host, consenter.Port)
+	}
+
+	updated := proto.Clone(config).(*cb.Config)
+	consensusType, metadata, err := raftMetadata(updated)
+	if err != nil {
+		return nil, err
+	}
+	metadata.Consenters = append(metadata.Consenters, consenter)
+	if consensusType.Metadata, err = proto.Marshal(metadata); err != nil {
+		return nil, err
+	}
+	ordererGroup := updated.ChannelGroup.Groups[ordererGroupKey]
+	if ordererGroup.Values[consensusTypeKey].Value, err = proto.Marshal(consensusType); err != nil {
+		return nil, err
+	}
+
+	address := net.JoinHostPort(consenter.Host, strconv.Itoa(int(consenter.Port)))
+	if err = appendAddress(updated.ChannelGroup.Values[ordererAddressesKey], address); err != nil {
+		return nil, err
+	}
+	for _, org := range ordererGroup.Groups {
+		if orgMspID(org) == mspID {
+			if err = appendAddress(org.Values[endpointsKey], address); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return updated, nil
+}
+
+// raftMetadata decodes the consensus type of the orderer group and its raft metadata.
+func raftMetadata(config *cb.Config) (*ab.ConsensusType, *etcdraft.ConfigMetadata, error) {
+	ordererGroup := config.ChannelGroup.Groups[ordererGroupKey]
+	if ordererGroup == nil || ordererGroup.Values[consensusTypeKey] == nil {
+		return nil, nil, errors.New("the channel config has no orderer consensus type")
+	}
+	consensusType := new(ab.ConsensusType)
+	if err := proto.Unmarshal(ordererGroup.Values[consensusTypeKey].Value, consensusType); err != nil {
+		return nil, nil, fmt.Errorf("could not unmarshal the consensus type: %w", err)
+	}
+	if consensusType.Type != etcdraftConsensusType {
+		return nil, nil, fmt.Errorf("the channel's consensus type is %q, not %q", consensusType.Type, etcdraftConsensusType)
+	}
+	metadata := new(etcdraft.ConfigMetadata)
+	if err := proto.Unmarshal(consensusType.Metadata, metadata); err != nil {
+		return nil, nil, fmt.Errorf("could not unmarshal the raft metadata: %w", err)
+	}
+	return consensusType, metadata, nil
+}
+
+// appendAddress appends an address to an OrdererAddresses config value, unless the value is nil or has the address.
+func appendAddress(value *cb.ConfigValue, address string) error {
+	if value == nil {
+		return nil
+	}
+	addresses := new(cb.OrdererAddresses)
+	if err := proto.Unmarshal(value.Value, addresses); err != nil {
+		return fmt.Errorf("could not unmarshal the orderer addresses: %w", err)
+	}
+	for _, a := range addresses.Addresses {
+		if a == address {
+			return nil
+		}
+	}
+	addresses.Addresses = append(addresses.Addresses, address)
+	var err error
+	value.Value, err = proto.Marshal(addresses)
+	return err
+}
+
+// orgMspID returns the MSP ID of an organization group, or an empty string if it has no fabric MSP.
+func orgMspID(org *cb.ConfigGroup) string {
+	value := org.Values[mspKey]
+	if value == nil {
+		return ""
+	}
+	mspConfig := new(mspproto.MSPConfig)
+	if err := proto.Unmarshal(value.Value, mspConfig); err != nil {
+		return ""
+	}
+	fabricConfig := new(mspproto.FabricMSPConfig)
+	if err := proto.Unmarshal(mspConfig.Config, fabricConfig); err != nil {
+		return ""
+	}
+	return fabricConfig.Name
+}
+
+// ComputeConfigUpdate returns the config update that turns the original channel config into the updated one. Its read
+// set pins the versions of the elements the update depends on and its write set holds the modified elements with their
+// versions bumped, following the rules of Fabric's configtxlator.
+func ComputeConfigUpdate(channelID string, original, updated *cb.Config) (*cb.ConfigUpdate, error) {
+	if original.ChannelGroup == nil || updated.ChannelGroup == nil {
+		return nil, errors.New("both configs need a channel group")
+	}
+	readSet, writeSet, changed := computeGroupUpdate(original.ChannelGroup, updated.ChannelGroup)
+	if !changed {
+		return nil, errors.New("the configs do not differ")
+	}
+	return &cb.ConfigUpdate{ChannelId: channelID, ReadSet: readSet, WriteSet: writeSet}, nil
+}
+
+func computeGroupUpdate(original, updated *cb.ConfigGroup) (readSet, writeSet *cb.ConfigGroup, changed bool) {
+	readPolicies, writePolicies, samePolicies, policyMembersChanged := computePoliciesUpdate(original.Policies, updated.Policies)
+	readValues, writeValues, sameValues, valueMembersChanged := computeValuesUpdate(original.Values, updated.Values)
+	readGroups, writeGroups, sameGroups, groupMembersChanged := computeGroupsUpdate(original.Groups, updated.Groups)
+
+	if !policyMembersChanged && !valueMembersChanged && !groupMembersChanged && original.ModPolicy == updated.ModPolicy {
+		// The group itself is unchanged, so its version stays and only the changed elements are written
+		if len(writePolicies) == 0 && len(writeValues) == 0 && len(writeGroups) == 0 {
+			return &cb.ConfigGroup{Version: original.Version}, &cb.ConfigGroup{Version: original.Version}, false
+		}
+		return &cb.ConfigGroup{Version: original.Version, Groups: readGroups},
+			&cb.ConfigGroup{Version: original.Version, Policies: writePolicies, Values: writeValues, Groups: writeGroups},
+			true
+	}
+
+	// Members were added or removed, so the group's version is bumped and the whole group is written
+	for key, policy := range samePolicies {
+		readPolicies[key], writePolicies[key] = policy, policy
+	}
+	for key, value := range sameValues {
+		readValues[key], writeValues[key] = value, value
+	}
+	for key, group := range sameGroups {
+		readGroups[key], writeGroups[key] = group, group
+	}
+	return &cb.ConfigGroup{Version: original.Version, Policies: readPolicies, Values: readValues, Groups: readGroups},
+		&cb.ConfigGroup{Version: original.Version + 1, Policies: writePolicies, Values: writeValues, Groups: writeGroups, ModPolicy: updated.ModPolicy},
+		true
+}
+
+func computePoliciesUpdate(original, updated map[string]*cb.ConfigPolicy) (readSet, writeSet, sameSet map[string]*cb.ConfigPolicy, membersChanged bool) {
+	readSet, writeSet, sameSet = map[string]*cb.ConfigPolicy{}, map[string]*cb.ConfigPolicy{}, map[string]*cb.ConfigPolicy{}
+	for key, originalPolicy := range original {
+		updatedPolicy, ok := updated[key]
+		if !ok {
+			membersChanged = true
+			continue
+		}
+		if originalPolicy.ModPolicy == updatedPolicy.ModPolicy && proto.Equal(originalPolicy.Policy, updatedPolicy.Policy) {
+			sameSet[key] = &cb.ConfigPolicy{Version: originalPolicy.Version}
+			continue
+		}
+		writeSet[key] = &cb.ConfigPolicy{Version: originalPolicy.Version + 1, ModPolicy: updatedPolicy.ModPolicy, Policy: updatedPolicy.Policy}
+	}
+	for key, updatedPolicy := range updated {
+		if _, ok := original[key]; !ok {
+			membersChanged = true
+			writeSet[key] = &cb.ConfigPolicy{ModPolicy: updatedPolicy.ModPolicy, Policy: updatedPolicy.Policy}
+		}
+	}
+	return
+}
+
+func computeValuesUpdate(original, updated map[string]*cb.ConfigValue) (readSet, writeSet, sameSet map[string]*cb.ConfigValue, membersChanged bool) {
+	readSet, writeSet, sameSet = map[string]*cb.ConfigValue{}, map[string]*cb.ConfigValue{}, map[string]*cb.ConfigValue{}
+	for key, originalValue := range original {
+		updatedValue, ok := updated[key]
+		if !ok {
+			membersChanged = true
+			continue
+		}
+		if originalValue.ModPolicy == updatedValue.ModPolicy && bytes.Equal(originalValue.Value, updatedValue.Value) {
+			sameSet[key] = &cb.ConfigValue{Version: originalValue.Version}
+			continue
+		}
+		writeSet[key] = &cb.ConfigValue{Version: originalValue.Version + 1, ModPolicy: updatedValue.ModPolicy, Value: updatedValue.Value}
+	}
+	for key, updatedValue := range updated {
+		if _, ok := original[key]; !ok {
+			membersChanged = true
+			writeSet[key] = &cb.ConfigValue{ModPolicy: updatedValue.ModPolicy, Value: updatedValue.Value}
+		}
+	}
+	return
+}
+
+func computeGroupsUpdate(original, updated map[string]*cb.ConfigGroup) (readSet, writeSet, sameSet map[string]*cb.ConfigGroup, membersChanged bool) {
+	readSet, writeSet, sameSet = map[string]*cb.ConfigGroup{}, map[string]*cb.ConfigGroup{}, map[string]*cb.ConfigGroup{}
+	for key, originalGroup := range original {
+		updatedGroup, ok := updated[key]
+		if !ok {
+			membersChanged = true
+			continue
+		}
+		groupReadSet, groupWriteSet, changed := computeGroupUpdate(originalGroup, updatedGroup)
+		if !changed {
+			sameSet[key] = groupReadSet
+			continue
+		}
+		readSet[key], writeSet[key] = groupReadSet, groupWriteSet
+	}
+	for key, updatedGroup := range updated {
+		if _, ok := original[key]; !ok {
+			membersChanged = true
+			_, groupWriteSet, _ := computeGroupUpdate(&cb.ConfigGroup{}, updatedGroup)
+			writeSet[key] = &cb.ConfigGroup{
+				ModPolicy: updatedGroup.ModPolicy,
+				Policies:  groupWriteSet.Policies,
+				Values:    groupWriteSet.Values,
+				Groups:    groupWriteSet.Groups,
+			}
+		}
+	}
+	return
+}
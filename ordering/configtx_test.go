@@ -0,0 +1,181 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ordering_test
+
+import (
+	"github.com/IBM-Blockchain/ibp-go-sdk/ordering"
+	"github.com/golang/protobuf/proto"
+	cb "github.com/hyperledger/fabric-protos-go/common"
+	mspproto "github.com/hyperledger/fabric-protos-go/msp"
+	ab "github.com/hyperledger/fabric-protos-go/orderer"
+	"github.com/hyperledger/fabric-protos-go/orderer/etcdraft"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func marshal(message proto.Message) []byte {
+	data, err := proto.Marshal(message)
+	Expect(err).To(BeNil())
+	return data
+}
+
+// testConfig returns a system channel config with a single raft consenter run by the "osmsp" organization.
+func testConfig() *cb.Config {
+	metadata := &etcdraft.ConfigMetadata{Consenters: []*etcdraft.Consenter{
+		{Host: "os1.example.com", Port: 7050, ClientTlsCert: []byte("os1"), ServerTlsCert: []byte("os1")},
+	}}
+	consensusType := &ab.ConsensusType{Type: "etcdraft", Metadata: marshal(metadata)}
+	addresses := &cb.OrdererAddresses{Addresses: []string{"os1.example.com:7050"}}
+	mspConfig := &mspproto.MSPConfig{Config: marshal(&mspproto.FabricMSPConfig{Name: "osmsp"})}
+	return &cb.Config{
+		Sequence: 5,
+		ChannelGroup: &cb.ConfigGroup{
+			Version:   1,
+			ModPolicy: "Admins",
+			Values: map[string]*cb.ConfigValue{
+				"OrdererAddresses": {Version: 0, ModPolicy: "/Channel/Orderer/Admins", Value: marshal(addresses)},
+				"HashingAlgorithm": {Version: 0, ModPolicy: "Admins", Value: []byte("SHA256")},
+			},
+			Groups: map[string]*cb.ConfigGroup{
+				"Orderer": {
+					Version:   2,
+					ModPolicy: "Admins",
+					Values: map[string]*cb.ConfigValue{
+						"ConsensusType": {Version: 3, ModPolicy: "Admins", Value: marshal(consensusType)},
+						"BatchSize":     {Version: 0, ModPolicy: "Admins", Value: []byte("batch")},
+					},
+					Groups: map[string]*cb.ConfigGroup{
+						"OSOrg": {
+							Version:   1,
+							ModPolicy: "Admins",
+							Values: map[string]*cb.ConfigValue{
+								"MSP":       {Version: 0, ModPolicy: "Admins", Value: marshal(mspConfig)},
+								"Endpoints": {Version: 0, ModPolicy: "Admins", Value: marshal(addresses)},
+							},
+						},
+					},
+				},
+				"Consortiums": {Version: 4, ModPolicy: "/Channel/Orderer/Admins"},
+			},
+		},
+	}
+}
+
+// configBlock wraps a channel config in a config block of the channel.
+func configBlock(channelID string, config *cb.Config) *cb.Block {
+	payload := &cb.Payload{
+		Header: &cb.Header{ChannelHeader: marshal(&cb.ChannelHeader{Type: int32(cb.HeaderType_CONFIG), ChannelId: channelID})},
+		Data:   marshal(&cb.ConfigEnvelope{Config: config}),
+	}
+	envelope := &cb.Envelope{Payload: marshal(payload)}
+	return &cb.Block{Data: &cb.BlockData{Data: [][]byte{marshal(envelope)}}}
+}
+
+func addresses(value *cb.ConfigValue) []string {
+	decoded := new(cb.OrdererAddresses)
+	Expect(proto.Unmarshal(value.Value, decoded)).To(Succeed())
+	return decoded.Addresses
+}
+
+var newConsenter = &etcdraft.Consenter{Host: "os4.example.com", Port: 7050, ClientTlsCert: []byte("os4"), ServerTlsCert: []byte("os4")}
+
+var _ = Describe(`Config transactions`, func() {
+	It(`Reads the channel config of a config block`, func() {
+		channelID, config, err := ordering.ConfigFromBlock(configBlock("testchainid", testConfig()))
+		Expect(err).To(BeNil())
+		Expect(channelID).To(Equal("testchainid"))
+		Expect(proto.Equal(config, testConfig())).To(BeTrue())
+
+		block := configBlock("testchainid", testConfig())
+		payload := &cb.Payload{Header: &cb.Header{ChannelHeader: marshal(&cb.ChannelHeader{Type: int32(cb.HeaderType_ENDORSER_TRANSACTION)})}}
+		block.Data.Data[0] = marshal(&cb.Envelope{Payload: marshal(payload)})
+		_, _, err = ordering.ConfigFromBlock(block)
+		Expect(err).To(MatchError("the block is not a config block, its transaction type is 3"))
+	})
+	It(`Adds a consenter and its address`, func() {
+		original := testConfig()
+		updated, err := ordering.AddConsenter(original, newConsenter, "osmsp")
+		Expect(err).To(BeNil())
+		Expect(proto.Equal(original, testConfig())).To(BeTrue())
+
+		consenters, err := ordering.Consenters(updated)
+		Expect(err).To(BeNil())
+		Expect(consenters).To(HaveLen(2))
+		Expect(proto.Equal(consenters[1], newConsenter)).To(BeTrue())
+		Expect(addresses(updated.ChannelGroup.Values["OrdererAddresses"])).To(Equal([]string{"os1.example.com:7050", "os4.example.com:7050"}))
+		Expect(addresses(updated.ChannelGroup.Groups["Orderer"].Groups["OSOrg"].Values["Endpoints"])).To(Equal([]string{"os1.example.com:7050", "os4.example.com:7050"}))
+
+		_, err = ordering.AddConsenter(updated, newConsenter, "osmsp")
+		Expect(err).To(MatchError("the channel config already has a consenter at os4.example.com:7050"))
+	})
+	It(`Only adds the address to the endpoints of the node's organization`, func() {
+		updated, err := ordering.AddConsenter(testConfig(), newConsenter, "othermsp")
+		Expect(err).To(BeNil())
+		Expect(addresses(updated.ChannelGroup.Groups["Orderer"].Groups["OSOrg"].Values["Endpoints"])).To(Equal([]string{"os1.example.com:7050"}))
+	})
+	It(`Rejects channels that do not use raft`, func() {
+		config := testConfig()
+		config.ChannelGroup.Groups["Orderer"].Values["ConsensusType"].Value = marshal(&ab.ConsensusType{Type: "kafka"})
+		_, err := ordering.AddConsenter(config, newConsenter, "osmsp")
+		Expect(err).To(MatchError(`the channel's consensus type is "kafka", not "etcdraft"`))
+	})
+	It(`Computes the read and write sets of a config update`, func() {
+		original := testConfig()
+		updated, err := ordering.AddConsenter(original, newConsenter, "osmsp")
+		Expect(err).To(BeNil())
+		update, err := ordering.ComputeConfigUpdate("testchainid", original, updated)
+		Expect(err).To(BeNil())
+		Expect(update.ChannelId).To(Equal("testchainid"))
+
+		// unchanged groups keep their version and only the modified values are written, with their version bumped
+		Expect(update.WriteSet.Version).To(Equal(uint64(1)))
+		Expect(update.WriteSet.Values).To(HaveLen(1))
+		Expect(update.WriteSet.Values["OrdererAddresses"].Version).To(Equal(uint64(1)))
+		Expect(update.WriteSet.Values["OrdererAddresses"].ModPolicy).To(Equal("/Channel/Orderer/Admins"))
+		ordererWrite := update.WriteSet.Groups["Orderer"]
+		Expect(ordererWrite.Version).To(Equal(uint64(2)))
+		Expect(ordererWrite.Values).To(HaveLen(1))
+		Expect(ordererWrite.Values["ConsensusType"].Version).To(Equal(uint64(4)))
+		Expect(ordererWrite.Groups["OSOrg"].Values["Endpoints"].Version).To(Equal(uint64(1)))
+		Expect(update.WriteSet.Groups).ToNot(HaveKey("Consortiums"))
+
+		// the read set pins the versions of the groups on the path to the changes
+		Expect(update.ReadSet.Version).To(Equal(uint64(1)))
+		Expect(update.ReadSet.Values).To(BeEmpty())
+		Expect(update.ReadSet.Groups["Orderer"].Version).To(Equal(uint64(2)))
+		Expect(update.ReadSet.Groups["Orderer"].Groups["OSOrg"].Version).To(Equal(uint64(1)))
+	})
+	It(`Bumps the version of a group whose members change`, func() {
+		original := testConfig()
+		updated := testConfig()
+		updated.ChannelGroup.Groups["Orderer"].Values["Capabilities"] = &cb.ConfigValue{ModPolicy: "Admins", Value: []byte("V2_0")}
+		update, err := ordering.ComputeConfigUpdate("testchainid", original, updated)
+		Expect(err).To(BeNil())
+		ordererWrite := update.WriteSet.Groups["Orderer"]
+		Expect(ordererWrite.Version).To(Equal(uint64(3)))
+		Expect(ordererWrite.ModPolicy).To(Equal("Admins"))
+		Expect(ordererWrite.Values).To(HaveLen(3))
+		Expect(ordererWrite.Values["Capabilities"].Version).To(Equal(uint64(0)))
+		Expect(ordererWrite.Values["ConsensusType"].Version).To(Equal(uint64(3)))
+		Expect(ordererWrite.Values["ConsensusType"].Value).To(BeNil())
+		Expect(update.ReadSet.Groups["Orderer"].Values["BatchSize"].Version).To(Equal(uint64(0)))
+	})
+	It(`Rejects identical configs`, func() {
+		_, err := ordering.ComputeConfigUpdate("testchainid", testConfig(), testConfig())
+		Expect(err).To(MatchError("the configs do not differ"))
+	})
+})
@@ -0,0 +1,170 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ordering
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/asn1"
+	"errors"
+	"fmt"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	cb "github.com/hyperledger/fabric-protos-go/common"
+	mspproto "github.com/hyperledger/fabric-protos-go/msp"
+	"math/big"
+)
+
+// Signer : A Fabric identity that signs config updates and transactions.
+type Signer interface {
+	// Serialize returns the marshaled msp.SerializedIdentity of the signer.
+	Serialize() ([]byte, error)
+
+	// Sign returns the signature of the message.
+	Sign(message []byte) ([]byte, error)
+}
+
+// SignatureCollector gathers the admin signatures a config update needs to satisfy the channel's modification policy.
+// configUpdate is the marshaled cb.ConfigUpdate. Admins that do not share a process with the caller can sign it with
+// SignConfigUpdate and send their signature back.
+type SignatureCollector func(ctx context.Context, configUpdate []byte) ([]*cb.ConfigSignature, error)
+
+// LocalSignatures returns a SignatureCollector that signs the config update with each of the signers.
+func LocalSignatures(signers ...Signer) SignatureCollector {
+	return func(ctx context.Context, configUpdate []byte) ([]*cb.ConfigSignature, error) {
+		signatures := make([]*cb.ConfigSignature, 0, len(signers))
+		for _, signer := range signers {
+			signature, err := SignConfigUpdate(signer, configUpdate)
+			if err != nil {
+				return nil, err
+			}
+			signatures = append(signatures, signature)
+		}
+		return signatures, nil
+	}
+}
+
+// SignConfigUpdate signs a marshaled cb.ConfigUpdate.
+func SignConfigUpdate(signer Signer, configUpdate []byte) (*cb.ConfigSignature, error) {
+	signatureHeader, err := newSignatureHeader(signer)
+	if err != nil {
+		return nil, err
+	}
+	signature, err := signer.Sign(append(append([]byte{}, signatureHeader...), configUpdate...))
+	if err != nil {
+		return nil, err
+	}
+	return &cb.ConfigSignature{SignatureHeader: signatureHeader, Signature: signature}, nil
+}
+
+// NewConfigUpdateTx returns the signed CONFIG_UPDATE transaction that submits the config update envelope to the
+// ordering service.
+func NewConfigUpdateTx(channelID string, configUpdate *cb.ConfigUpdateEnvelope, signer Signer) (*cb.Envelope, error) {
+	data, err := proto.Marshal(configUpdate)
+	if err != nil {
+		return nil, err
+	}
+	channelHeader, err := proto.Marshal(&cb.ChannelHeader{
+		Type:      int32(cb.HeaderType_CONFIG_UPDATE),
+		ChannelId: channelID,
+		Timestamp: ptypes.TimestampNow(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	signatureHeader, err := newSignatureHeader(signer)
+	if err != nil {
+		return nil, err
+	}
+	payload, err := proto.Marshal(&cb.Payload{
+		Header: &cb.Header{ChannelHeader: channelHeader, SignatureHeader: signatureHeader},
+		Data:   data,
+	})
+	if err != nil {
+		return nil, err
+	}
+	signature, err := signer.Sign(payload)
+	if err != nil {
+		return nil, err
+	}
+	return &cb.Envelope{Payload: payload, Signature: signature}, nil
+}
+
+func newSignatureHeader(signer Signer) ([]byte, error) {
+	creator, err := signer.Serialize()
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, 24)
+	if _, err = rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return proto.Marshal(&cb.SignatureHeader{Creator: creator, Nonce: nonce})
+}
+
+// IdentitySigner : A Signer for an enrolled identity, e.g. an organization admin enrolled with a fabric-ca client.
+type IdentitySigner struct {
+	// The MSP ID of the identity's organization.
+	MspID string
+
+	// The PEM encoded enrollment certificate of the identity.
+	CertPEM []byte
+
+	// The private key of the identity.
+	Key crypto.Signer
+}
+
+// Serialize returns the marshaled msp.SerializedIdentity of the identity.
+func (signer *IdentitySigner) Serialize() ([]byte, error) {
+	return proto.Marshal(&mspproto.SerializedIdentity{Mspid: signer.MspID, IdBytes: signer.CertPEM})
+}
+
+// Sign signs the SHA-256 digest of the message. ECDSA signatures are normalized to the low-S form Fabric requires.
+func (signer *IdentitySigner) Sign(message []byte) ([]byte, error) {
+	digest := sha256.Sum256(message)
+	signature, err := signer.Key.Sign(rand.Reader, digest[:], crypto.SHA256)
+	if err != nil {
+		return nil, err
+	}
+	if publicKey, ok := signer.Key.Public().(*ecdsa.PublicKey); ok {
+		return toLowS(publicKey, signature)
+	}
+	return signature, nil
+}
+
+type ecdsaSignature struct {
+	R, S *big.Int
+}
+
+// toLowS rewrites an ASN.1 ECDSA signature so that S is at most half the order of the curve.
+func toLowS(publicKey *ecdsa.PublicKey, signature []byte) ([]byte, error) {
+	parsed := new(ecdsaSignature)
+	if rest, err := asn1.Unmarshal(signature, parsed); err != nil {
+		return nil, fmt.Errorf("could not unmarshal the ECDSA signature: %w", err)
+	} else if len(rest) > 0 {
+		return nil, errors.New("the ECDSA signature has trailing data")
+	}
+	order := publicKey.Curve.Params().N
+	if parsed.S.Cmp(new(big.Int).Rsh(order, 1)) <= 0 {
+		return signature, nil
+	}
+	parsed.S.Sub(order, parsed.S)
+	return asn1.Marshal(*parsed)
+}
@@ -0,0 +1,87 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ordering_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/asn1"
+	"github.com/IBM-Blockchain/ibp-go-sdk/ordering"
+	"github.com/golang/protobuf/proto"
+	cb "github.com/hyperledger/fabric-protos-go/common"
+	mspproto "github.com/hyperledger/fabric-protos-go/msp"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"math/big"
+)
+
+var _ = Describe(`Signing`, func() {
+	var key *ecdsa.PrivateKey
+	var signer *ordering.IdentitySigner
+
+	BeforeEach(func() {
+		var err error
+		key, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		Expect(err).To(BeNil())
+		signer = &ordering.IdentitySigner{MspID: "osmsp", CertPEM: []byte("cert"), Key: key}
+	})
+
+	verify := func(message, signature []byte) {
+		var parsed struct{ R, S *big.Int }
+		_, err := asn1.Unmarshal(signature, &parsed)
+		Expect(err).To(BeNil())
+		Expect(parsed.S.Cmp(new(big.Int).Rsh(key.Params().N, 1))).To(BeNumerically("<=", 0))
+		digest := sha256.Sum256(message)
+		Expect(ecdsa.Verify(&key.PublicKey, digest[:], parsed.R, parsed.S)).To(BeTrue())
+	}
+
+	It(`Signs config updates with low-S signatures`, func() {
+		configUpdate := []byte("update")
+		signatures, err := ordering.LocalSignatures(signer, signer)(context.Background(), configUpdate)
+		Expect(err).To(BeNil())
+		Expect(signatures).To(HaveLen(2))
+		for _, signature := range signatures {
+			header := new(cb.SignatureHeader)
+			Expect(proto.Unmarshal(signature.SignatureHeader, header)).To(Succeed())
+			Expect(header.Nonce).To(HaveLen(24))
+			creator := new(mspproto.SerializedIdentity)
+			Expect(proto.Unmarshal(header.Creator, creator)).To(Succeed())
+			Expect(creator.Mspid).To(Equal("osmsp"))
+			Expect(creator.IdBytes).To(Equal([]byte("cert")))
+			verify(append(append([]byte{}, signature.SignatureHeader...), configUpdate...), signature.Signature)
+		}
+	})
+	It(`Wraps a config update in a signed CONFIG_UPDATE transaction`, func() {
+		update := &cb.ConfigUpdateEnvelope{ConfigUpdate: []byte("update")}
+		envelope, err := ordering.NewConfigUpdateTx("testchainid", update, signer)
+		Expect(err).To(BeNil())
+		verify(envelope.Payload, envelope.Signature)
+
+		payload := new(cb.Payload)
+		Expect(proto.Unmarshal(envelope.Payload, payload)).To(Succeed())
+		channelHeader := new(cb.ChannelHeader)
+		Expect(proto.Unmarshal(payload.Header.ChannelHeader, channelHeader)).To(Succeed())
+		Expect(channelHeader.Type).To(Equal(int32(cb.HeaderType_CONFIG_UPDATE)))
+		Expect(channelHeader.ChannelId).To(Equal("testchainid"))
+		data := new(cb.ConfigUpdateEnvelope)
+		Expect(proto.Unmarshal(payload.Data, data)).To(Succeed())
+		Expect(data.ConfigUpdate).To(Equal([]byte("update")))
+	})
+})
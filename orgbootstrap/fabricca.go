@@ -0,0 +1,114 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package orgbootstrap
+
+import (
+	"fmt"
+	"github.com/hyperledger/fabric-ca/api"
+	"github.com/hyperledger/fabric-ca/lib"
+	catls "github.com/hyperledger/fabric-ca/lib/tls"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// CAConnector opens a client for the fabric-ca server of a CA created by the workflow.
+// tlsCertPEM is the PEM encoded TLS certificate of the CA's API endpoint.
+type CAConnector func(apiURL, caName string, tlsCertPEM []byte) (CAClient, error)
+
+// CAClient : The fabric-ca operations used by the workflow.
+type CAClient interface {
+	// Enroll enrolls an identity with the CA.
+	Enroll(enrollID, enrollSecret string) (CAIdentity, error)
+}
+
+// CAIdentity : An identity enrolled with a CA.
+type CAIdentity interface {
+	// Cert returns the PEM encoded enrollment certificate of the identity.
+	Cert() []byte
+
+	// Register registers a new identity with the CA, using this identity as the registrar. Registering an identity
+	// that is already registered is not an error, so that an interrupted run can be resumed.
+	Register(enrollID, enrollSecret, identityType string) error
+}
+
+// FabricCAIdentity : A CAIdentity backed by the fabric-ca client library. The identities returned by the default
+// CAConnector are of this type, so callers can reach the identity's private key through Identity.GetECert().Key().
+type FabricCAIdentity struct {
+	Identity *lib.Identity
+}
+
+// Cert returns the PEM encoded enrollment certificate of the identity.
+func (identity *FabricCAIdentity) Cert() []byte {
+	return identity.Identity.GetECert().Cert()
+}
+
+// Register registers a new identity with the CA, using this identity as the registrar.
+func (identity *FabricCAIdentity) Register(enrollID, enrollSecret, identityType string) error {
+	_, err := identity.Identity.Register(&api.RegistrationRequest{Name: enrollID, Secret: enrollSecret, Type: identityType})
+	if err != nil && strings.Contains(err.Error(), "is already registered") {
+		return nil
+	}
+	return err
+}
+
+type fabricCAClient struct {
+	client *lib.Client
+}
+
+func (c *fabricCAClient) Enroll(enrollID, enrollSecret string) (CAIdentity, error) {
+	response, err := c.client.Enroll(&api.EnrollmentRequest{Name: enrollID, Secret: enrollSecret})
+	if err != nil {
+		return nil, err
+	}
+	return &FabricCAIdentity{Identity: response.Identity}, nil
+}
+
+// FabricCAConnector returns the default CAConnector, which uses the fabric-ca client library. The client's TLS
+// certificate and MSP material, including the private keys of the enrolled identities, are kept under homeDir.
+// If homeDir is empty, every connection creates a temporary directory that is left behind with the private keys in
+// it: pass a directory and remove it once the identities are no longer needed.
+func FabricCAConnector(homeDir string) CAConnector {
+	return func(apiURL, caName string, tlsCertPEM []byte) (CAClient, error) {
+		dir := homeDir
+		if dir == "" {
+			var err error
+			if dir, err = ioutil.TempDir("", "orgbootstrap"); err != nil {
+				return nil, err
+			}
+		} else if err := os.MkdirAll(dir, 0700); err != nil {
+			return nil, err
+		}
+		tlsCertFile := filepath.Join(dir, "ca-tls-cert.pem")
+		if err := ioutil.WriteFile(tlsCertFile, tlsCertPEM, 0600); err != nil {
+			return nil, fmt.Errorf("could not write the CA's TLS certificate: %w", err)
+		}
+		client := &lib.Client{
+			HomeDir: dir,
+			Config: &lib.ClientConfig{
+				TLS: catls.ClientTLSConfig{
+					Enabled:   true,
+					CertFiles: []string{tlsCertFile},
+				},
+				CAName: caName,
+				URL:    apiURL,
+			},
+		}
+		return &fabricCAClient{client: client}, nil
+	}
+}
@@ -0,0 +1,428 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package orgbootstrap creates a complete organization on a console in one call: a CA, the organization admin and peer
+// identities registered with it, an MSP whose admin is the organization admin, and a peer enrolled with the CA.
+package orgbootstrap
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"github.com/IBM-Blockchain/ibp-go-sdk/blockchainv3"
+	"github.com/IBM/go-sdk-core/v4/core"
+	"io/ioutil"
+	"os"
+)
+
+// Options : The Bootstrap options.
+type Options struct {
+	// The display name of the CA.
+	CADisplayName string
+
+	// The enroll ID and secret of the CA's registrar, which is created with the CA.
+	CAAdminID     string
+	CAAdminSecret string
+
+	// The MSP ID of the organization and the display name of its MSP.
+	MspID          string
+	MspDisplayName string
+
+	// The enroll ID and secret of the organization admin, who becomes an admin of the MSP and of the peer.
+	OrgAdminID     string
+	OrgAdminSecret string
+
+	// The display name of the peer and the enroll ID and secret it enrolls with.
+	PeerDisplayName  string
+	PeerEnrollID     string
+	PeerEnrollSecret string
+
+	// Additional hosts of the peer's TLS certificate.
+	PeerCsrHosts []string
+
+	// The state database of the peer, e.g. "couchdb". Defaults to the console's default.
+	PeerStateDb string
+
+	// Tags, Fabric version and zone of the CA and the peer. Default to the console's defaults.
+	Tags    []string
+	Version string
+	Zone    string
+
+	// The file progress is recorded in. If the file exists, the run resumes from the state it holds. Progress is only
+	// kept in memory if empty.
+	StatePath string
+
+	// Opens a client for the fabric-ca server of the CA. Defaults to FabricCAConnector(HomeDir).
+	CAConnector CAConnector
+
+	// The home directory of the default CAConnector, which holds the private keys of the enrolled identities. It is
+	// kept so that the identities can be used again. If empty, a temporary directory is used and removed when
+	// Bootstrap returns; the private key of Result.AdminIdentity is then only kept in memory.
+	HomeDir string
+
+	// Called with the IDs of the CA and the peer once they are created. Defaults to
+	// BlockchainV3.WaitForComponentReady with ReadyOptions.
+	WaitForReady func(ctx context.Context, componentID string) error
+
+	// The options used by the default WaitForReady.
+	ReadyOptions *blockchainv3.WaitForComponentReadyOptions
+}
+
+// Validate returns an error if a required option is missing.
+func (options *Options) Validate() error {
+	required := []struct{ name, value string }{
+		{"CADisplayName", options.CADisplayName},
+		{"CAAdminID", options.CAAdminID},
+		{"CAAdminSecret", options.CAAdminSecret},
+		{"MspID", options.MspID},
+		{"MspDisplayName", options.MspDisplayName},
+		{"OrgAdminID", options.OrgAdminID},
+		{"OrgAdminSecret", options.OrgAdminSecret},
+		{"PeerDisplayName", options.PeerDisplayName},
+		{"PeerEnrollID", options.PeerEnrollID},
+		{"PeerEnrollSecret", options.PeerEnrollSecret},
+	}
+	for _, option := range required {
+		if option.value == "" {
+			return fmt.Errorf("%s is required", option.name)
+		}
+	}
+	return nil
+}
+
+// Result : The organization created by Bootstrap.
+type Result struct {
+	// The IDs of the created components.
+	CAID           string
+	MspComponentID string
+	PeerID         string
+
+	// The enrolled organization admin. A resumed run enrolls the admin again, so the identity may hold a different
+	// certificate than the one recorded in State.AdminCert; both carry the admin role of the identity.
+	AdminIdentity CAIdentity
+
+	// The MSP definition of the organization.
+	MSP *blockchainv3.MspPublicData
+
+	// The progress of the run.
+	State *State
+}
+
+// StepError : A step of the workflow failed. The state records the steps completed before the failure.
+type StepError struct {
+	Step Step
+	Err  error
+}
+
+// Error returns the failed step and its error.
+func (e *StepError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Step, e.Err)
+}
+
+// Unwrap returns the error of the step.
+func (e *StepError) Unwrap() error {
+	return e.Err
+}
+
+type bootstrapper struct {
+	service blockchainv3.BlockchainV3API
+	options *Options
+	state   *State
+}
+
+// Bootstrap runs the organization bootstrap workflow. The steps recorded as completed by the state at
+// Options.StatePath are skipped, and the state is saved after every step that creates something.
+// On failure the result holds the state of the run and the error is a *StepError.
+func Bootstrap(ctx context.Context, service blockchainv3.BlockchainV3API, options *Options) (*Result, error) {
+	if err := options.Validate(); err != nil {
+		return nil, err
+	}
+	state := new(State)
+	if options.StatePath != "" {
+		var err error
+		if state, err = LoadState(options.StatePath); err != nil {
+			return nil, fmt.Errorf("could not load the bootstrap state: %w", err)
+		}
+	}
+	b := &bootstrapper{service: service, options: options, state: state}
+	result := &Result{State: state}
+	err := b.run(ctx, result)
+	result.CAID, result.MspComponentID, result.PeerID = state.CAID, state.MspComponentID, state.PeerID
+	return result, err
+}
+
+func (b *bootstrapper) run(ctx context.Context, result *Result) error {
+	if !b.state.Done(StepCreateCA) {
+		if err := b.createCA(ctx); err != nil {
+			return &StepError{Step: StepCreateCA, Err: err}
+		}
+	}
+	if err := b.waitForReady(ctx, b.state.CAID); err != nil {
+		return &StepError{Step: StepCreateCA, Err: err}
+	}
+
+	ca, _, err := b.service.GetComponentWithContext(ctx, b.service.NewGetComponentOptions(b.state.CAID))
+	if err != nil {
+		return &StepError{Step: StepCreateCA, Err: err}
+	}
+	info, err := newCAInfo(ca)
+	if err != nil {
+		return &StepError{Step: StepCreateCA, Err: err}
+	}
+	connector := b.options.CAConnector
+	if connector == nil {
+		homeDir := b.options.HomeDir
+		if homeDir == "" {
+			// The private keys of the enrolled identities are written under the home directory: do not leave them
+			// behind in a temporary directory.
+			if homeDir, err = ioutil.TempDir("", "orgbootstrap"); err != nil {
+				return &StepError{Step: StepRegisterOrgAdmin, Err: err}
+			}
+			defer os.RemoveAll(homeDir)
+		}
+		connector = FabricCAConnector(homeDir)
+	}
+	client, err := connector(info.apiURL, info.caName, info.tlsCertPEM)
+	if err != nil {
+		return &StepError{Step: StepRegisterOrgAdmin, Err: err}
+	}
+	registrar, err := client.Enroll(b.options.CAAdminID, b.options.CAAdminSecret)
+	if err != nil {
+		return &StepError{Step: StepRegisterOrgAdmin, Err: fmt.Errorf("could not enroll the CA admin: %w", err)}
+	}
+
+	if !b.state.Done(StepRegisterOrgAdmin) {
+		if err = registrar.Register(b.options.OrgAdminID, b.options.OrgAdminSecret, "admin"); err != nil {
+			return &StepError{Step: StepRegisterOrgAdmin, Err: err}
+		}
+		if err = b.complete(StepRegisterOrgAdmin); err != nil {
+			return &StepError{Step: StepRegisterOrgAdmin, Err: err}
+		}
+	}
+	admin, err := client.Enroll(b.options.OrgAdminID, b.options.OrgAdminSecret)
+	if err != nil {
+		return &StepError{Step: StepRegisterOrgAdmin, Err: fmt.Errorf("could not enroll the organization admin: %w", err)}
+	}
+	result.AdminIdentity = admin
+	if b.state.AdminCert == "" {
+		b.state.AdminCert = base64.StdEncoding.EncodeToString(admin.Cert())
+	}
+
+	if !b.state.Done(StepRegisterPeerIdentity) {
+		if err = registrar.Register(b.options.PeerEnrollID, b.options.PeerEnrollSecret, "peer"); err != nil {
+			return &StepError{Step: StepRegisterPeerIdentity, Err: err}
+		}
+		if err = b.complete(StepRegisterPeerIdentity); err != nil {
+			return &StepError{Step: StepRegisterPeerIdentity, Err: err}
+		}
+	}
+
+	result.MSP = &blockchainv3.MspPublicData{
+		MspID:        core.StringPtr(b.options.MspID),
+		RootCerts:    info.rootCerts,
+		Admins:       []string{b.state.AdminCert},
+		TlsRootCerts: info.tlsRootCerts,
+	}
+	if !b.state.Done(StepImportMSP) {
+		if err = b.importMSP(ctx, result.MSP); err != nil {
+			return &StepError{Step: StepImportMSP, Err: err}
+		}
+	}
+
+	if !b.state.Done(StepCreatePeer) {
+		if err = b.createPeer(ctx, ca); err != nil {
+			return &StepError{Step: StepCreatePeer, Err: err}
+		}
+	}
+	if err = b.waitForReady(ctx, b.state.PeerID); err != nil {
+		return &StepError{Step: StepCreatePeer, Err: err}
+	}
+	return nil
+}
+
+func (b *bootstrapper) createCA(ctx context.Context) error {
+	identity, err := b.service.NewConfigCARegistryIdentitiesItem(b.options.CAAdminID, b.options.CAAdminSecret, "client")
+	if err != nil {
+		return err
+	}
+	all := "*"
+	identity.Attrs = &blockchainv3.IdentityAttrs{
+		HfRegistrarRoles:      &all,
+		HfRegistrarAttributes: &all,
+	}
+	registry, err := b.service.NewConfigCARegistry(-1, []blockchainv3.ConfigCARegistryIdentitiesItem{*identity})
+	if err != nil {
+		return err
+	}
+	configCreate, err := b.service.NewConfigCACreate(registry)
+	if err != nil {
+		return err
+	}
+	configOverride, err := b.service.NewCreateCaBodyConfigOverride(configCreate)
+	if err != nil {
+		return err
+	}
+	createOptions := b.service.NewCreateCaOptions(b.options.CADisplayName, configOverride)
+	createOptions.Tags = b.options.Tags
+	createOptions.Version = optionalString(b.options.Version)
+	createOptions.Zone = optionalString(b.options.Zone)
+	ca, _, err := b.service.CreateCaWithContext(ctx, createOptions)
+	if err != nil {
+		return err
+	}
+	b.state.CAID = *ca.ID
+	return b.complete(StepCreateCA)
+}
+
+func (b *bootstrapper) importMSP(ctx context.Context, msp *blockchainv3.MspPublicData) error {
+	importOptions := b.service.NewImportMspOptions(b.options.MspID, b.options.MspDisplayName, msp.RootCerts)
+	importOptions.SetTlsRootCerts(msp.TlsRootCerts)
+	importOptions.SetAdmins(msp.Admins)
+	response, _, err := b.service.ImportMspWithContext(ctx, importOptions)
+	if err != nil {
+		return err
+	}
+	b.state.MspComponentID = *response.ID
+	return b.complete(StepImportMSP)
+}
+
+func (b *bootstrapper) createPeer(ctx context.Context, ca *blockchainv3.GenericComponentResponse) error {
+	crypto, err := blockchainv3.NewCryptoObjectBuilderFor(b.service).
+		FromCA(ca).
+		SetEnrollment(b.options.PeerEnrollID, b.options.PeerEnrollSecret).
+		SetCsrHosts(b.options.PeerCsrHosts...).
+		AddAdminCerts(b.state.AdminCert).
+		Build()
+	if err != nil {
+		return err
+	}
+	createOptions := b.service.NewCreatePeerOptions(b.options.MspID, b.options.PeerDisplayName, crypto)
+	createOptions.StateDb = optionalString(b.options.PeerStateDb)
+	createOptions.Tags = b.options.Tags
+	createOptions.Version = optionalString(b.options.Version)
+	createOptions.Zone = optionalString(b.options.Zone)
+	peer, _, err := b.service.CreatePeerWithContext(ctx, createOptions)
+	if err != nil {
+		return err
+	}
+	b.state.PeerID = *peer.ID
+	return b.complete(StepCreatePeer)
+}
+
+// complete records a completed step and saves the state.
+func (b *bootstrapper) complete(step Step) error {
+	b.state.complete(step)
+	if b.options.StatePath == "" {
+		return nil
+	}
+	if err := b.state.Save(b.options.StatePath); err != nil {
+		return fmt.Errorf("could not save the bootstrap state: %w", err)
+	}
+	return nil
+}
+
+func (b *bootstrapper) waitForReady(ctx context.Context, componentID string) error {
+	if b.options.WaitForReady == nil {
+		return b.service.WaitForComponentReady(ctx, componentID, b.options.ReadyOptions)
+	}
+	return b.options.WaitForReady(ctx, componentID)
+}
+
+// Rollback removes the components recorded by the state, in the reverse order of their creation, and forgets the steps
+// that created them. Identities registered with the CA go away with the CA. Components that no longer exist are
+// skipped, so an interrupted rollback can be run again. Save the state afterwards to keep it in sync with the console.
+func Rollback(ctx context.Context, service blockchainv3.BlockchainV3API, state *State) error {
+	if state.PeerID != "" {
+		if err := ignoreNotFound(service.DeleteComponentWithContext(ctx, service.NewDeleteComponentOptions(state.PeerID))); err != nil {
+			return &StepError{Step: StepCreatePeer, Err: err}
+		}
+		state.PeerID = ""
+		state.forget(StepCreatePeer)
+	}
+	if state.MspComponentID != "" {
+		if err := ignoreNotFound(service.RemoveComponentWithContext(ctx, service.NewRemoveComponentOptions(state.MspComponentID))); err != nil {
+			return &StepError{Step: StepImportMSP, Err: err}
+		}
+		state.MspComponentID = ""
+		state.forget(StepImportMSP)
+	}
+	if state.CAID != "" {
+		if err := ignoreNotFound(service.DeleteComponentWithContext(ctx, service.NewDeleteComponentOptions(state.CAID))); err != nil {
+			return &StepError{Step: StepCreateCA, Err: err}
+		}
+		state.CAID = ""
+		state.AdminCert = ""
+		state.Completed = nil
+	}
+	return nil
+}
+
+func ignoreNotFound(_ *blockchainv3.DeleteComponentResponse, _ *core.DetailedResponse, err error) error {
+	var notFound *blockchainv3.NotFoundError
+	if errors.As(err, &notFound) {
+		return nil
+	}
+	return err
+}
+
+// caInfo holds what connecting to the CA and defining the MSP require.
+type caInfo struct {
+	apiURL       string
+	caName       string
+	tlsCertPEM   []byte
+	rootCerts    []string
+	tlsRootCerts []string
+}
+
+func newCAInfo(ca *blockchainv3.GenericComponentResponse) (*caInfo, error) {
+	if ca.ApiURL == nil {
+		return nil, fmt.Errorf("the console has no API URL for the CA")
+	}
+	info := &caInfo{apiURL: *ca.ApiURL}
+	tlsCert := ""
+	if msp := ca.Msp; msp != nil {
+		if msp.Ca != nil {
+			info.caName = stringValue(msp.Ca.Name)
+			info.rootCerts = msp.Ca.RootCerts
+		}
+		if msp.Tlsca != nil {
+			info.tlsRootCerts = msp.Tlsca.RootCerts
+		}
+		if msp.Component != nil {
+			tlsCert = stringValue(msp.Component.TlsCert)
+		}
+	}
+	var err error
+	if info.tlsCertPEM, err = base64.StdEncoding.DecodeString(tlsCert); err != nil {
+		return nil, fmt.Errorf("could not decode the CA's TLS certificate: %w", err)
+	}
+	return info, nil
+}
+
+func optionalString(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
+
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
@@ -0,0 +1,229 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package orgbootstrap_test
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"github.com/IBM-Blockchain/ibp-go-sdk/blockchainv3"
+	"github.com/IBM-Blockchain/ibp-go-sdk/internal/testutil"
+	"github.com/IBM-Blockchain/ibp-go-sdk/orgbootstrap"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// fakeCA stands in for a fabric-ca server. Every enrollment yields a new certificate.
+type fakeCA struct {
+	apiURL, caName string
+	registered     map[string]string
+	enrollments    int
+	failRegister   string
+}
+
+type fakeIdentity struct {
+	ca   *fakeCA
+	cert []byte
+}
+
+func (ca *fakeCA) connect(apiURL, caName string, tlsCertPEM []byte) (orgbootstrap.CAClient, error) {
+	ca.apiURL, ca.caName = apiURL, caName
+	Expect(string(tlsCertPEM)).To(Equal("tlscert"))
+	return ca, nil
+}
+
+func (ca *fakeCA) Enroll(enrollID, enrollSecret string) (orgbootstrap.CAIdentity, error) {
+	if enrollID != "admin" && ca.registered[enrollID] != enrollSecret {
+		return nil, errors.New("authentication failure")
+	}
+	ca.enrollments++
+	return &fakeIdentity{ca: ca, cert: []byte(fmt.Sprintf("%s-cert-%d", enrollID, ca.enrollments))}, nil
+}
+
+func (identity *fakeIdentity) Cert() []byte {
+	return identity.cert
+}
+
+func (identity *fakeIdentity) Register(enrollID, enrollSecret, identityType string) error {
+	if enrollID == identity.ca.failRegister {
+		return errors.New("registration failed")
+	}
+	identity.ca.registered[enrollID] = enrollSecret
+	return nil
+}
+
+const caComponent = `{"id": "org1ca", "type": "fabric-ca", "api_url": "https://ca.example.com:7054",
+	"msp": {"ca": {"name": "ca", "root_certs": ["cm9vdA=="]}, "tlsca": {"name": "tlsca", "root_certs": ["dGxz"]},
+	"component": {"tls_cert": "dGxzY2VydA=="}}}`
+
+var _ = Describe(`Bootstrap`, func() {
+	var console *testutil.MockConsole
+	var ca *fakeCA
+	var options *orgbootstrap.Options
+	var waited []string
+	var dir string
+
+	BeforeEach(func() {
+		console = testutil.NewMockConsole()
+		console.Responses["POST /ak/api/v3/kubernetes/components/fabric-ca"] = `{"id": "org1ca"}`
+		console.Responses["GET /ak/api/v3/components/org1ca"] = caComponent
+		console.Responses["POST /ak/api/v3/components/msp"] = `{"id": "org1msp"}`
+		console.Responses["POST /ak/api/v3/kubernetes/components/fabric-peer"] = `{"id": "peer1"}`
+		ca = &fakeCA{registered: map[string]string{}}
+		waited = nil
+		var err error
+		dir, err = ioutil.TempDir("", "orgbootstrap")
+		Expect(err).To(BeNil())
+		options = &orgbootstrap.Options{
+			CADisplayName:    "Org1 CA",
+			CAAdminID:        "admin",
+			CAAdminSecret:    "adminpw",
+			MspID:            "org1msp",
+			MspDisplayName:   "Org1 MSP",
+			OrgAdminID:       "org1admin",
+			OrgAdminSecret:   "org1adminpw",
+			PeerDisplayName:  "Peer1",
+			PeerEnrollID:     "peer1",
+			PeerEnrollSecret: "peer1pw",
+			StatePath:        filepath.Join(dir, "state.json"),
+			CAConnector:      ca.connect,
+			WaitForReady: func(ctx context.Context, componentID string) error {
+				waited = append(waited, componentID)
+				return nil
+			},
+		}
+	})
+	AfterEach(func() {
+		console.Close()
+		os.RemoveAll(dir)
+	})
+
+	It(`Creates the CA, identities, MSP and peer`, func() {
+		result, err := orgbootstrap.Bootstrap(context.Background(), console.Service(), options)
+		Expect(err).To(BeNil())
+		Expect(result.CAID).To(Equal("org1ca"))
+		Expect(result.MspComponentID).To(Equal("org1msp"))
+		Expect(result.PeerID).To(Equal("peer1"))
+		Expect(result.AdminIdentity.Cert()).To(Equal([]byte("org1admin-cert-2")))
+		Expect(waited).To(Equal([]string{"org1ca", "peer1"}))
+
+		Expect(ca.apiURL).To(Equal("https://ca.example.com:7054"))
+		Expect(ca.caName).To(Equal("ca"))
+		Expect(ca.registered).To(Equal(map[string]string{"org1admin": "org1adminpw", "peer1": "peer1pw"}))
+
+		adminCert := base64.StdEncoding.EncodeToString([]byte("org1admin-cert-2"))
+		Expect(*result.MSP.MspID).To(Equal("org1msp"))
+		Expect(result.MSP.RootCerts).To(Equal([]string{"cm9vdA=="}))
+		Expect(result.MSP.TlsRootCerts).To(Equal([]string{"dGxz"}))
+		Expect(result.MSP.Admins).To(Equal([]string{adminCert}))
+
+		caBody := console.Bodies["POST /ak/api/v3/kubernetes/components/fabric-ca"][0]
+		registry := caBody["config_override"].(map[string]interface{})["ca"].(map[string]interface{})["registry"].(map[string]interface{})
+		Expect(registry["identities"].([]interface{})[0].(map[string]interface{})["name"]).To(Equal("admin"))
+
+		msp := console.Bodies["POST /ak/api/v3/components/msp"][0]
+		Expect(msp["admins"]).To(Equal([]interface{}{adminCert}))
+
+		peer := console.Bodies["POST /ak/api/v3/kubernetes/components/fabric-peer"][0]
+		enrollment := peer["crypto"].(map[string]interface{})["enrollment"].(map[string]interface{})
+		Expect(enrollment["ca"].(map[string]interface{})["enroll_id"]).To(Equal("peer1"))
+		Expect(enrollment["component"].(map[string]interface{})["admincerts"]).To(Equal([]interface{}{adminCert}))
+
+		state, err := orgbootstrap.LoadState(options.StatePath)
+		Expect(err).To(BeNil())
+		Expect(state).To(Equal(result.State))
+		Expect(state.Completed).To(Equal([]orgbootstrap.Step{
+			orgbootstrap.StepCreateCA,
+			orgbootstrap.StepRegisterOrgAdmin,
+			orgbootstrap.StepRegisterPeerIdentity,
+			orgbootstrap.StepImportMSP,
+			orgbootstrap.StepCreatePeer,
+		}))
+	})
+	It(`Resumes a failed run from its saved state`, func() {
+		delete(console.Responses, "POST /ak/api/v3/kubernetes/components/fabric-peer")
+		result, err := orgbootstrap.Bootstrap(context.Background(), console.Service(), options)
+		var stepErr *orgbootstrap.StepError
+		Expect(errors.As(err, &stepErr)).To(BeTrue())
+		Expect(stepErr.Step).To(Equal(orgbootstrap.StepCreatePeer))
+		var notFound *blockchainv3.NotFoundError
+		Expect(errors.As(err, &notFound)).To(BeTrue())
+		Expect(result.MspComponentID).To(Equal("org1msp"))
+		Expect(result.PeerID).To(Equal(""))
+		firstAdminCert := result.State.AdminCert
+
+		console.Responses["POST /ak/api/v3/kubernetes/components/fabric-peer"] = `{"id": "peer1"}`
+		console.Calls = nil
+		result, err = orgbootstrap.Bootstrap(context.Background(), console.Service(), options)
+		Expect(err).To(BeNil())
+		Expect(result.PeerID).To(Equal("peer1"))
+		Expect(console.Calls).To(Equal([]string{
+			"GET /ak/api/v3/components/org1ca",
+			"POST /ak/api/v3/kubernetes/components/fabric-peer",
+		}))
+		Expect(result.MSP.Admins).To(Equal([]string{firstAdminCert}))
+		peer := console.Bodies["POST /ak/api/v3/kubernetes/components/fabric-peer"][1]
+		enrollment := peer["crypto"].(map[string]interface{})["enrollment"].(map[string]interface{})
+		Expect(enrollment["component"].(map[string]interface{})["admincerts"]).To(Equal([]interface{}{firstAdminCert}))
+	})
+	It(`Reports the failing step`, func() {
+		ca.failRegister = "peer1"
+		result, err := orgbootstrap.Bootstrap(context.Background(), console.Service(), options)
+		Expect(err).To(MatchError("register-peer-identity: registration failed"))
+		Expect(result.State.Completed).To(Equal([]orgbootstrap.Step{orgbootstrap.StepCreateCA, orgbootstrap.StepRegisterOrgAdmin}))
+	})
+	It(`Removes the temporary home directory of the default CA connector`, func() {
+		tmpDir := filepath.Join(dir, "tmp")
+		Expect(os.Mkdir(tmpDir, 0700)).To(Succeed())
+		defer os.Setenv("TMPDIR", os.Getenv("TMPDIR"))
+		os.Setenv("TMPDIR", tmpDir)
+		console.Responses["GET /ak/api/v3/components/org1ca"] = strings.Replace(caComponent, "ca.example.com:7054", "127.0.0.1:1", 1)
+		options.CAConnector = nil
+		_, err := orgbootstrap.Bootstrap(context.Background(), console.Service(), options)
+		Expect(err).To(MatchError(ContainSubstring("register-org-admin: could not enroll the CA admin")))
+		entries, err := ioutil.ReadDir(tmpDir)
+		Expect(err).To(BeNil())
+		Expect(entries).To(BeEmpty())
+	})
+	It(`Rejects incomplete options`, func() {
+		options.PeerEnrollSecret = ""
+		_, err := orgbootstrap.Bootstrap(context.Background(), console.Service(), options)
+		Expect(err).To(MatchError("PeerEnrollSecret is required"))
+		Expect(console.Calls).To(BeEmpty())
+	})
+	It(`Rolls back the components of a run`, func() {
+		result, err := orgbootstrap.Bootstrap(context.Background(), console.Service(), options)
+		Expect(err).To(BeNil())
+		console.Calls = nil
+		console.Responses["DELETE /ak/api/v3/kubernetes/components/peer1"] = `{"id": "peer1"}`
+		console.Responses["DELETE /ak/api/v3/kubernetes/components/org1ca"] = `{"id": "org1ca"}`
+
+		err = orgbootstrap.Rollback(context.Background(), console.Service(), result.State)
+		Expect(err).To(BeNil())
+		Expect(console.Calls).To(Equal([]string{
+			"DELETE /ak/api/v3/kubernetes/components/peer1",
+			"DELETE /ak/api/v3/components/org1msp",
+			"DELETE /ak/api/v3/kubernetes/components/org1ca",
+		}))
+		Expect(*result.State).To(Equal(orgbootstrap.State{}))
+	})
+})
@@ -0,0 +1,122 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package orgbootstrap
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+// Step : A step of the organization bootstrap workflow.
+type Step string
+
+// The steps of the workflow, in the order they run. Enrolling identities leaves nothing to roll back, so only the steps
+// that create something are recorded.
+const (
+	StepCreateCA             Step = "create-ca"
+	StepRegisterOrgAdmin     Step = "register-org-admin"
+	StepRegisterPeerIdentity Step = "register-peer-identity"
+	StepImportMSP            Step = "import-msp"
+	StepCreatePeer           Step = "create-peer"
+)
+
+// State : The progress of a workflow run. Bootstrap skips the steps a state records as completed, which lets a failed
+// run be resumed, and Rollback removes the components it records.
+type State struct {
+	// The steps that completed, in the order they completed.
+	Completed []Step `json:"completed,omitempty"`
+
+	// The ID of the CA component.
+	CAID string `json:"ca_id,omitempty"`
+
+	// The base 64 encoded PEM enrollment certificate of the organization admin that was made an admin of the MSP and
+	// of the peer.
+	AdminCert string `json:"admin_cert,omitempty"`
+
+	// The ID of the MSP component.
+	MspComponentID string `json:"msp_component_id,omitempty"`
+
+	// The ID of the peer component.
+	PeerID string `json:"peer_id,omitempty"`
+}
+
+// Done returns true if the state records the step as completed.
+func (state *State) Done(step Step) bool {
+	for _, completed := range state.Completed {
+		if completed == step {
+			return true
+		}
+	}
+	return false
+}
+
+func (state *State) complete(step Step) {
+	if !state.Done(step) {
+		state.Completed = append(state.Completed, step)
+	}
+}
+
+func (state *State) forget(step Step) {
+	completed := state.Completed[:0]
+	for _, s := range state.Completed {
+		if s != step {
+			completed = append(completed, s)
+		}
+	}
+	state.Completed = completed
+}
+
+// LoadState reads a state saved by Save. A missing file yields an empty state, so that the first run of a workflow
+// and a resumed run can share the same code path.
+func LoadState(path string) (*State, error) {
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return new(State), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	state := new(State)
+	if err = json.Unmarshal(data, state); err != nil {
+		return nil, err
+	}
+	return state, nil
+}
+
+// Save writes the state to path as JSON. The file is replaced atomically so that an interrupted run never leaves a
+// truncated state behind.
+func (state *State) Save(path string) error {
+	data, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp, err := ioutil.TempFile(filepath.Dir(path), filepath.Base(path)+".tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err = tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
@@ -0,0 +1,199 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package relay
+
+import (
+	"encoding/json"
+	"github.com/IBM-Blockchain/ibp-go-sdk/blockchainv3"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// Cursor : The position of a relay: the creation time of the newest notification delivered, in milliseconds since the
+// epoch, and the IDs of the notifications delivered with that creation time.
+type Cursor struct {
+	TsDisplay float64 `json:"ts_display"`
+
+	IDs []string `json:"ids,omitempty"`
+
+	// The notifications seen while they were still pending, so that a restarted relay reads them again and forwards
+	// their status changes.
+	Pending []PendingNotification `json:"pending,omitempty"`
+}
+
+// PendingNotification : A pending notification in a cursor, with the status and message it was last seen with.
+type PendingNotification struct {
+	ID        string  `json:"id"`
+	TsDisplay float64 `json:"ts_display"`
+	Status    string  `json:"status"`
+	Message   string  `json:"message,omitempty"`
+
+	// Whether the notification passed the relay filter and was forwarded.
+	Delivered bool `json:"delivered,omitempty"`
+}
+
+// since returns the creation time of the oldest notification a restarted relay must read: the cursor, or the oldest
+// pending notification if it is older.
+func (cursor *Cursor) since() float64 {
+	since := cursor.TsDisplay
+	for _, pending := range cursor.Pending {
+		if pending.TsDisplay < since {
+			since = pending.TsDisplay
+		}
+	}
+	return since
+}
+
+// pending returns the index of a pending notification, or -1.
+func (cursor *Cursor) pending(id string) int {
+	for i := range cursor.Pending {
+		if cursor.Pending[i].ID == id {
+			return i
+		}
+	}
+	return -1
+}
+
+// track records the status of a notification: it is added to or updated in the pending notifications while it is
+// pending, and removed from them once it is not. It returns false if nothing changed.
+func (cursor *Cursor) track(notification PendingNotification) bool {
+	i := cursor.pending(notification.ID)
+	switch {
+	case notification.Status == blockchainv3.NotificationStatusPending && i < 0:
+		cursor.Pending = append(cursor.Pending, notification)
+	case notification.Status == blockchainv3.NotificationStatusPending && cursor.Pending[i] != notification:
+		cursor.Pending[i] = notification
+	case notification.Status != blockchainv3.NotificationStatusPending && i >= 0:
+		cursor.Pending = append(cursor.Pending[:i], cursor.Pending[i+1:]...)
+	default:
+		return false
+	}
+	return true
+}
+
+// copy returns a deep copy of the cursor.
+func (cursor *Cursor) copy() *Cursor {
+	return &Cursor{
+		TsDisplay: cursor.TsDisplay,
+		IDs:       append([]string(nil), cursor.IDs...),
+		Pending:   append([]PendingNotification(nil), cursor.Pending...),
+	}
+}
+
+// delivered returns true if the notification is at or before the cursor.
+func (cursor *Cursor) delivered(id string, ts float64) bool {
+	if ts != cursor.TsDisplay {
+		return ts < cursor.TsDisplay
+	}
+	for _, delivered := range cursor.IDs {
+		if delivered == id {
+			return true
+		}
+	}
+	return false
+}
+
+// advance moves the cursor to a delivered notification. It returns false if the notification is before the cursor.
+func (cursor *Cursor) advance(id string, ts float64) bool {
+	switch {
+	case ts > cursor.TsDisplay:
+		cursor.TsDisplay, cursor.IDs = ts, []string{id}
+	case ts == cursor.TsDisplay && !cursor.delivered(id, ts):
+		cursor.IDs = append(cursor.IDs, id)
+	default:
+		return false
+	}
+	return true
+}
+
+// CursorStore : Persists the cursor of a relay.
+type CursorStore interface {
+	// Load returns the saved cursor, or nil if there is none.
+	Load() (*Cursor, error)
+
+	Save(cursor *Cursor) error
+}
+
+// MemoryCursor : A CursorStore that keeps the cursor in memory.
+type MemoryCursor struct {
+	mu     sync.Mutex
+	cursor *Cursor
+}
+
+// Load returns a copy of the saved cursor.
+func (store *MemoryCursor) Load() (*Cursor, error) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	if store.cursor == nil {
+		return nil, nil
+	}
+	return store.cursor.copy(), nil
+}
+
+// Save keeps a copy of the cursor.
+func (store *MemoryCursor) Save(cursor *Cursor) error {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	store.cursor = cursor.copy()
+	return nil
+}
+
+// FileCursor : A CursorStore that saves the cursor as JSON in a file. The file is replaced atomically, so that a crash
+// never leaves a partial cursor.
+type FileCursor struct {
+	Path string
+}
+
+// Load reads the cursor file, or returns nil if it does not exist.
+func (store *FileCursor) Load() (*Cursor, error) {
+	data, err := ioutil.ReadFile(store.Path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	cursor := new(Cursor)
+	if err := json.Unmarshal(data, cursor); err != nil {
+		return nil, err
+	}
+	return cursor, nil
+}
+
+// Save writes the cursor to a temporary file and renames it to the cursor file.
+func (store *FileCursor) Save(cursor *Cursor) error {
+	data, err := json.Marshal(cursor)
+	if err != nil {
+		return err
+	}
+	file, err := ioutil.TempFile(filepath.Dir(store.Path), filepath.Base(store.Path)+".*")
+	if err != nil {
+		return err
+	}
+	if _, err := file.Write(data); err != nil {
+		file.Close()
+		os.Remove(file.Name())
+		return err
+	}
+	if err := file.Close(); err != nil {
+		os.Remove(file.Name())
+		return err
+	}
+	return os.Rename(file.Name(), store.Path)
+}
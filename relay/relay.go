@@ -0,0 +1,207 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package relay forwards the console notifications to webhooks, JSON-lines files and standard output.
+package relay
+
+import (
+	"context"
+	"github.com/IBM-Blockchain/ibp-go-sdk/blockchainv3"
+	"github.com/IBM/go-sdk-core/v4/core"
+	"time"
+)
+
+// Defaults of the Relay retry settings.
+const (
+	DefaultRetryInterval    = time.Second
+	DefaultMaxRetryInterval = time.Minute
+)
+
+// Event : A notification forwarded to the sinks.
+type Event struct {
+	blockchainv3.NotificationData
+
+	// The component the relay watches, if any.
+	ComponentID string `json:"component_id,omitempty"`
+
+	// Whether the notification was forwarded before with another status or message.
+	Updated bool `json:"updated"`
+}
+
+// Sink : A destination of the events.
+type Sink interface {
+	// Send delivers an event. An error makes the relay send the event again later.
+	Send(ctx context.Context, event *Event) error
+}
+
+// Filter : The notifications a relay forwards.
+type Filter struct {
+	// Only forward the notifications of this component.
+	ComponentID string
+
+	// Only forward the notifications of these types ("notification", "webhook_tx" or "other") and with these statuses
+	// ("pending", "error" or "success"). All of them by default.
+	Types    []string
+	Statuses []string
+}
+
+// Relay : Forwards the new console notifications to sinks, at least once: an event is sent again until every sink
+// accepts it, and the position of the relay is saved in a cursor only after that, so that a restarted relay resends
+// the events it may not have delivered and skips the others. The cursor also keeps the notifications that were still
+// pending, and a restarted relay reads back to the oldest of them to forward their status changes.
+type Relay struct {
+	Service blockchainv3.BlockchainV3API
+
+	Sinks []Sink
+
+	Filter Filter
+
+	// Where the position of the relay is saved. It is only kept in memory by default, and the relay then starts with
+	// every notification the console holds.
+	Cursor CursorStore
+
+	// The delay between two polls of the console. Defaults to blockchainv3.DefaultNotificationPollInterval.
+	PollInterval time.Duration
+
+	// The delay before an event is sent again to a sink that failed, doubled after each failure up to MaxRetryInterval.
+	// Default to DefaultRetryInterval and DefaultMaxRetryInterval.
+	RetryInterval, MaxRetryInterval time.Duration
+
+	// Called with the errors of the polls, the sinks and the cursor, if set. The relay goes on.
+	OnError func(err error)
+}
+
+// Run forwards the notifications until the context is done, and returns the error of the context. It fails at once
+// if the cursor cannot be loaded.
+func (relay *Relay) Run(ctx context.Context) error {
+	store := relay.Cursor
+	if store == nil {
+		store = new(MemoryCursor)
+	}
+	cursor, err := store.Load()
+	if err != nil {
+		return err
+	}
+	if cursor == nil {
+		cursor = new(Cursor)
+	}
+
+	// The statuses are filtered here rather than by the watch, so that the cursor tracks every pending notification,
+	// including the ones filtered out until they succeed or fail.
+	filter := &blockchainv3.NotificationFilter{
+		ComponentID:  relay.Filter.ComponentID,
+		Types:        relay.Filter.Types,
+		PollInterval: relay.PollInterval,
+	}
+	if since := cursor.since(); since > 0 {
+		filter.Since = time.Unix(0, int64(since)*int64(time.Millisecond))
+	}
+	for event := range relay.Service.WatchNotifications(ctx, filter) {
+		if event.Err != nil {
+			relay.report(event.Err)
+			continue
+		}
+		notification := event.Notification
+		id := core.StringNilMapper(notification.ID)
+		ts := 0.0
+		if notification.TsDisplay != nil {
+			ts = *notification.TsDisplay
+		}
+		status := PendingNotification{
+			ID:        id,
+			TsDisplay: ts,
+			Status:    core.StringNilMapper(notification.Status),
+			Message:   core.StringNilMapper(notification.Message),
+		}
+		updated := event.Updated
+		if i := cursor.pending(id); i >= 0 {
+			previous := cursor.Pending[i]
+			if previous.Status == status.Status && previous.Message == status.Message {
+				continue
+			}
+			updated = previous.Delivered
+		} else if !event.Updated && cursor.delivered(id, ts) {
+			continue
+		}
+		status.Delivered = contains(relay.Filter.Statuses, status.Status)
+		if status.Delivered && !relay.deliver(ctx, &Event{NotificationData: *notification, ComponentID: relay.Filter.ComponentID, Updated: updated}) {
+			break
+		}
+		advanced := cursor.advance(id, ts)
+		if cursor.track(status) || advanced {
+			if err := store.Save(cursor); err != nil {
+				relay.report(err)
+			}
+		}
+	}
+	return ctx.Err()
+}
+
+// deliver sends an event to every sink, again and again to the sinks that fail. It returns false if the context is
+// done first.
+func (relay *Relay) deliver(ctx context.Context, event *Event) bool {
+	interval, maxInterval := relay.RetryInterval, relay.MaxRetryInterval
+	if interval <= 0 {
+		interval = DefaultRetryInterval
+	}
+	if maxInterval <= 0 {
+		maxInterval = DefaultMaxRetryInterval
+	}
+	pending := relay.Sinks
+	for {
+		var failed []Sink
+		for _, sink := range pending {
+			if err := sink.Send(ctx, event); err != nil {
+				relay.report(err)
+				failed = append(failed, sink)
+			}
+		}
+		if len(failed) == 0 {
+			return true
+		}
+		pending = failed
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return false
+		case <-timer.C:
+		}
+		if interval *= 2; interval > maxInterval {
+			interval = maxInterval
+		}
+	}
+}
+
+func (relay *Relay) report(err error) {
+	if relay.OnError != nil {
+		relay.OnError(err)
+	}
+}
+
+// contains returns true if values is empty or contains value.
+func contains(values []string, value string) bool {
+	if len(values) == 0 {
+		return true
+	}
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,277 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package relay_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"github.com/IBM-Blockchain/ibp-go-sdk/blockchainv3"
+	"github.com/IBM-Blockchain/ibp-go-sdk/blockchainv3fake"
+	"github.com/IBM-Blockchain/ibp-go-sdk/relay"
+	"github.com/IBM/go-sdk-core/v4/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// webhook is a local stand-in for a webhook endpoint, failing the first requests it is told to.
+type webhook struct {
+	mu       sync.Mutex
+	server   *httptest.Server
+	bodies   [][]byte
+	failures int
+}
+
+func newWebhook(secret []byte) *webhook {
+	hook := &webhook{}
+	hook.server = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		defer GinkgoRecover()
+		body, err := ioutil.ReadAll(req.Body)
+		Expect(err).To(BeNil())
+		Expect(relay.Verify(secret, body, req.Header.Get(relay.SignatureHeader))).To(BeTrue())
+		hook.mu.Lock()
+		defer hook.mu.Unlock()
+		if hook.failures > 0 {
+			hook.failures--
+			res.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		hook.bodies = append(hook.bodies, body)
+	}))
+	return hook
+}
+
+// ids returns the IDs of the events the webhook received.
+func (hook *webhook) ids() []string {
+	hook.mu.Lock()
+	defer hook.mu.Unlock()
+	var ids []string
+	for _, body := range hook.bodies {
+		var event relay.Event
+		Expect(json.Unmarshal(body, &event)).To(Succeed())
+		ids = append(ids, *event.ID)
+	}
+	return ids
+}
+
+var _ = Describe(`Relay`, func() {
+	var console *blockchainv3fake.Console
+	var hook *webhook
+	var secret = []byte("secret")
+	var dir string
+
+	notify := func(id, notificationType, status string, ts float64, componentID string) {
+		console.AddNotification(blockchainv3.NotificationData{
+			ID:        core.StringPtr(id),
+			Type:      core.StringPtr(notificationType),
+			Status:    core.StringPtr(status),
+			Message:   core.StringPtr(id + " " + status),
+			TsDisplay: core.Float64Ptr(ts),
+		}, componentID)
+	}
+
+	// run starts a relay and returns a function that stops it.
+	run := func(r *relay.Relay) func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan error)
+		go func() {
+			done <- r.Run(ctx)
+		}()
+		return func() {
+			cancel()
+			Eventually(done).Should(Receive(Equal(context.Canceled)))
+		}
+	}
+
+	BeforeEach(func() {
+		console = blockchainv3fake.NewConsole()
+		hook = newWebhook(secret)
+		var err error
+		dir, err = ioutil.TempDir("", "relay")
+		Expect(err).To(BeNil())
+	})
+	AfterEach(func() {
+		hook.server.Close()
+		os.RemoveAll(dir)
+	})
+
+	It(`Forwards the matching notifications to every sink`, func() {
+		notify("a", "notification", "success", 1000, "peer")
+		notify("b", "webhook_tx", "success", 2000, "peer")
+		notify("c", "notification", "pending", 3000, "peer")
+		notify("d", "notification", "error", 4000, "ca")
+		notify("e", "notification", "error", 5000, "peer")
+
+		file, err := relay.OpenJSONLinesFile(filepath.Join(dir, "events.jsonl"))
+		Expect(err).To(BeNil())
+		defer file.Close()
+		var stdout bytes.Buffer
+		stop := run(&relay.Relay{
+			Service: console,
+			Sinks: []relay.Sink{
+				&relay.WebhookSink{URL: hook.server.URL, Secret: secret},
+				file,
+				relay.NewJSONLinesSink(&stdout),
+			},
+			Filter: relay.Filter{
+				ComponentID: "peer",
+				Types:       []string{"notification"},
+				Statuses:    []string{"success", "error"},
+			},
+			PollInterval: 10 * time.Millisecond,
+		})
+		Eventually(hook.ids).Should(Equal([]string{"a", "e"}))
+		stop()
+
+		data, err := ioutil.ReadFile(filepath.Join(dir, "events.jsonl"))
+		Expect(err).To(BeNil())
+		Expect(string(data)).To(Equal(stdout.String()))
+		lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+		Expect(lines).To(HaveLen(2))
+		var event map[string]interface{}
+		Expect(json.Unmarshal([]byte(lines[1]), &event)).To(Succeed())
+		Expect(event).To(Equal(map[string]interface{}{
+			"id":           "e",
+			"type":         "notification",
+			"status":       "error",
+			"message":      "e error",
+			"ts_display":   float64(5000),
+			"component_id": "peer",
+			"updated":      false,
+		}))
+	})
+	It(`Retries the sinks that fail and resumes from the cursor`, func() {
+		notify("a", "notification", "success", 1000, "")
+		notify("b", "notification", "success", 2000, "")
+		notify("c", "notification", "success", 2000, "")
+		hook.failures = 2
+		var errs []error
+		var mu sync.Mutex
+		newRelay := func() *relay.Relay {
+			return &relay.Relay{
+				Service:       console,
+				Sinks:         []relay.Sink{&relay.WebhookSink{URL: hook.server.URL, Secret: secret}},
+				Cursor:        &relay.FileCursor{Path: filepath.Join(dir, "cursor.json")},
+				PollInterval:  10 * time.Millisecond,
+				RetryInterval: time.Millisecond,
+				OnError: func(err error) {
+					mu.Lock()
+					defer mu.Unlock()
+					errs = append(errs, err)
+				},
+			}
+		}
+
+		stop := run(newRelay())
+		Eventually(hook.ids).Should(ConsistOf("a", "b", "c"))
+		stop()
+		mu.Lock()
+		Expect(errs).To(HaveLen(2))
+		Expect(errs[0].Error()).To(HaveSuffix("answered with HTTP 503"))
+		mu.Unlock()
+
+		cursor, err := (&relay.FileCursor{Path: filepath.Join(dir, "cursor.json")}).Load()
+		Expect(err).To(BeNil())
+		Expect(cursor.TsDisplay).To(Equal(float64(2000)))
+		Expect(cursor.IDs).To(ConsistOf("b", "c"))
+
+		notify("d", "notification", "success", 2000, "")
+		notify("e", "notification", "success", 3000, "")
+		stop = run(newRelay())
+		Eventually(hook.ids).Should(ConsistOf("a", "b", "c", "d", "e"))
+		Consistently(hook.ids, 50*time.Millisecond).Should(HaveLen(5))
+		stop()
+	})
+	It(`Forwards the status changes of the pending notifications after a restart`, func() {
+		notify("a", "notification", "pending", 1000, "")
+		notify("b", "notification", "pending", 2000, "")
+		notify("c", "notification", "success", 3000, "")
+		cursorFile := filepath.Join(dir, "cursor.json")
+		all := newWebhook(secret)
+		defer all.server.Close()
+		newRelay := func(hook *webhook, statuses []string, path string) *relay.Relay {
+			return &relay.Relay{
+				Service:      console,
+				Sinks:        []relay.Sink{&relay.WebhookSink{URL: hook.server.URL, Secret: secret}},
+				Filter:       relay.Filter{Statuses: statuses},
+				Cursor:       &relay.FileCursor{Path: path},
+				PollInterval: 10 * time.Millisecond,
+			}
+		}
+		updated := func(hook *webhook) []bool {
+			hook.mu.Lock()
+			defer hook.mu.Unlock()
+			var updated []bool
+			for _, body := range hook.bodies {
+				var event relay.Event
+				Expect(json.Unmarshal(body, &event)).To(Succeed())
+				updated = append(updated, event.Updated)
+			}
+			return updated
+		}
+
+		stopAll := run(newRelay(all, nil, filepath.Join(dir, "all.json")))
+		stop := run(newRelay(hook, []string{"success", "error"}, cursorFile))
+		Eventually(all.ids).Should(Equal([]string{"a", "b", "c"}))
+		Eventually(hook.ids).Should(Equal([]string{"c"}))
+		stopAll()
+		stop()
+
+		cursor, err := (&relay.FileCursor{Path: cursorFile}).Load()
+		Expect(err).To(BeNil())
+		Expect(cursor.TsDisplay).To(Equal(float64(3000)))
+		Expect(cursor.Pending).To(Equal([]relay.PendingNotification{
+			{ID: "a", TsDisplay: 1000, Status: "pending", Message: "a pending"},
+			{ID: "b", TsDisplay: 2000, Status: "pending", Message: "b pending"},
+		}))
+
+		console.UpdateNotification("b", "error", "b error")
+		stopAll = run(newRelay(all, nil, filepath.Join(dir, "all.json")))
+		stop = run(newRelay(hook, []string{"success", "error"}, cursorFile))
+		Eventually(all.ids).Should(Equal([]string{"a", "b", "c", "b"}))
+		Eventually(hook.ids).Should(Equal([]string{"c", "b"}))
+		Consistently(all.ids, 50*time.Millisecond).Should(HaveLen(4))
+		Consistently(hook.ids, 50*time.Millisecond).Should(HaveLen(2))
+		stopAll()
+		stop()
+		Expect(updated(all)).To(Equal([]bool{false, false, false, true}))
+		Expect(updated(hook)).To(Equal([]bool{false, false}))
+
+		cursor, err = (&relay.FileCursor{Path: cursorFile}).Load()
+		Expect(err).To(BeNil())
+		Expect(cursor.Pending).To(Equal([]relay.PendingNotification{
+			{ID: "a", TsDisplay: 1000, Status: "pending", Message: "a pending"},
+		}))
+	})
+	It(`Formats Slack messages`, func() {
+		payload, err := relay.SlackPayload(&relay.Event{NotificationData: blockchainv3.NotificationData{
+			Status:  core.StringPtr("error"),
+			Message: core.StringPtr("could not restart peer1"),
+			By:      core.StringPtr("admin@example.com"),
+		}})
+		Expect(err).To(BeNil())
+		Expect(string(payload)).To(Equal(`{"text":"[error] could not restart peer1 (by admin@example.com)"}`))
+	})
+})
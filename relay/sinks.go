@@ -0,0 +1,165 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package relay
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"github.com/IBM/go-sdk-core/v4/core"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// SignatureHeader is the header of the HMAC-SHA256 signature of the body of the webhook requests.
+const SignatureHeader = "X-Signature-256"
+
+// Sign returns the signature of a webhook body: "sha256=" followed by the hex encoded HMAC-SHA256 of the body.
+func Sign(secret, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify returns true if the signature of a webhook body is valid, in constant time.
+func Verify(secret, body []byte, signature string) bool {
+	return hmac.Equal([]byte(Sign(secret, body)), []byte(signature))
+}
+
+// WebhookSink : Posts the events to an HTTP endpoint.
+type WebhookSink struct {
+	URL string
+
+	// The HMAC key of the SignatureHeader header. The requests are not signed when it is empty.
+	Secret []byte
+
+	// Encodes the events. Defaults to their JSON encoding; SlackPayload suits Slack-style incoming webhooks.
+	Payload func(event *Event) ([]byte, error)
+
+	// Additional headers, e.g. for authentication.
+	Headers map[string]string
+
+	// Defaults to a client with a 30 second timeout.
+	Client *http.Client
+}
+
+// Send posts an event and fails unless the endpoint answers with a 2xx status code.
+func (sink *WebhookSink) Send(ctx context.Context, event *Event) error {
+	payload := sink.Payload
+	if payload == nil {
+		payload = JSONPayload
+	}
+	body, err := payload(event)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest(http.MethodPost, sink.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for name, value := range sink.Headers {
+		req.Header.Set(name, value)
+	}
+	if len(sink.Secret) > 0 {
+		req.Header.Set(SignatureHeader, Sign(sink.Secret, body))
+	}
+	client := sink.Client
+	if client == nil {
+		client = &http.Client{Timeout: 30 * time.Second}
+	}
+	resp, err := client.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	io.Copy(ioutil.Discard, resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("%s answered with HTTP %d", sink.URL, resp.StatusCode)
+	}
+	return nil
+}
+
+// JSONPayload encodes an event as JSON.
+func JSONPayload(event *Event) ([]byte, error) {
+	return json.Marshal(event)
+}
+
+// SlackPayload encodes an event as the {"text": "..."} message of Slack-style incoming webhooks.
+func SlackPayload(event *Event) ([]byte, error) {
+	text := fmt.Sprintf("[%s] %s", core.StringNilMapper(event.Status), core.StringNilMapper(event.Message))
+	if event.By != nil {
+		text += " (by " + *event.By + ")"
+	}
+	return json.Marshal(map[string]string{"text": strings.TrimSpace(text)})
+}
+
+// JSONLinesSink : Writes the events as JSON, one per line.
+type JSONLinesSink struct {
+	mu sync.Mutex
+	w  io.Writer
+	f  *os.File
+}
+
+// NewJSONLinesSink returns a sink writing to w, e.g. os.Stdout.
+func NewJSONLinesSink(w io.Writer) *JSONLinesSink {
+	return &JSONLinesSink{w: w}
+}
+
+// OpenJSONLinesFile returns a sink appending to a file, which is created if needed. Every event is flushed to disk
+// before Send returns.
+func OpenJSONLinesFile(path string) (*JSONLinesSink, error) {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0600)
+	if err != nil {
+		return nil, err
+	}
+	return &JSONLinesSink{w: f, f: f}, nil
+}
+
+// Send writes an event on its own line.
+func (sink *JSONLinesSink) Send(ctx context.Context, event *Event) error {
+	line, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	sink.mu.Lock()
+	defer sink.mu.Unlock()
+	if _, err := sink.w.Write(append(line, '\n')); err != nil {
+		return err
+	}
+	if sink.f != nil {
+		return sink.f.Sync()
+	}
+	return nil
+}
+
+// Close closes the file opened by OpenJSONLinesFile. It does nothing for the other sinks.
+func (sink *JSONLinesSink) Close() error {
+	if sink.f != nil {
+		return sink.f.Close()
+	}
+	return nil
+}
@@ -0,0 +1,234 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package renew
+
+import (
+	"context"
+	"fmt"
+	"github.com/IBM-Blockchain/ibp-go-sdk/blockchainv3"
+	"github.com/IBM-Blockchain/ibp-go-sdk/certs"
+	"github.com/IBM-Blockchain/ibp-go-sdk/clock"
+	"github.com/IBM-Blockchain/ibp-go-sdk/rolling"
+	"github.com/IBM/go-sdk-core/v4/core"
+	"time"
+)
+
+// Defaults of the ExecuteOptions verification settings.
+const (
+	DefaultVerifyTimeout  = 10 * time.Minute
+	DefaultVerifyInterval = 15 * time.Second
+)
+
+// ExecuteOptions : The Execute options.
+type ExecuteOptions struct {
+	// Report the renewals of the plan without requesting any action.
+	DryRun bool
+
+	// Checks the health of a component: before an orderer restarts, for the other nodes of its cluster, and after every
+	// renewal, for the renewed component. Defaults to BlockchainV3API.WaitForComponentReady with ReadyOptions.
+	WaitForReady func(ctx context.Context, componentID string) error
+
+	// The options used by the default WaitForReady.
+	ReadyOptions *blockchainv3.WaitForComponentReadyOptions
+
+	// How long to wait for the console to report the renewed certificates, and how often to check. Default to
+	// DefaultVerifyTimeout and DefaultVerifyInterval.
+	VerifyTimeout, VerifyInterval time.Duration
+
+	// The clock used to wait between verifications. Defaults to the system clock.
+	Clock Clock
+}
+
+// Result : The outcome of Execute.
+type Result struct {
+	// The renewals that completed and were verified, in order. In a dry run, the renewals of the plan.
+	Renewals []*Renewal
+
+	DryRun bool
+}
+
+// QuorumError : The certificates of an orderer were not renewed because too few of the other nodes of its cluster are
+// ready.
+type QuorumError = rolling.QuorumError
+
+// NotRenewedError : The console still reports the old expiry date of a certificate after the renewal.
+type NotRenewedError struct {
+	ComponentID string
+
+	Field string
+
+	// The expiry date the console reports.
+	NotAfter time.Time
+}
+
+func (e *NotRenewedError) Error() string {
+	return fmt.Sprintf("%s: the certificate in %s still expires on %s", e.ComponentID, e.Field, e.NotAfter.Format(time.RFC3339))
+}
+
+// Execute renews the certificates of the plan one component at a time. Before an orderer restarts, it checks that
+// enough of the other nodes of its cluster are ready to keep quorum; after every renewal it waits for the component to
+// be ready and for the console to report certificates that expire later than the old ones.
+// It stops at the first failure and returns the renewals completed so far along with the error, so that the plan can be
+// computed and executed again once the problem is fixed.
+func Execute(ctx context.Context, service blockchainv3.BlockchainV3API, plan *Plan, options *ExecuteOptions) (*Result, error) {
+	if options == nil {
+		options = new(ExecuteOptions)
+	}
+	if options.DryRun {
+		return &Result{Renewals: plan.Renewals, DryRun: true}, nil
+	}
+	waitForReady := options.WaitForReady
+	if waitForReady == nil {
+		waitForReady = func(ctx context.Context, componentID string) error {
+			return service.WaitForComponentReady(ctx, componentID, options.ReadyOptions)
+		}
+	}
+
+	result := new(Result)
+	for _, renewal := range plan.Renewals {
+		if err := execute(ctx, service, renewal, waitForReady, options); err != nil {
+			return result, fmt.Errorf("renew the certificates of %s: %w", renewal.ComponentID, err)
+		}
+		result.Renewals = append(result.Renewals, renewal)
+	}
+	return result, nil
+}
+
+func execute(ctx context.Context, service blockchainv3.BlockchainV3API, renewal *Renewal, waitForReady func(context.Context, string) error, options *ExecuteOptions) error {
+	if err := rolling.CheckQuorum(ctx, renewal.ComponentID, renewal.ClusterNodes, renewal.Quorum, waitForReady); err != nil {
+		return err
+	}
+
+	var err error
+	switch renewal.Type {
+	case TypeCA:
+		actionOptions := service.NewCaActionOptions(renewal.ComponentID)
+		actionOptions.Restart = core.BoolPtr(true)
+		actionOptions.Renew = &blockchainv3.ActionRenew{TlsCert: core.BoolPtr(true)}
+		_, _, err = service.CaActionWithContext(ctx, actionOptions)
+	case TypePeer:
+		actionOptions := service.NewPeerActionOptions(renewal.ComponentID)
+		actionOptions.Restart = core.BoolPtr(true)
+		actionOptions.Reenroll, actionOptions.Enroll = enrollments(renewal)
+		_, _, err = service.PeerActionWithContext(ctx, actionOptions)
+	case TypeOrderer:
+		actionOptions := service.NewOrdererActionOptions(renewal.ComponentID)
+		actionOptions.Restart = core.BoolPtr(true)
+		actionOptions.Reenroll, actionOptions.Enroll = enrollments(renewal)
+		_, _, err = service.OrdererActionWithContext(ctx, actionOptions)
+	default:
+		err = fmt.Errorf("the certificates of components of type %q cannot be renewed", renewal.Type)
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := waitForReady(ctx, renewal.ComponentID); err != nil {
+		return err
+	}
+	return verify(ctx, service, renewal, options)
+}
+
+// enrollments returns the re-enrollment of the certificates of a peer or an orderer that are still valid, and the
+// enrollment of those that have expired.
+func enrollments(renewal *Renewal) (*blockchainv3.ActionReenroll, *blockchainv3.ActionEnroll) {
+	var reenroll *blockchainv3.ActionReenroll
+	var enroll *blockchainv3.ActionEnroll
+	for _, cert := range renewal.Certs {
+		if renewal.expired(cert.Field) {
+			if enroll == nil {
+				enroll = new(blockchainv3.ActionEnroll)
+			}
+			if cert.Field == FieldEcert {
+				enroll.Ecert = core.BoolPtr(true)
+			} else {
+				enroll.TlsCert = core.BoolPtr(true)
+			}
+			continue
+		}
+		if reenroll == nil {
+			reenroll = new(blockchainv3.ActionReenroll)
+		}
+		if cert.Field == FieldEcert {
+			reenroll.Ecert = core.BoolPtr(true)
+		} else {
+			reenroll.TlsCert = core.BoolPtr(true)
+		}
+	}
+	return reenroll, enroll
+}
+
+// verify waits until the console reports certificates that expire later than the renewed ones, and records them.
+func verify(ctx context.Context, service blockchainv3.BlockchainV3API, renewal *Renewal, options *ExecuteOptions) error {
+	clock := clock.OrSystem(options.Clock)
+	timeout, interval := options.VerifyTimeout, options.VerifyInterval
+	if timeout == 0 {
+		timeout = DefaultVerifyTimeout
+	}
+	if interval == 0 {
+		interval = DefaultVerifyInterval
+	}
+	deadline := clock.Now().Add(timeout)
+	getOptions := service.NewGetComponentOptions(renewal.ComponentID)
+	getOptions.SetCache(blockchainv3.GetComponentOptions_Cache_Skip)
+	for {
+		component, _, err := service.GetComponentWithContext(ctx, getOptions)
+		if err != nil {
+			return fmt.Errorf("could not get the renewed certificates: %w", err)
+		}
+		renewed, err := compare(renewal, certs.ComponentCertificates(component))
+		if err == nil {
+			renewal.Renewed = renewed
+			return nil
+		}
+		if !clock.Now().Before(deadline) {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-clock.After(interval):
+		}
+	}
+}
+
+// compare returns the current certificates of the renewed fields, or a *NotRenewedError if one of them does not expire
+// later than before.
+func compare(renewal *Renewal, current []certs.Certificate) ([]certs.Certificate, error) {
+	var renewed []certs.Certificate
+	for _, old := range renewal.Certs {
+		cert := find(current, old.Field)
+		if cert == nil {
+			return nil, &NotRenewedError{ComponentID: renewal.ComponentID, Field: old.Field, NotAfter: old.NotAfter}
+		}
+		if !cert.NotAfter.After(old.NotAfter) {
+			return nil, &NotRenewedError{ComponentID: renewal.ComponentID, Field: old.Field, NotAfter: cert.NotAfter}
+		}
+		renewed = append(renewed, *cert)
+	}
+	return renewed, nil
+}
+
+// find returns the parsed certificate in the field, or nil if there is none.
+func find(certificates []certs.Certificate, field string) *certs.Certificate {
+	for i := range certificates {
+		if certificates[i].Field == field && certificates[i].Error == "" {
+			return &certificates[i]
+		}
+	}
+	return nil
+}
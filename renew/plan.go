@@ -0,0 +1,204 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package renew renews the enrollment and TLS certificates of a console's components before they expire.
+package renew
+
+import (
+	"context"
+	"fmt"
+	"github.com/IBM-Blockchain/ibp-go-sdk/blockchainv3"
+	"github.com/IBM-Blockchain/ibp-go-sdk/certs"
+	"github.com/IBM-Blockchain/ibp-go-sdk/clock"
+	"github.com/IBM-Blockchain/ibp-go-sdk/rolling"
+	"github.com/IBM/go-sdk-core/v4/core"
+	"strings"
+	"time"
+)
+
+// DefaultWindow is how long before they expire certificates are renewed by default.
+const DefaultWindow = 30 * 24 * time.Hour
+
+// The component types whose certificates can be renewed.
+const (
+	TypeCA      = rolling.TypeCA
+	TypePeer    = rolling.TypePeer
+	TypeOrderer = rolling.TypeOrderer
+)
+
+// The fields of the certificates that can be renewed. CAs only renew their TLS certificate.
+const (
+	FieldEcert   = "msp.component.ecert"
+	FieldTlsCert = "msp.component.tls_cert"
+)
+
+// locationDeployed is the location of the components the console deploys. Imported components cannot be re-enrolled.
+const locationDeployed = "ibm_saas"
+
+// PlanOptions : The ComputePlan options.
+type PlanOptions struct {
+	// Renew the certificates that expire within the window. Defaults to DefaultWindow.
+	Window time.Duration
+
+	// Only renew the certificates of these components. All the deployed components by default.
+	ComponentIDs []string
+
+	// Allow renewing the certificates of the nodes of raft clusters of fewer than three nodes, which lose quorum while a
+	// node restarts.
+	AllowQuorumLoss bool
+
+	// The clock the expiry dates are compared with. Defaults to the system clock.
+	Clock Clock
+}
+
+// Renewal : The renewal of the certificates of one component.
+type Renewal struct {
+	ComponentID string
+
+	DisplayName string
+
+	// One of TypeCA, TypePeer and TypeOrderer.
+	Type string
+
+	// The raft cluster of an orderer.
+	ClusterID string
+
+	// The certificates to renew, as they were when the plan was computed.
+	Certs []certs.Certificate
+
+	// The fields of the certificates that have already expired. They cannot be re-enrolled and are enrolled again.
+	Expired []string
+
+	// The other nodes of the raft cluster of an orderer.
+	ClusterNodes []string
+
+	// How many of ClusterNodes must be ready before the orderer restarts, so that the cluster keeps its quorum.
+	Quorum int
+
+	// The renewed certificates, once Execute has verified them.
+	Renewed []certs.Certificate
+}
+
+// expired returns true if the certificate in the field has already expired.
+func (renewal *Renewal) expired(field string) bool {
+	for _, expired := range renewal.Expired {
+		if expired == field {
+			return true
+		}
+	}
+	return false
+}
+
+// Plan : The ordered renewals: CAs first, then the orderers one at a time, then the peers.
+type Plan struct {
+	Renewals []*Renewal
+}
+
+// String renders the plan for humans, one line per certificate.
+func (plan *Plan) String() string {
+	var b strings.Builder
+	for _, renewal := range plan.Renewals {
+		for _, cert := range renewal.Certs {
+			action := "reenroll"
+			if renewal.Type == TypeCA {
+				action = "renew"
+			} else if renewal.expired(cert.Field) {
+				action = "enroll"
+			}
+			fmt.Fprintf(&b, "%s/%s: %s %s (expires %s)\n", renewal.Type, renewal.ComponentID, action, cert.Field,
+				cert.NotAfter.Format(time.RFC3339))
+		}
+	}
+	return b.String()
+}
+
+// ComputePlan plans the renewal of the certificates of the deployed components that expire within the window. CAs
+// renew their TLS certificate; peers and orderers re-enroll their enrollment and TLS certificates, or enroll them again
+// if they have already expired. CAs are renewed first, then the orderers one at a time, cluster by cluster, then the
+// peers. It fails if a raft cluster would lose its quorum and AllowQuorumLoss is not set.
+func ComputePlan(ctx context.Context, service blockchainv3.BlockchainV3API, options *PlanOptions) (*Plan, error) {
+	if options == nil {
+		options = new(PlanOptions)
+	}
+	window := options.Window
+	if window == 0 {
+		window = DefaultWindow
+	}
+	now := clock.OrSystem(options.Clock).Now()
+
+	listOptions := service.NewListComponentsOptions()
+	listOptions.SetParsedCerts(blockchainv3.ListComponentsOptions_ParsedCerts_Included)
+	list, _, err := service.ListComponentsWithContext(ctx, listOptions)
+	if err != nil {
+		return nil, fmt.Errorf("could not list the components: %w", err)
+	}
+	selected := map[string]bool{}
+	for _, id := range options.ComponentIDs {
+		selected[id] = true
+	}
+	clusters := rolling.Clusters{}
+	for _, c := range list.Components {
+		if core.StringNilMapper(c.Type) == TypeOrderer {
+			clusters.Add(core.StringNilMapper(c.ClusterID), core.StringNilMapper(c.ID))
+		}
+	}
+
+	var renewals []*Renewal
+	for i := range list.Components {
+		component := &list.Components[i]
+		componentType := core.StringNilMapper(component.Type)
+		if componentType != TypeCA && componentType != TypePeer && componentType != TypeOrderer {
+			continue
+		}
+		if core.StringNilMapper(component.Location) != locationDeployed {
+			continue
+		}
+		if len(selected) > 0 && !selected[core.StringNilMapper(component.ID)] {
+			continue
+		}
+		renewal := &Renewal{
+			ComponentID: core.StringNilMapper(component.ID),
+			DisplayName: core.StringNilMapper(component.DisplayName),
+			Type:        componentType,
+			ClusterID:   core.StringNilMapper(component.ClusterID),
+		}
+		for _, cert := range certs.ExpiringWithin(certs.ComponentCertificates(component), now, window) {
+			if cert.Field != FieldTlsCert && (cert.Field != FieldEcert || componentType == TypeCA) {
+				continue
+			}
+			renewal.Certs = append(renewal.Certs, cert)
+			if !cert.NotAfter.After(now) {
+				renewal.Expired = append(renewal.Expired, cert.Field)
+			}
+		}
+		if len(renewal.Certs) == 0 {
+			continue
+		}
+		if componentType == TypeOrderer {
+			renewal.ClusterNodes, renewal.Quorum, err = clusters.Quorum(renewal.ComponentID, renewal.ClusterID, options.AllowQuorumLoss)
+			if err != nil {
+				return nil, err
+			}
+		}
+		renewals = append(renewals, renewal)
+	}
+
+	plan := &Plan{}
+	for _, i := range rolling.Order(len(renewals), func(i int) (string, string) { return renewals[i].Type, renewals[i].ClusterID }) {
+		plan.Renewals = append(plan.Renewals, renewals[i])
+	}
+	return plan, nil
+}
@@ -0,0 +1,258 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package renew_test
+
+import (
+	"context"
+	"crypto/x509/pkix"
+	"errors"
+	"github.com/IBM-Blockchain/ibp-go-sdk/blockchainv3"
+	"github.com/IBM-Blockchain/ibp-go-sdk/blockchainv3fake"
+	"github.com/IBM-Blockchain/ibp-go-sdk/internal/testutil"
+	"github.com/IBM-Blockchain/ibp-go-sdk/renew"
+	"github.com/IBM/go-sdk-core/v4/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"time"
+)
+
+func cert(notAfter time.Time) string {
+	return testutil.SelfSignedCert(pkix.Name{CommonName: "node"}, notAfter.Unix(), notAfter)
+}
+
+func crypto(ecertNotAfter, tlsNotAfter time.Time) *blockchainv3.CryptoObject {
+	crypto := testutil.CryptoObject()
+	crypto.Msp.Component.Ecert = core.StringPtr(cert(ecertNotAfter))
+	crypto.Msp.Component.TlsCert = core.StringPtr(cert(tlsNotAfter))
+	crypto.Msp.Component.AdminCerts = nil
+	return crypto
+}
+
+// renewingConsole issues certificates valid for a year when a peer or an orderer is (re)enrolled, unless stuck is set.
+// Like the console, it serves the components from a cache, as they were before the renewals, unless the cache is
+// skipped.
+type renewingConsole struct {
+	*blockchainv3fake.Console
+	clock  *testutil.Clock
+	stuck  bool
+	cached map[string]*blockchainv3.GenericComponentResponse
+}
+
+func (console *renewingConsole) GetComponentWithContext(ctx context.Context, options *blockchainv3.GetComponentOptions) (*blockchainv3.GenericComponentResponse, *core.DetailedResponse, error) {
+	if cached, ok := console.cached[*options.ID]; ok && (options.Cache == nil || *options.Cache != blockchainv3.GetComponentOptions_Cache_Skip) {
+		return cached, nil, nil
+	}
+	return console.Console.GetComponentWithContext(ctx, options)
+}
+
+func (console *renewingConsole) renewed(reenroll *blockchainv3.ActionReenroll, enroll *blockchainv3.ActionEnroll) *blockchainv3.UpdateMspCryptoField {
+	component := &blockchainv3.UpdateMspCryptoFieldComponent{}
+	notAfter := console.clock.Now().AddDate(1, 0, 0)
+	if (reenroll != nil && reenroll.Ecert != nil) || (enroll != nil && enroll.Ecert != nil) {
+		component.Ecert = core.StringPtr(cert(notAfter))
+	}
+	if (reenroll != nil && reenroll.TlsCert != nil) || (enroll != nil && enroll.TlsCert != nil) {
+		component.TlsCert = core.StringPtr(cert(notAfter))
+	}
+	return &blockchainv3.UpdateMspCryptoField{Component: component}
+}
+
+func (console *renewingConsole) PeerActionWithContext(ctx context.Context, options *blockchainv3.PeerActionOptions) (*blockchainv3.ActionsResponse, *core.DetailedResponse, error) {
+	result, response, err := console.Console.PeerActionWithContext(ctx, options)
+	if err == nil && !console.stuck {
+		updateOptions := console.NewUpdatePeerOptions(*options.ID)
+		updateOptions.Crypto = &blockchainv3.UpdatePeerBodyCrypto{Msp: console.renewed(options.Reenroll, options.Enroll)}
+		_, _, err = console.UpdatePeerWithContext(ctx, updateOptions)
+	}
+	return result, response, err
+}
+
+func (console *renewingConsole) OrdererActionWithContext(ctx context.Context, options *blockchainv3.OrdererActionOptions) (*blockchainv3.ActionsResponse, *core.DetailedResponse, error) {
+	result, response, err := console.Console.OrdererActionWithContext(ctx, options)
+	if err == nil && !console.stuck {
+		updateOptions := console.NewUpdateOrdererOptions(*options.ID)
+		updateOptions.Crypto = &blockchainv3.UpdateOrdererBodyCrypto{Msp: console.renewed(options.Reenroll, options.Enroll)}
+		_, _, err = console.UpdateOrdererWithContext(ctx, updateOptions)
+	}
+	return result, response, err
+}
+
+var _ = Describe(`Renew`, func() {
+	var console *renewingConsole
+	var clock *testutil.Clock
+	var ordererIDs []string
+
+	BeforeEach(func() {
+		clock = testutil.NewClock(time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC))
+		console = &renewingConsole{Console: blockchainv3fake.NewConsole(), clock: clock}
+		nextYear := clock.Now().AddDate(1, 0, 0)
+
+		peerOptions := console.NewCreatePeerOptions("org1msp", "Peer", crypto(clock.Now().AddDate(0, 0, 10), clock.Now().AddDate(0, 0, -1)))
+		peerOptions.SetID("peer")
+		_, _, err := console.CreatePeer(peerOptions)
+		Expect(err).To(BeNil())
+
+		ordererOptions := console.NewCreateOrdererOptions("raft", "osmsp", "Orderer", []blockchainv3.CryptoObject{
+			*crypto(clock.Now().AddDate(0, 0, 5), nextYear), *crypto(nextYear, nextYear), *crypto(nextYear, nextYear),
+		})
+		created, _, err := console.CreateOrderer(ordererOptions)
+		Expect(err).To(BeNil())
+		ordererIDs = nil
+		for _, node := range created.Created {
+			ordererIDs = append(ordererIDs, *node.ID)
+		}
+
+		importOptions := console.NewImportPeerOptions("Imported", "https://proxy", &blockchainv3.MspCryptoField{
+			Tlsca:     &blockchainv3.MspCryptoFieldTlsca{RootCerts: []string{"tlsroot"}},
+			Component: &blockchainv3.MspCryptoFieldComponent{TlsCert: core.StringPtr(cert(clock.Now()))},
+		}, "org2msp")
+		_, _, err = console.ImportPeer(importOptions)
+		Expect(err).To(BeNil())
+
+		console.cached = map[string]*blockchainv3.GenericComponentResponse{}
+		for _, id := range append([]string{"peer"}, ordererIDs...) {
+			console.cached[id], _, err = console.Console.GetComponent(console.NewGetComponentOptions(id))
+			Expect(err).To(BeNil())
+		}
+	})
+
+	It(`Plans the renewal of the certificates expiring within the window`, func() {
+		plan, err := renew.ComputePlan(context.Background(), console, &renew.PlanOptions{Clock: clock})
+		Expect(err).To(BeNil())
+		Expect(plan.Renewals).To(HaveLen(2))
+
+		orderer := plan.Renewals[0]
+		Expect(orderer.ComponentID).To(Equal(ordererIDs[0]))
+		Expect(orderer.Certs).To(HaveLen(1))
+		Expect(orderer.Certs[0].Field).To(Equal(renew.FieldEcert))
+		Expect(orderer.Expired).To(BeEmpty())
+		Expect(orderer.ClusterNodes).To(Equal(ordererIDs[1:]))
+		Expect(orderer.Quorum).To(Equal(2))
+
+		peer := plan.Renewals[1]
+		Expect(peer.ComponentID).To(Equal("peer"))
+		Expect(peer.Certs).To(HaveLen(2))
+		Expect(peer.Expired).To(Equal([]string{renew.FieldTlsCert}))
+		Expect(plan.String()).To(Equal(
+			"fabric-orderer/" + ordererIDs[0] + ": reenroll msp.component.ecert (expires 2021-06-06T00:00:00Z)\n" +
+				"fabric-peer/peer: enroll msp.component.tls_cert (expires 2021-05-31T00:00:00Z)\n" +
+				"fabric-peer/peer: reenroll msp.component.ecert (expires 2021-06-11T00:00:00Z)\n"))
+
+		plan, err = renew.ComputePlan(context.Background(), console, &renew.PlanOptions{Clock: clock, Window: 7 * 24 * time.Hour})
+		Expect(err).To(BeNil())
+		Expect(plan.Renewals).To(HaveLen(2))
+		Expect(plan.Renewals[1].Certs).To(HaveLen(1))
+
+		plan, err = renew.ComputePlan(context.Background(), console, &renew.PlanOptions{Clock: clock, ComponentIDs: []string{"peer"}})
+		Expect(err).To(BeNil())
+		Expect(plan.Renewals).To(HaveLen(1))
+	})
+	It(`Refuses to restart the node of a small raft cluster`, func() {
+		ordererOptions := console.NewCreateOrdererOptions("raft", "osmsp", "Solo", []blockchainv3.CryptoObject{*crypto(clock.Now(), clock.Now())})
+		ordererOptions.SetID("solo")
+		_, _, err := console.CreateOrderer(ordererOptions)
+		Expect(err).To(BeNil())
+
+		_, err = renew.ComputePlan(context.Background(), console, &renew.PlanOptions{Clock: clock})
+		Expect(err).To(MatchError("solo: the raft cluster has 1 node(s) and loses its quorum while a node restarts"))
+		plan, err := renew.ComputePlan(context.Background(), console, &renew.PlanOptions{Clock: clock, AllowQuorumLoss: true})
+		Expect(err).To(BeNil())
+		Expect(plan.Renewals).To(HaveLen(3))
+	})
+	It(`Does nothing in a dry run`, func() {
+		plan, err := renew.ComputePlan(context.Background(), console, &renew.PlanOptions{Clock: clock})
+		Expect(err).To(BeNil())
+		result, err := renew.Execute(context.Background(), console, plan, &renew.ExecuteOptions{DryRun: true})
+		Expect(err).To(BeNil())
+		Expect(result.DryRun).To(BeTrue())
+		Expect(result.Renewals).To(Equal(plan.Renewals))
+		Expect(console.Actions("peer")).To(BeEmpty())
+		Expect(console.Actions(ordererIDs[0])).To(BeEmpty())
+	})
+	It(`Renews the certificates and verifies their new expiry dates`, func() {
+		plan, err := renew.ComputePlan(context.Background(), console, &renew.PlanOptions{Clock: clock})
+		Expect(err).To(BeNil())
+		result, err := renew.Execute(context.Background(), console, plan, &renew.ExecuteOptions{Clock: clock})
+		Expect(err).To(BeNil())
+		Expect(result.Renewals).To(HaveLen(2))
+		Expect(console.Actions(ordererIDs[0])).To(Equal([]string{"restart", "reenroll_ecert"}))
+		Expect(console.Actions(ordererIDs[1])).To(BeEmpty())
+		Expect(console.Actions("peer")).To(Equal([]string{"restart", "reenroll_ecert", "enroll_tls_cert"}))
+		for _, renewal := range result.Renewals {
+			Expect(renewal.Renewed).To(HaveLen(len(renewal.Certs)))
+			for _, renewed := range renewal.Renewed {
+				Expect(renewed.NotAfter).To(Equal(clock.Now().AddDate(1, 0, 0)))
+			}
+		}
+
+		plan, err = renew.ComputePlan(context.Background(), console, &renew.PlanOptions{Clock: clock})
+		Expect(err).To(BeNil())
+		Expect(plan.Renewals).To(BeEmpty())
+	})
+	It(`Keeps the quorum of raft clusters`, func() {
+		console.SetComponentReady(ordererIDs[1], false)
+		console.SetComponentReady(ordererIDs[2], false)
+		plan, err := renew.ComputePlan(context.Background(), console, &renew.PlanOptions{Clock: clock})
+		Expect(err).To(BeNil())
+		result, err := renew.Execute(context.Background(), console, plan, &renew.ExecuteOptions{Clock: clock})
+		var quorumErr *renew.QuorumError
+		Expect(errors.As(err, &quorumErr)).To(BeTrue())
+		Expect(quorumErr.Ready).To(Equal(0))
+		Expect(quorumErr.Quorum).To(Equal(2))
+		Expect(result.Renewals).To(BeEmpty())
+		Expect(console.Actions(ordererIDs[0])).To(BeEmpty())
+		Expect(console.Actions("peer")).To(BeEmpty())
+	})
+	It(`Fails when the console keeps reporting the old certificates`, func() {
+		console.stuck = true
+		start := clock.Now()
+		plan, err := renew.ComputePlan(context.Background(), console, &renew.PlanOptions{Clock: clock})
+		Expect(err).To(BeNil())
+		_, err = renew.Execute(context.Background(), console, plan, &renew.ExecuteOptions{
+			Clock:          clock,
+			VerifyTimeout:  time.Minute,
+			VerifyInterval: 10 * time.Second,
+		})
+		var notRenewedErr *renew.NotRenewedError
+		Expect(errors.As(err, &notRenewedErr)).To(BeTrue())
+		Expect(notRenewedErr.ComponentID).To(Equal(ordererIDs[0]))
+		Expect(notRenewedErr.Field).To(Equal(renew.FieldEcert))
+		Expect(notRenewedErr.NotAfter).To(Equal(start.AddDate(0, 0, 5)))
+		Expect(clock.Now()).To(Equal(start.Add(time.Minute)))
+	})
+	It(`Runs on a schedule`, func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		var results []*renew.Result
+		scheduler := &renew.Scheduler{
+			Service:  console,
+			Interval: time.Hour,
+			Clock:    clock,
+			OnRun: func(result *renew.Result, err error) {
+				Expect(err).To(BeNil())
+				results = append(results, result)
+				if len(results) == 2 {
+					cancel()
+				}
+			},
+		}
+		Expect(scheduler.Run(ctx)).To(Equal(context.Canceled))
+		Expect(results).To(HaveLen(2))
+		Expect(results[0].Renewals).To(HaveLen(2))
+		Expect(results[1].Renewals).To(BeEmpty())
+	})
+})
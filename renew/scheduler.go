@@ -0,0 +1,94 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package renew
+
+import (
+	"context"
+	"github.com/IBM-Blockchain/ibp-go-sdk/blockchainv3"
+	"github.com/IBM-Blockchain/ibp-go-sdk/clock"
+	"time"
+)
+
+// DefaultInterval is how often a Scheduler looks for certificates to renew by default.
+const DefaultInterval = 24 * time.Hour
+
+// Clock : The source of time of the renewals, shared with the other helper packages.
+type Clock = clock.Clock
+
+// Scheduler : Periodically renews the certificates that are about to expire.
+type Scheduler struct {
+	Service blockchainv3.BlockchainV3API
+
+	// How often to look for certificates to renew. Defaults to DefaultInterval.
+	Interval time.Duration
+
+	PlanOptions *PlanOptions
+
+	ExecuteOptions *ExecuteOptions
+
+	// The clock of the scheduler, used instead of the clocks of PlanOptions and ExecuteOptions when they have none.
+	// Defaults to the system clock.
+	Clock Clock
+
+	// Called with the outcome of every run, if set.
+	OnRun func(result *Result, err error)
+}
+
+// RunOnce computes a renewal plan and executes it.
+func (scheduler *Scheduler) RunOnce(ctx context.Context) (*Result, error) {
+	planOptions := PlanOptions{}
+	if scheduler.PlanOptions != nil {
+		planOptions = *scheduler.PlanOptions
+	}
+	executeOptions := ExecuteOptions{}
+	if scheduler.ExecuteOptions != nil {
+		executeOptions = *scheduler.ExecuteOptions
+	}
+	if planOptions.Clock == nil {
+		planOptions.Clock = scheduler.Clock
+	}
+	if executeOptions.Clock == nil {
+		executeOptions.Clock = scheduler.Clock
+	}
+	plan, err := ComputePlan(ctx, scheduler.Service, &planOptions)
+	if err != nil {
+		return nil, err
+	}
+	return Execute(ctx, scheduler.Service, plan, &executeOptions)
+}
+
+// Run calls RunOnce right away and then every Interval, until the context is done. A failed run does not stop the
+// scheduler: the next run computes a new plan. It returns the error of the context.
+func (scheduler *Scheduler) Run(ctx context.Context) error {
+	clock := clock.OrSystem(scheduler.Clock)
+	interval := scheduler.Interval
+	if interval == 0 {
+		interval = DefaultInterval
+	}
+	for ctx.Err() == nil {
+		result, err := scheduler.RunOnce(ctx)
+		if scheduler.OnRun != nil {
+			scheduler.OnRun(result, err)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-clock.After(interval):
+		}
+	}
+	return ctx.Err()
+}
@@ -0,0 +1,122 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package rolling orders the restarts of a rolling operation on a console's components, such as an upgrade or a
+// certificate renewal, so that no raft ordering service loses its quorum while one of its nodes restarts.
+package rolling
+
+import (
+	"context"
+	"fmt"
+	"github.com/IBM-Blockchain/ibp-go-sdk/blockchainv3"
+)
+
+// The component types a rolling operation restarts.
+const (
+	TypeCA      = blockchainv3.GenericComponentResponse_Type_FabricCa
+	TypePeer    = blockchainv3.GenericComponentResponse_Type_FabricPeer
+	TypeOrderer = blockchainv3.GenericComponentResponse_Type_FabricOrderer
+)
+
+// minClusterSize is the smallest raft cluster that keeps its quorum while one of its nodes restarts.
+const minClusterSize = 3
+
+// Clusters : The IDs of the nodes of each raft cluster, by cluster ID.
+type Clusters map[string][]string
+
+// Add records a node of a raft cluster. Nodes without a cluster ID are ignored.
+func (clusters Clusters) Add(clusterID, componentID string) {
+	if clusterID != "" {
+		clusters[clusterID] = append(clusters[clusterID], componentID)
+	}
+}
+
+// Quorum returns the other nodes of the cluster of an orderer, and how many of them must be ready before it restarts so
+// that the cluster keeps its quorum. It fails if the cluster has fewer than three nodes, unless allowQuorumLoss is set,
+// in which case the quorum is 0.
+func (clusters Clusters) Quorum(componentID, clusterID string, allowQuorumLoss bool) (others []string, quorum int, err error) {
+	nodes := clusters[clusterID]
+	if len(nodes) < minClusterSize && !allowQuorumLoss {
+		return nil, 0, fmt.Errorf("%s: the raft cluster has %d node(s) and loses its quorum while a node restarts", componentID, len(nodes))
+	}
+	for _, node := range nodes {
+		if node != componentID {
+			others = append(others, node)
+		}
+	}
+	if len(nodes) >= minClusterSize {
+		quorum = len(nodes)/2 + 1
+	}
+	return others, quorum, nil
+}
+
+// Order returns the indexes of n components in the order they restart: CAs first, then the orderers one at a time,
+// cluster by cluster in the order of each cluster's first node, then the peers. component returns the type and the
+// cluster ID of the component at an index; components of other types are left out.
+func Order(n int, component func(i int) (componentType, clusterID string)) []int {
+	var cas, peers, clusterOrder []int
+	var clusterIDs []string
+	orderers := map[string][]int{}
+	for i := 0; i < n; i++ {
+		componentType, clusterID := component(i)
+		switch componentType {
+		case TypeCA:
+			cas = append(cas, i)
+		case TypeOrderer:
+			if _, ok := orderers[clusterID]; !ok {
+				clusterIDs = append(clusterIDs, clusterID)
+			}
+			orderers[clusterID] = append(orderers[clusterID], i)
+		case TypePeer:
+			peers = append(peers, i)
+		}
+	}
+	for _, clusterID := range clusterIDs {
+		clusterOrder = append(clusterOrder, orderers[clusterID]...)
+	}
+	order := append(cas, clusterOrder...)
+	return append(order, peers...)
+}
+
+// QuorumError : An orderer was not restarted because too few of the other nodes of its cluster are ready.
+type QuorumError struct {
+	ComponentID string
+
+	// How many of the other nodes are ready, and how many must be.
+	Ready, Quorum int
+}
+
+func (e *QuorumError) Error() string {
+	return fmt.Sprintf("%s: only %d of the %d nodes needed for quorum are ready", e.ComponentID, e.Ready, e.Quorum)
+}
+
+// CheckQuorum checks that at least quorum of the other nodes of the cluster of an orderer are ready before it restarts,
+// and returns a *QuorumError otherwise. A quorum of 0 is not checked.
+func CheckQuorum(ctx context.Context, componentID string, clusterNodes []string, quorum int, waitForReady func(context.Context, string) error) error {
+	if quorum <= 0 {
+		return nil
+	}
+	ready := 0
+	for _, node := range clusterNodes {
+		if waitForReady(ctx, node) == nil {
+			ready++
+		}
+	}
+	if ready < quorum {
+		return &QuorumError{ComponentID: componentID, Ready: ready, Quorum: quorum}
+	}
+	return nil
+}
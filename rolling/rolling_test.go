@@ -0,0 +1,78 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package rolling_test
+
+import (
+	"context"
+	"errors"
+	"github.com/IBM-Blockchain/ibp-go-sdk/rolling"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Rolling`, func() {
+	It(`Orders CAs, then orderers cluster by cluster, then peers`, func() {
+		components := [][2]string{
+			{rolling.TypePeer, ""},
+			{rolling.TypeOrderer, "os2"},
+			{"msp", ""},
+			{rolling.TypeOrderer, "os1"},
+			{rolling.TypeCA, ""},
+			{rolling.TypeOrderer, "os2"},
+		}
+		order := rolling.Order(len(components), func(i int) (string, string) {
+			return components[i][0], components[i][1]
+		})
+		Expect(order).To(Equal([]int{4, 1, 5, 3, 0}))
+	})
+	It(`Computes the quorum of the other nodes of a cluster`, func() {
+		clusters := rolling.Clusters{}
+		clusters.Add("os1", "a")
+		clusters.Add("os1", "b")
+		clusters.Add("os1", "c")
+		clusters.Add("", "standalone")
+		others, quorum, err := clusters.Quorum("b", "os1", false)
+		Expect(err).To(BeNil())
+		Expect(others).To(Equal([]string{"a", "c"}))
+		Expect(quorum).To(Equal(2))
+	})
+	It(`Refuses clusters that lose their quorum unless allowed`, func() {
+		clusters := rolling.Clusters{}
+		clusters.Add("os1", "a")
+		clusters.Add("os1", "b")
+		_, _, err := clusters.Quorum("a", "os1", false)
+		Expect(err).To(MatchError("a: the raft cluster has 2 node(s) and loses its quorum while a node restarts"))
+		others, quorum, err := clusters.Quorum("a", "os1", true)
+		Expect(err).To(BeNil())
+		Expect(others).To(Equal([]string{"b"}))
+		Expect(quorum).To(Equal(0))
+	})
+	It(`Checks that enough of the other nodes are ready`, func() {
+		waitForReady := func(ctx context.Context, id string) error {
+			if id == "c" {
+				return errors.New("not ready")
+			}
+			return nil
+		}
+		Expect(rolling.CheckQuorum(context.Background(), "a", []string{"b", "c"}, 1, waitForReady)).To(Succeed())
+		Expect(rolling.CheckQuorum(context.Background(), "a", []string{"b", "c"}, 0, waitForReady)).To(Succeed())
+		err := rolling.CheckQuorum(context.Background(), "a", []string{"b", "c"}, 2, waitForReady)
+		var quorumErr *rolling.QuorumError
+		Expect(errors.As(err, &quorumErr)).To(BeTrue())
+		Expect(err).To(MatchError("a: only 1 of the 2 nodes needed for quorum are ready"))
+	})
+})
@@ -0,0 +1,178 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package settings
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/IBM-Blockchain/ibp-go-sdk/blockchainv3"
+	"reflect"
+	"strings"
+)
+
+// Change : A single setting whose live value differs from the desired one.
+type Change struct {
+	// The path of the setting in the EditSettings body, e.g. "inactivity_timeouts.max_idle_time".
+	Field string
+
+	// The live value, or empty when the console does not report the setting.
+	Current string
+
+	Desired string
+}
+
+// Plan : The changes that bring the settings of a console in line with the desired settings.
+type Plan struct {
+	Changes []Change
+
+	// The EditSettings request carrying only the changed settings. Nil when there is nothing to change.
+	Edit *blockchainv3.EditSettingsOptions
+
+	desired *Settings
+}
+
+// HasChanges returns true if applying the plan would change the console.
+func (plan *Plan) HasChanges() bool {
+	return len(plan.Changes) > 0
+}
+
+// String renders the plan for humans, one line per change.
+func (plan *Plan) String() string {
+	var b strings.Builder
+	for _, change := range plan.Changes {
+		fmt.Fprintf(&b, "%s: %q -> %q\n", change.Field, change.Current, change.Desired)
+	}
+	return b.String()
+}
+
+// ComputePlan diffs the desired settings against the live settings of the console.
+func ComputePlan(ctx context.Context, service blockchainv3.BlockchainV3API, desired *Settings) (*Plan, error) {
+	if err := desired.Validate(); err != nil {
+		return nil, err
+	}
+	live, err := Snapshot(ctx, service)
+	if err != nil {
+		return nil, err
+	}
+	changes, changed := diff("", reflect.ValueOf(*live), reflect.ValueOf(*desired))
+	plan := &Plan{Changes: changes, desired: desired}
+	if len(changes) == 0 {
+		return plan, nil
+	}
+
+	// The fields of Settings are named after the fields of the EditSettings body.
+	data, err := json.Marshal(changed.Interface())
+	if err != nil {
+		return nil, err
+	}
+	plan.Edit = service.NewEditSettingsOptions()
+	if err = json.Unmarshal(data, plan.Edit); err != nil {
+		return nil, err
+	}
+	return plan, nil
+}
+
+// Diff returns the settings set in desired whose value differs from live.
+func Diff(live, desired *Settings) []Change {
+	changes, _ := diff("", reflect.ValueOf(*live), reflect.ValueOf(*desired))
+	return changes
+}
+
+// diff compares the fields set in desired, a Settings struct or one of its nested structs, with live. It returns the
+// changes and a copy of desired holding only the changed fields.
+func diff(prefix string, live, desired reflect.Value) ([]Change, reflect.Value) {
+	var changes []Change
+	changed := reflect.New(desired.Type()).Elem()
+	for i := 0; i < desired.NumField(); i++ {
+		field := desired.Type().Field(i)
+		liveValue, desiredValue := live.Field(i), desired.Field(i)
+		if field.Anonymous {
+			more, changedValue := diff(prefix, liveValue, desiredValue)
+			changes = append(changes, more...)
+			changed.Field(i).Set(changedValue)
+			continue
+		}
+		if desiredValue.IsNil() {
+			continue
+		}
+		name := prefix + fieldName(field)
+		if desiredValue.Elem().Kind() == reflect.Struct {
+			liveStruct := reflect.New(desiredValue.Elem().Type()).Elem()
+			if !liveValue.IsNil() {
+				liveStruct = liveValue.Elem()
+			}
+			more, changedValue := diff(name+".", liveStruct, desiredValue.Elem())
+			if len(more) > 0 {
+				changes = append(changes, more...)
+				changed.Field(i).Set(changedValue.Addr())
+			}
+			continue
+		}
+		if liveValue.IsNil() || !reflect.DeepEqual(liveValue.Elem().Interface(), desiredValue.Elem().Interface()) {
+			changes = append(changes, Change{Field: name, Current: format(liveValue), Desired: format(desiredValue)})
+			changed.Field(i).Set(desiredValue)
+		}
+	}
+	return changes, changed
+}
+
+// format renders the value of a pointer field, or an empty string when it is nil.
+func format(value reflect.Value) string {
+	if value.IsNil() {
+		return ""
+	}
+	return fmt.Sprint(value.Elem().Interface())
+}
+
+// NotAppliedError : The console accepted the edit but reports settings that still differ from the desired ones.
+type NotAppliedError struct {
+	Changes []Change
+}
+
+func (e *NotAppliedError) Error() string {
+	fields := make([]string, len(e.Changes))
+	for i, change := range e.Changes {
+		fields[i] = change.Field
+	}
+	return fmt.Sprintf("the console did not apply the settings: %s", strings.Join(fields, ", "))
+}
+
+// Apply sends the changed settings of the plan to the console in a single EditSettings request, then checks the
+// settings the console reports back when the plan was computed by ComputePlan. Plans without changes do not send any
+// request.
+// Changes to the file logging settings or the rate limits restart the console server(s).
+func Apply(ctx context.Context, service blockchainv3.BlockchainV3API, plan *Plan) error {
+	if !plan.HasChanges() {
+		return nil
+	}
+	result, _, err := service.EditSettingsWithContext(ctx, plan.Edit)
+	if err != nil {
+		return err
+	}
+	live, err := fromResponse(result)
+	if err != nil {
+		return err
+	}
+	if plan.desired == nil {
+		return nil
+	}
+	if changes := Diff(live, plan.desired); len(changes) > 0 {
+		return &NotAppliedError{Changes: changes}
+	}
+	return nil
+}
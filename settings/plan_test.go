@@ -0,0 +1,124 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package settings_test
+
+import (
+	"context"
+	"errors"
+	"github.com/IBM-Blockchain/ibp-go-sdk/blockchainv3"
+	"github.com/IBM-Blockchain/ibp-go-sdk/blockchainv3fake"
+	"github.com/IBM-Blockchain/ibp-go-sdk/settings"
+	"github.com/IBM/go-sdk-core/v4/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// recordingConsole records the EditSettings requests, and ignores them when frozen.
+type recordingConsole struct {
+	*blockchainv3fake.Console
+	edits  []*blockchainv3.EditSettingsOptions
+	frozen bool
+}
+
+func (console *recordingConsole) EditSettingsWithContext(ctx context.Context, options *blockchainv3.EditSettingsOptions) (*blockchainv3.GetPublicSettingsResponse, *core.DetailedResponse, error) {
+	console.edits = append(console.edits, options)
+	if console.frozen {
+		return console.Console.GetSettingsWithContext(ctx, console.NewGetSettingsOptions())
+	}
+	return console.Console.EditSettingsWithContext(ctx, options)
+}
+
+var _ = Describe(`Plan`, func() {
+	const desiredYAML = `
+inactivity_timeouts:
+  max_idle_time: 120000
+file_logging:
+  server:
+    level: debug
+fabric_general_timeout_ms: 10000
+`
+	var console *recordingConsole
+	var desired *settings.Settings
+
+	BeforeEach(func() {
+		console = &recordingConsole{Console: blockchainv3fake.NewConsole()}
+		var err error
+		desired, err = settings.ParseSettings([]byte(desiredYAML))
+		Expect(err).To(BeNil())
+	})
+
+	It(`only edits the changed settings`, func() {
+		plan, err := settings.ComputePlan(context.Background(), console, desired)
+		Expect(err).To(BeNil())
+		Expect(plan.HasChanges()).To(BeTrue())
+		Expect(plan.Changes).To(Equal([]settings.Change{
+			{Field: "inactivity_timeouts.max_idle_time", Current: "90000", Desired: "120000"},
+			{Field: "file_logging.server.level", Current: "silly", Desired: "debug"},
+		}))
+		Expect(plan.String()).To(Equal("inactivity_timeouts.max_idle_time: \"90000\" -> \"120000\"\n" +
+			"file_logging.server.level: \"silly\" -> \"debug\"\n"))
+		Expect(plan.Edit).To(Equal(&blockchainv3.EditSettingsOptions{
+			InactivityTimeouts: &blockchainv3.EditSettingsBodyInactivityTimeouts{MaxIdleTime: core.Float64Ptr(120000)},
+			FileLogging:        &blockchainv3.EditLogSettingsBody{Server: &blockchainv3.LoggingSettingsServer{Level: core.StringPtr("debug")}},
+		}))
+
+		Expect(settings.Apply(context.Background(), console, plan)).To(Succeed())
+		Expect(console.edits).To(HaveLen(1))
+		live, err := settings.Snapshot(context.Background(), console)
+		Expect(err).To(BeNil())
+		Expect(*live.InactivityTimeouts.MaxIdleTime).To(Equal(float64(120000)))
+		Expect(*live.InactivityTimeouts.Enabled).To(BeFalse())
+		Expect(*live.FileLogging.Server.Level).To(Equal("debug"))
+		Expect(*live.FileLogging.Client.Level).To(Equal("silly"))
+
+		plan, err = settings.ComputePlan(context.Background(), console, desired)
+		Expect(err).To(BeNil())
+		Expect(plan.HasChanges()).To(BeFalse())
+		Expect(plan.Edit).To(BeNil())
+		Expect(settings.Apply(context.Background(), console, plan)).To(Succeed())
+		Expect(console.edits).To(HaveLen(1))
+	})
+
+	It(`aligns several consoles`, func() {
+		other := blockchainv3fake.NewConsole()
+		_, _, err := other.EditSettings(other.NewEditSettingsOptions().SetFabricGeneralTimeoutMs(30000))
+		Expect(err).To(BeNil())
+
+		for _, service := range []blockchainv3.BlockchainV3API{console, other} {
+			plan, err := settings.ComputePlan(context.Background(), service, desired)
+			Expect(err).To(BeNil())
+			Expect(settings.Apply(context.Background(), service, plan)).To(Succeed())
+		}
+		first, err := settings.Snapshot(context.Background(), console)
+		Expect(err).To(BeNil())
+		second, err := settings.Snapshot(context.Background(), other)
+		Expect(err).To(BeNil())
+		Expect(settings.Diff(first, second)).To(BeEmpty())
+		Expect(*second.FabricGeneralTimeoutMs).To(Equal(float64(10000)))
+	})
+
+	It(`reports the settings the console did not apply`, func() {
+		console.frozen = true
+		plan, err := settings.ComputePlan(context.Background(), console, desired)
+		Expect(err).To(BeNil())
+		err = settings.Apply(context.Background(), console, plan)
+		var notAppliedErr *settings.NotAppliedError
+		Expect(errors.As(err, &notAppliedErr)).To(BeTrue())
+		Expect(notAppliedErr.Changes).To(HaveLen(2))
+		Expect(err.Error()).To(Equal("the console did not apply the settings: inactivity_timeouts.max_idle_time, file_logging.server.level"))
+	})
+})
@@ -0,0 +1,259 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package settings snapshots the editable settings of an IBP console to YAML and applies desired settings to it.
+package settings
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/IBM-Blockchain/ibp-go-sdk/blockchainv3"
+	"gopkg.in/yaml.v2"
+	"io/ioutil"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// Settings : The settings of a console that EditSettings can change.
+// Fields are named after the fields of the EditSettings body. Fields left empty in desired settings keep their live
+// value.
+type Settings struct {
+	InactivityTimeouts *InactivityTimeouts `json:"inactivity_timeouts,omitempty" yaml:"inactivity_timeouts,omitempty"`
+
+	// Changes to the file logging settings restart the console server(s).
+	FileLogging *FileLogging `json:"file_logging,omitempty" yaml:"file_logging,omitempty"`
+
+	// The rate limits of the UI and API key requests, per minute. Changes restart the console server(s).
+	MaxReqPerMin   *float64 `json:"max_req_per_min,omitempty" yaml:"max_req_per_min,omitempty"`
+	MaxReqPerMinAk *float64 `json:"max_req_per_min_ak,omitempty" yaml:"max_req_per_min_ak,omitempty"`
+
+	FabricTimeouts `yaml:",inline"`
+}
+
+// InactivityTimeouts : The automatic log out of idle browser clients.
+type InactivityTimeouts struct {
+	Enabled *bool `json:"enabled,omitempty" yaml:"enabled,omitempty"`
+
+	// In milliseconds.
+	MaxIdleTime *float64 `json:"max_idle_time,omitempty" yaml:"max_idle_time,omitempty"`
+}
+
+// FileLogging : The file system logging of the browser clients and of the console server.
+type FileLogging struct {
+	Client *LogSettings `json:"client,omitempty" yaml:"client,omitempty"`
+
+	Server *LogSettings `json:"server,omitempty" yaml:"server,omitempty"`
+}
+
+// LogSettings : The file system logging of one side of the console.
+type LogSettings struct {
+	Enabled *bool `json:"enabled,omitempty" yaml:"enabled,omitempty"`
+
+	// One of "error", "warn", "info", "verbose", "debug" or "silly".
+	Level *string `json:"level,omitempty" yaml:"level,omitempty"`
+
+	// Add a random suffix to the names of the log files.
+	UniqueName *bool `json:"unique_name,omitempty" yaml:"unique_name,omitempty"`
+}
+
+// FabricTimeouts : How long the console waits for Fabric transactions, in milliseconds.
+type FabricTimeouts struct {
+	FabricGetBlockTimeoutMs    *float64 `json:"fabric_get_block_timeout_ms,omitempty" yaml:"fabric_get_block_timeout_ms,omitempty"`
+	FabricInstantiateTimeoutMs *float64 `json:"fabric_instantiate_timeout_ms,omitempty" yaml:"fabric_instantiate_timeout_ms,omitempty"`
+	FabricJoinChannelTimeoutMs *float64 `json:"fabric_join_channel_timeout_ms,omitempty" yaml:"fabric_join_channel_timeout_ms,omitempty"`
+	FabricInstallCcTimeoutMs   *float64 `json:"fabric_install_cc_timeout_ms,omitempty" yaml:"fabric_install_cc_timeout_ms,omitempty"`
+	FabricLcInstallCcTimeoutMs *float64 `json:"fabric_lc_install_cc_timeout_ms,omitempty" yaml:"fabric_lc_install_cc_timeout_ms,omitempty"`
+	FabricLcGetCcTimeoutMs     *float64 `json:"fabric_lc_get_cc_timeout_ms,omitempty" yaml:"fabric_lc_get_cc_timeout_ms,omitempty"`
+	FabricGeneralTimeoutMs     *float64 `json:"fabric_general_timeout_ms,omitempty" yaml:"fabric_general_timeout_ms,omitempty"`
+}
+
+// editableFields are the fields of GetSettings that Settings covers, under a different name.
+var editableFields = map[string]bool{
+	"INACTIVITY_TIMEOUTS": true,
+	"FILE_LOGGING":        true,
+	"MAX_REQ_PER_MIN":     true,
+	"MAX_REQ_PER_MIN_AK":  true,
+	"TIMEOUTS":            true,
+}
+
+// ReadOnlyError : The settings set fields that GetSettings reports but EditSettings cannot change, e.g. CRN or
+// CLUSTER_DATA.
+type ReadOnlyError struct {
+	Fields []string
+}
+
+func (e *ReadOnlyError) Error() string {
+	return fmt.Sprintf("read-only settings cannot be edited: %s", strings.Join(e.Fields, ", "))
+}
+
+// Snapshot returns the live editable settings of a console.
+func Snapshot(ctx context.Context, service blockchainv3.BlockchainV3API) (*Settings, error) {
+	live, _, err := service.GetSettingsWithContext(ctx, service.NewGetSettingsOptions())
+	if err != nil {
+		return nil, err
+	}
+	return fromResponse(live)
+}
+
+// fromResponse extracts the editable settings from the settings reported by the console. The console reports the
+// file logging settings twice, under FILE_LOGGING.server and FILE_LOGGING.client; the first one set is used.
+func fromResponse(live *blockchainv3.GetPublicSettingsResponse) (*Settings, error) {
+	settings := &Settings{
+		MaxReqPerMin:   live.MAXREQPERMIN,
+		MaxReqPerMinAk: live.MAXREQPERMINAK,
+	}
+	if timeouts := live.INACTIVITYTIMEOUTS; timeouts != nil {
+		settings.InactivityTimeouts = &InactivityTimeouts{Enabled: timeouts.Enabled, MaxIdleTime: timeouts.MaxIdleTime}
+	}
+	if live.FILELOGGING != nil {
+		logging := live.FILELOGGING.Server
+		if logging == nil {
+			logging = live.FILELOGGING.Client
+		}
+		if logging != nil {
+			settings.FileLogging = new(FileLogging)
+			if client := logging.Client; client != nil {
+				settings.FileLogging.Client = &LogSettings{Enabled: client.Enabled, Level: client.Level, UniqueName: client.UniqueName}
+			}
+			if server := logging.Server; server != nil {
+				settings.FileLogging.Server = &LogSettings{Enabled: server.Enabled, Level: server.Level, UniqueName: server.UniqueName}
+			}
+		}
+	}
+	if live.TIMEOUTS != nil {
+		data, err := json.Marshal(live.TIMEOUTS)
+		if err != nil {
+			return nil, err
+		}
+		if err = json.Unmarshal(data, &settings.FabricTimeouts); err != nil {
+			return nil, fmt.Errorf("invalid TIMEOUTS settings: %s", err.Error())
+		}
+	}
+	return settings, nil
+}
+
+// YAML renders the settings as YAML, in the format ParseSettings reads.
+func (settings *Settings) YAML() ([]byte, error) {
+	return yaml.Marshal(settings)
+}
+
+// LoadSettings reads and validates the settings in the YAML or JSON file at path.
+func LoadSettings(path string) (*Settings, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSettings(data)
+}
+
+// ParseSettings parses and validates YAML or JSON settings. Settings that GetSettings reports but EditSettings cannot
+// change, such as CRN, are rejected with a *ReadOnlyError.
+func ParseSettings(data []byte) (*Settings, error) {
+	var fields map[string]interface{}
+	if err := yaml.Unmarshal(data, &fields); err != nil {
+		return nil, fmt.Errorf("invalid settings: %s", err.Error())
+	}
+	if readOnly := readOnlyFields(fields); len(readOnly) > 0 {
+		return nil, &ReadOnlyError{Fields: readOnly}
+	}
+
+	settings := new(Settings)
+	if err := yaml.UnmarshalStrict(data, settings); err != nil {
+		return nil, fmt.Errorf("invalid settings: %s", err.Error())
+	}
+	if err := settings.Validate(); err != nil {
+		return nil, err
+	}
+	return settings, nil
+}
+
+// readOnlyFields returns the fields of GetSettings, other than the editable ones, among the given top level fields.
+func readOnlyFields(fields map[string]interface{}) []string {
+	known := map[string]bool{}
+	responseType := reflect.TypeOf(blockchainv3.GetPublicSettingsResponse{})
+	for i := 0; i < responseType.NumField(); i++ {
+		name := strings.Split(responseType.Field(i).Tag.Get("json"), ",")[0]
+		if name != "" && !editableFields[name] {
+			known[name] = true
+		}
+	}
+	var readOnly []string
+	for field := range fields {
+		if known[strings.ToUpper(field)] {
+			readOnly = append(readOnly, field)
+		}
+	}
+	sort.Strings(readOnly)
+	return readOnly
+}
+
+// Validate checks the log levels and that the limits and timeouts are positive.
+func (settings *Settings) Validate() error {
+	if logging := settings.FileLogging; logging != nil {
+		if err := validateLevel("client", logging.Client); err != nil {
+			return err
+		}
+		if err := validateLevel("server", logging.Server); err != nil {
+			return err
+		}
+	}
+	if timeouts := settings.InactivityTimeouts; timeouts != nil && timeouts.MaxIdleTime != nil && *timeouts.MaxIdleTime <= 0 {
+		return fmt.Errorf("inactivity_timeouts.max_idle_time must be positive")
+	}
+	numbers := map[string]*float64{
+		"max_req_per_min":    settings.MaxReqPerMin,
+		"max_req_per_min_ak": settings.MaxReqPerMinAk,
+	}
+	timeouts := reflect.ValueOf(settings.FabricTimeouts)
+	for i := 0; i < timeouts.NumField(); i++ {
+		numbers[fieldName(timeouts.Type().Field(i))] = timeouts.Field(i).Interface().(*float64)
+	}
+	var invalid []string
+	for name, number := range numbers {
+		if number != nil && *number <= 0 {
+			invalid = append(invalid, name)
+		}
+	}
+	if len(invalid) > 0 {
+		sort.Strings(invalid)
+		return fmt.Errorf("%s must be positive", strings.Join(invalid, ", "))
+	}
+	return nil
+}
+
+func validateLevel(side string, log *LogSettings) error {
+	if log != nil && log.Level != nil && !validLevels[*log.Level] {
+		return fmt.Errorf("invalid file_logging.%s.level %q", side, *log.Level)
+	}
+	return nil
+}
+
+// validLevels are the log levels of the console.
+var validLevels = map[string]bool{
+	blockchainv3.LoggingSettingsServer_Level_Error:   true,
+	blockchainv3.LoggingSettingsServer_Level_Warn:    true,
+	blockchainv3.LoggingSettingsServer_Level_Info:    true,
+	blockchainv3.LoggingSettingsServer_Level_Verbose: true,
+	blockchainv3.LoggingSettingsServer_Level_Debug:   true,
+	blockchainv3.LoggingSettingsServer_Level_Silly:   true,
+}
+
+// fieldName returns the name of a field of Settings in the EditSettings body.
+func fieldName(field reflect.StructField) string {
+	return strings.Split(field.Tag.Get("json"), ",")[0]
+}
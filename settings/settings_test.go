@@ -0,0 +1,105 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package settings_test
+
+import (
+	"context"
+	"errors"
+	"github.com/IBM-Blockchain/ibp-go-sdk/blockchainv3fake"
+	"github.com/IBM-Blockchain/ibp-go-sdk/settings"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+var _ = Describe(`Settings`, func() {
+	It(`snapshots the editable settings to YAML`, func() {
+		snapshot, err := settings.Snapshot(context.Background(), blockchainv3fake.NewConsole())
+		Expect(err).To(BeNil())
+		Expect(*snapshot.InactivityTimeouts.MaxIdleTime).To(Equal(float64(90000)))
+		Expect(*snapshot.FileLogging.Server.Level).To(Equal("silly"))
+		Expect(*snapshot.MaxReqPerMinAk).To(Equal(float64(25)))
+		Expect(*snapshot.FabricLcGetCcTimeoutMs).To(Equal(float64(180000)))
+
+		data, err := snapshot.YAML()
+		Expect(err).To(BeNil())
+		Expect(string(data)).To(Equal(`inactivity_timeouts:
+  enabled: false
+  max_idle_time: 90000
+file_logging:
+  client:
+    enabled: true
+    level: silly
+    unique_name: false
+  server:
+    enabled: true
+    level: silly
+    unique_name: false
+max_req_per_min: 25
+max_req_per_min_ak: 25
+fabric_get_block_timeout_ms: 10000
+fabric_instantiate_timeout_ms: 300000
+fabric_join_channel_timeout_ms: 25000
+fabric_install_cc_timeout_ms: 300000
+fabric_lc_install_cc_timeout_ms: 300000
+fabric_lc_get_cc_timeout_ms: 180000
+fabric_general_timeout_ms: 10000
+`))
+
+		parsed, err := settings.ParseSettings(data)
+		Expect(err).To(BeNil())
+		Expect(parsed).To(Equal(snapshot))
+	})
+
+	It(`loads settings from a file`, func() {
+		dir, err := ioutil.TempDir("", "settings")
+		Expect(err).To(BeNil())
+		defer os.RemoveAll(dir)
+		path := filepath.Join(dir, "settings.yaml")
+		Expect(ioutil.WriteFile(path, []byte("fabric_general_timeout_ms: 20000\n"), 0600)).To(Succeed())
+
+		loaded, err := settings.LoadSettings(path)
+		Expect(err).To(BeNil())
+		Expect(*loaded.FabricGeneralTimeoutMs).To(Equal(float64(20000)))
+		Expect(loaded.InactivityTimeouts).To(BeNil())
+	})
+
+	It(`rejects read-only settings`, func() {
+		_, err := settings.ParseSettings([]byte("max_req_per_min: 30\nCRN:\n  location: us-south\nCLUSTER_DATA:\n  type: paid\n"))
+		var readOnlyErr *settings.ReadOnlyError
+		Expect(errors.As(err, &readOnlyErr)).To(BeTrue())
+		Expect(readOnlyErr.Fields).To(Equal([]string{"CLUSTER_DATA", "CRN"}))
+		Expect(err.Error()).To(Equal("read-only settings cannot be edited: CLUSTER_DATA, CRN"))
+
+		_, err = settings.ParseSettings([]byte("host_url: https://elsewhere.local\n"))
+		Expect(errors.As(err, &readOnlyErr)).To(BeTrue())
+		Expect(readOnlyErr.Fields).To(Equal([]string{"host_url"}))
+	})
+
+	It(`rejects unknown and invalid settings`, func() {
+		_, err := settings.ParseSettings([]byte("fabric_timeout_ms: 100\n"))
+		Expect(err).To(MatchError(ContainSubstring("field fabric_timeout_ms not found")))
+
+		_, err = settings.ParseSettings([]byte("file_logging:\n  server:\n    level: loud\n"))
+		Expect(err).To(MatchError(`invalid file_logging.server.level "loud"`))
+
+		_, err = settings.ParseSettings([]byte("max_req_per_min: 0\nfabric_general_timeout_ms: -1\n"))
+		Expect(err).To(MatchError("fabric_general_timeout_ms, max_req_per_min must be positive"))
+	})
+})
@@ -0,0 +1,147 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package sigtx manages the signature collection transactions of a console: the channel config updates waiting for
+// the signatures of other organizations.
+package sigtx
+
+import (
+	"context"
+	"fmt"
+	"github.com/IBM-Blockchain/ibp-go-sdk/blockchainv3"
+	"github.com/IBM-Blockchain/ibp-go-sdk/clock"
+	"sort"
+	"strings"
+	"time"
+)
+
+// DefaultMaxAge is how long a signature collection may go without updates before it is considered stale by default.
+const DefaultMaxAge = 30 * 24 * time.Hour
+
+// CleanupOptions : The CleanupStale options.
+type CleanupOptions struct {
+	// Delete the collections that were not updated for longer than this. Defaults to DefaultMaxAge.
+	MaxAge time.Duration
+
+	// Only delete the collections that are still open, keeping the submitted ones.
+	OpenOnly bool
+
+	// Report the stale collections without deleting them.
+	DryRun bool
+
+	// The clock the age of the collections is computed from. Defaults to the system clock.
+	Clock clock.Clock
+}
+
+// Cleanup : The result of CleanupStale.
+type Cleanup struct {
+	// The stale collections, oldest first.
+	Stale []blockchainv3.SignatureCollection
+
+	// The transaction IDs of the collections that were deleted. Empty on a dry run.
+	Deleted []string
+
+	DryRun bool
+}
+
+// CleanupError : Some of the stale collections could not be deleted.
+type CleanupError struct {
+	// The errors of the failed deletions, by transaction ID.
+	Failed map[string]error
+}
+
+func (e *CleanupError) Error() string {
+	ids := make([]string, 0, len(e.Failed))
+	for id := range e.Failed {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	failures := make([]string, len(ids))
+	for i, id := range ids {
+		failures[i] = id + ": " + e.Failed[id].Error()
+	}
+	return fmt.Sprintf("failed to delete %d stale signature collection(s): %s", len(ids), strings.Join(failures, "; "))
+}
+
+// CleanupStale deletes the signature collections that were not updated for longer than the maximum age, using the
+// time of their last update or, when the console did not report one, of their creation. Collections with neither are
+// kept. The options may be nil.
+// Every stale collection is attempted: when some deletions fail, the cleanup is returned along with a *CleanupError.
+func CleanupStale(ctx context.Context, service blockchainv3.BlockchainV3API, options *CleanupOptions) (*Cleanup, error) {
+	if options == nil {
+		options = &CleanupOptions{}
+	}
+	maxAge := options.MaxAge
+	if maxAge <= 0 {
+		maxAge = DefaultMaxAge
+	}
+
+	list, _, err := service.ListSigTxsWithContext(ctx, service.NewListSigTxsOptions())
+	if err != nil {
+		return nil, err
+	}
+	cutoff := clock.OrSystem(options.Clock).Now().Add(-maxAge)
+	cleanup := &Cleanup{DryRun: options.DryRun}
+	for _, collection := range list.SignatureCollections {
+		if collection.TxID == nil {
+			continue
+		}
+		if options.OpenOnly && (collection.Status == nil || *collection.Status != blockchainv3.SignatureCollection_Status_Open) {
+			continue
+		}
+		if updated, ok := LastUpdate(collection); ok && updated.Before(cutoff) {
+			cleanup.Stale = append(cleanup.Stale, collection)
+		}
+	}
+	sort.SliceStable(cleanup.Stale, func(i, j int) bool {
+		first, _ := LastUpdate(cleanup.Stale[i])
+		second, _ := LastUpdate(cleanup.Stale[j])
+		return first.Before(second)
+	})
+	if options.DryRun {
+		return cleanup, nil
+	}
+
+	failed := map[string]error{}
+	for _, collection := range cleanup.Stale {
+		if err := ctx.Err(); err != nil {
+			return cleanup, err
+		}
+		id := *collection.TxID
+		if _, _, err := service.DeleteSigTxWithContext(ctx, service.NewDeleteSigTxOptions(id)); err != nil {
+			failed[id] = err
+			continue
+		}
+		cleanup.Deleted = append(cleanup.Deleted, id)
+	}
+	if len(failed) > 0 {
+		return cleanup, &CleanupError{Failed: failed}
+	}
+	return cleanup, nil
+}
+
+// LastUpdate returns the time a signature collection was last updated, or created when the console did not report an
+// update. It returns false when the collection has neither.
+func LastUpdate(collection blockchainv3.SignatureCollection) (time.Time, bool) {
+	ms := collection.Updated
+	if ms == nil || *ms == 0 {
+		ms = collection.Timestamp
+	}
+	if ms == nil || *ms == 0 {
+		return time.Time{}, false
+	}
+	return time.Unix(0, int64(*ms)*int64(time.Millisecond)), true
+}
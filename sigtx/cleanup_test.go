@@ -0,0 +1,132 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sigtx_test
+
+import (
+	"context"
+	"errors"
+	"github.com/IBM-Blockchain/ibp-go-sdk/blockchainv3"
+	"github.com/IBM-Blockchain/ibp-go-sdk/blockchainv3fake"
+	"github.com/IBM-Blockchain/ibp-go-sdk/internal/testutil"
+	"github.com/IBM-Blockchain/ibp-go-sdk/sigtx"
+	"github.com/IBM/go-sdk-core/v4/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"time"
+)
+
+const day = 24 * time.Hour
+
+var _ = Describe(`CleanupStale`, func() {
+	var console *blockchainv3fake.Console
+	var clock *testutil.Clock
+	var now time.Time
+
+	create := func(id string, age time.Duration) {
+		console.Now = func() time.Time { return now.Add(-age) }
+		orgs := []blockchainv3.SignatureCollectionOrg{{MspID: core.StringPtr("org1")}}
+		_, _, err := console.CreateSigTx(console.NewCreateSigTxOptions(id, "channel1", "cHJvcG9zYWw=", orgs))
+		Expect(err).To(BeNil())
+	}
+	remaining := func() []string {
+		list, _, err := console.ListSigTxs(console.NewListSigTxsOptions())
+		Expect(err).To(BeNil())
+		var ids []string
+		for _, collection := range list.SignatureCollections {
+			ids = append(ids, *collection.TxID)
+		}
+		return ids
+	}
+	txIDs := func(collections []blockchainv3.SignatureCollection) []string {
+		var ids []string
+		for _, collection := range collections {
+			ids = append(ids, *collection.TxID)
+		}
+		return ids
+	}
+
+	BeforeEach(func() {
+		console = blockchainv3fake.NewConsole()
+		now = time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+		create("old", 40*day)
+		create("older", 60*day)
+		create("recent", 1*day)
+		create("submitted", 45*day)
+		_, _, err := console.ApproveSigTx(console.NewApproveSigTxOptions("submitted", "org1", "Y2VydA==", "c2ln"))
+		Expect(err).To(BeNil())
+		_, _, err = console.SubmitSigTx(console.NewSubmitSigTxOptions("submitted", "org1", "Y2VydA==", "c2ln"))
+		Expect(err).To(BeNil())
+		clock = testutil.NewClock(now)
+		console.Now = clock.Now
+	})
+
+	It(`deletes the collections older than the maximum age, oldest first`, func() {
+		cleanup, err := sigtx.CleanupStale(context.Background(), console, &sigtx.CleanupOptions{MaxAge: 30 * day, Clock: clock})
+		Expect(err).To(BeNil())
+		Expect(txIDs(cleanup.Stale)).To(Equal([]string{"older", "submitted", "old"}))
+		Expect(cleanup.Deleted).To(Equal([]string{"older", "submitted", "old"}))
+		Expect(remaining()).To(Equal([]string{"recent"}))
+	})
+
+	It(`uses the default maximum age`, func() {
+		cleanup, err := sigtx.CleanupStale(context.Background(), console, &sigtx.CleanupOptions{Clock: clock})
+		Expect(err).To(BeNil())
+		Expect(cleanup.Deleted).To(HaveLen(3))
+	})
+
+	It(`uses the system clock and the default maximum age without options`, func() {
+		cleanup, err := sigtx.CleanupStale(context.Background(), console, nil)
+		Expect(err).To(BeNil())
+		Expect(cleanup.Deleted).To(Equal([]string{"older", "submitted", "old", "recent"}))
+	})
+
+	It(`keeps the submitted collections`, func() {
+		cleanup, err := sigtx.CleanupStale(context.Background(), console, &sigtx.CleanupOptions{MaxAge: 42 * day, OpenOnly: true, Clock: clock})
+		Expect(err).To(BeNil())
+		Expect(cleanup.Deleted).To(Equal([]string{"older"}))
+		Expect(remaining()).To(Equal([]string{"old", "recent", "submitted"}))
+	})
+
+	It(`reports the stale collections on a dry run`, func() {
+		cleanup, err := sigtx.CleanupStale(context.Background(), console, &sigtx.CleanupOptions{DryRun: true, Clock: clock})
+		Expect(err).To(BeNil())
+		Expect(cleanup.DryRun).To(BeTrue())
+		Expect(txIDs(cleanup.Stale)).To(Equal([]string{"older", "submitted", "old"}))
+		Expect(cleanup.Deleted).To(BeEmpty())
+		Expect(remaining()).To(HaveLen(4))
+	})
+
+	It(`attempts every deletion`, func() {
+		service := testutil.NewFailingConsole(console)
+		service.Fail("DeleteSigTx", "submitted")
+		cleanup, err := sigtx.CleanupStale(context.Background(), service, &sigtx.CleanupOptions{Clock: clock})
+		var cleanupErr *sigtx.CleanupError
+		Expect(errors.As(err, &cleanupErr)).To(BeTrue())
+		Expect(cleanupErr.Failed).To(HaveKey("submitted"))
+		Expect(err.Error()).To(Equal("failed to delete 1 stale signature collection(s): submitted: connection refused"))
+		Expect(cleanup.Deleted).To(Equal([]string{"older", "old"}))
+		Expect(remaining()).To(Equal([]string{"recent", "submitted"}))
+	})
+
+	It(`keeps the collections without timestamps`, func() {
+		_, ok := sigtx.LastUpdate(blockchainv3.SignatureCollection{TxID: core.StringPtr("unknown")})
+		Expect(ok).To(BeFalse())
+		updated, ok := sigtx.LastUpdate(blockchainv3.SignatureCollection{Timestamp: core.Float64Ptr(1000)})
+		Expect(ok).To(BeTrue())
+		Expect(updated.Unix()).To(Equal(int64(1)))
+	})
+})
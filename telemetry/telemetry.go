@@ -0,0 +1,227 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package telemetry instruments a BlockchainV3 client with OpenTelemetry. Every operation gets a client span named
+// after its operation ID (e.g. "CreatePeer") and is recorded in latency and error metrics, and the trace context is
+// propagated to the console in the request headers.
+//
+//	err := telemetry.Instrument(service, nil) // uses the global tracer and meter providers and propagator
+package telemetry
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/IBM-Blockchain/ibp-go-sdk/blockchainv3"
+	"github.com/IBM-Blockchain/ibp-go-sdk/common"
+	"github.com/IBM/go-sdk-core/v4/core"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/global"
+	"go.opentelemetry.io/otel/metric/unit"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+	"go.opentelemetry.io/otel/trace"
+	"regexp"
+	"time"
+)
+
+// InstrumentationName is the name of the tracer and the meter of this package.
+const InstrumentationName = "github.com/IBM-Blockchain/ibp-go-sdk/telemetry"
+
+// The attributes set on spans and metrics, besides the HTTP semantic conventions.
+const (
+	// The operation ID, e.g. "CreatePeer".
+	OperationKey = attribute.Key("ibp.operation")
+
+	// The ID of the component the operation is about, if any.
+	ComponentIDKey = attribute.Key("ibp.component.id")
+
+	// How many times the request was retried.
+	RetryCountKey = attribute.Key("ibp.retry_count")
+
+	// The kind of a failure: the name of the blockchainv3 error type (e.g. "NotFoundError"), "ContextError" when the
+	// context was cancelled or timed out, or "TransportError" when no response was received for another reason.
+	ErrorTypeKey = attribute.Key("ibp.error.type")
+)
+
+// The names of the metric instruments.
+const (
+	// A histogram of the duration of the operations, in milliseconds.
+	DurationMetric = "ibp.client.duration"
+
+	// A counter of the operations that failed.
+	ErrorsMetric = "ibp.client.errors"
+
+	// A counter of the retries of the operations.
+	RetriesMetric = "ibp.client.retries"
+)
+
+// Options : The telemetry options. The zero value uses the global OpenTelemetry providers and propagator.
+type Options struct {
+	// Creates the spans. Defaults to otel.GetTracerProvider().
+	TracerProvider trace.TracerProvider
+
+	// Creates the metric instruments. Defaults to global.GetMeterProvider().
+	MeterProvider metric.MeterProvider
+
+	// Injects the trace context into the request headers. Defaults to otel.GetTextMapPropagator().
+	Propagator propagation.TextMapPropagator
+}
+
+// componentPathRegexp matches the component ID in the path of the operations about a single component.
+var componentPathRegexp = regexp.MustCompile(`/components/(?:fabric-ca/|fabric-peer/|fabric-orderer/|msp/)?([^/]+)`)
+
+// notComponentIDs are the path segments that componentPathRegexp matches in the paths of other operations.
+var notComponentIDs = map[string]bool{
+	"fabric-ca": true, "fabric-peer": true, "fabric-orderer": true, "msp": true,
+	"msps": true, "tags": true, "types": true, "purge": true,
+}
+
+// Instrument registers the telemetry middleware on a client. Nil options use the global providers and propagator.
+func Instrument(service *blockchainv3.BlockchainV3, options *Options) error {
+	middleware, err := Middleware(options)
+	if err != nil {
+		return err
+	}
+	service.Use(middleware)
+	return nil
+}
+
+// Middleware returns the telemetry middleware, e.g. to register it at a specific position of a client's middleware
+// chain. It fails if a metric instrument cannot be created.
+func Middleware(options *Options) (blockchainv3.Middleware, error) {
+	opts := Options{}
+	if options != nil {
+		opts = *options
+	}
+	if opts.TracerProvider == nil {
+		opts.TracerProvider = otel.GetTracerProvider()
+	}
+	if opts.MeterProvider == nil {
+		opts.MeterProvider = global.GetMeterProvider()
+	}
+	if opts.Propagator == nil {
+		opts.Propagator = otel.GetTextMapPropagator()
+	}
+
+	tracer := opts.TracerProvider.Tracer(InstrumentationName, trace.WithInstrumentationVersion(common.Version))
+	meter := opts.MeterProvider.Meter(InstrumentationName, metric.WithInstrumentationVersion(common.Version))
+	duration, err := meter.NewFloat64Histogram(DurationMetric,
+		metric.WithUnit(unit.Milliseconds), metric.WithDescription("The duration of the IBP console operations."))
+	if err != nil {
+		return nil, err
+	}
+	errorCount, err := meter.NewInt64Counter(ErrorsMetric, metric.WithDescription("The IBP console operations that failed."))
+	if err != nil {
+		return nil, err
+	}
+	retryCount, err := meter.NewInt64Counter(RetriesMetric, metric.WithDescription("The retries of the IBP console operations."))
+	if err != nil {
+		return nil, err
+	}
+
+	return func(next blockchainv3.Handler) blockchainv3.Handler {
+		return func(operation *blockchainv3.Operation) (*core.DetailedResponse, error) {
+			start := time.Now()
+			attributes := append([]attribute.KeyValue{OperationKey.String(operation.ID)},
+				semconv.HTTPClientAttributesFromHTTPRequest(operation.Request)...)
+			if id := componentIDFromPath(operation.Request.URL.Path); id != "" {
+				attributes = append(attributes, ComponentIDKey.String(id))
+			}
+			ctx, span := tracer.Start(operation.Request.Context(), operation.ID,
+				trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(attributes...))
+			defer span.End()
+
+			operation.Request = operation.Request.WithContext(ctx)
+			opts.Propagator.Inject(ctx, propagation.HeaderCarrier(operation.Request.Header))
+			response, err := next(operation)
+
+			labels := []attribute.KeyValue{OperationKey.String(operation.ID)}
+			if response != nil && response.StatusCode != 0 {
+				span.SetAttributes(semconv.HTTPAttributesFromHTTPStatusCode(response.StatusCode)...)
+				labels = append(labels, semconv.HTTPStatusCodeKey.Int(response.StatusCode))
+			}
+			if id := componentIDFromResult(operation.Result); id != "" && componentIDFromPath(operation.Request.URL.Path) == "" {
+				span.SetAttributes(ComponentIDKey.String(id))
+			}
+			span.SetAttributes(RetryCountKey.Int(operation.Retries))
+			if operation.Retries > 0 {
+				retryCount.Add(ctx, int64(operation.Retries), labels...)
+			}
+			if err != nil {
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
+				errorType := ErrorTypeKey.String(errorType(err))
+				span.SetAttributes(errorType)
+				errorCount.Add(ctx, 1, append(labels, errorType)...)
+			}
+			duration.Record(ctx, float64(time.Since(start))/float64(time.Millisecond), labels...)
+			return response, err
+		}
+	}, nil
+}
+
+// componentIDFromPath returns the component ID in the path of an operation, if any.
+func componentIDFromPath(path string) string {
+	match := componentPathRegexp.FindStringSubmatch(path)
+	if match == nil || notComponentIDs[match[1]] {
+		return ""
+	}
+	return match[1]
+}
+
+// componentIDFromResult returns the ID of the component returned by an operation, e.g. the one CreatePeer created.
+func componentIDFromResult(result interface{}) string {
+	raw, ok := result.(*map[string]json.RawMessage)
+	if !ok || raw == nil {
+		return ""
+	}
+	var id string
+	if err := json.Unmarshal((*raw)["id"], &id); err != nil {
+		return ""
+	}
+	return id
+}
+
+// errorType names the kind of an operation failure.
+func errorType(err error) string {
+	var notFound *blockchainv3.NotFoundError
+	var conflict *blockchainv3.ConflictError
+	var authorization *blockchainv3.AuthorizationError
+	var rateLimit *blockchainv3.RateLimitError
+	var validation *blockchainv3.ValidationError
+	var service *blockchainv3.ServiceError
+	switch {
+	case errors.As(err, &notFound):
+		return "NotFoundError"
+	case errors.As(err, &conflict):
+		return "ConflictError"
+	case errors.As(err, &authorization):
+		return "AuthorizationError"
+	case errors.As(err, &rateLimit):
+		return "RateLimitError"
+	case errors.As(err, &validation):
+		return "ValidationError"
+	case errors.As(err, &service):
+		return "ServiceError"
+	case errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded):
+		return "ContextError"
+	}
+	return "TransportError"
+}
@@ -0,0 +1,164 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package telemetry_test
+
+import (
+	"context"
+	"fmt"
+	"github.com/IBM-Blockchain/ibp-go-sdk/blockchainv3"
+	"github.com/IBM-Blockchain/ibp-go-sdk/telemetry"
+	"github.com/IBM/go-sdk-core/v4/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric/metrictest"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"net/http"
+	"net/http/httptest"
+)
+
+// spanAttributes returns the attributes of a span as a map.
+func spanAttributes(span tracetest.SpanStub) map[attribute.Key]attribute.Value {
+	attributes := map[attribute.Key]attribute.Value{}
+	for _, kv := range span.Attributes {
+		attributes[kv.Key] = kv.Value
+	}
+	return attributes
+}
+
+var _ = Describe(`Telemetry`, func() {
+	var testServer *httptest.Server
+	var service *blockchainv3.BlockchainV3
+	var exporter *tracetest.InMemoryExporter
+	var meters *metrictest.MeterProvider
+	var headers []http.Header
+	var busy int
+
+	BeforeEach(func() {
+		headers = nil
+		busy = 0
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			headers = append(headers, req.Header.Clone())
+			res.Header().Set("Content-type", "application/json")
+			switch req.URL.Path {
+			case "/ak/api/v3/components/missing":
+				res.WriteHeader(404)
+				fmt.Fprint(res, `{"statusCode": 404, "msg": "component id does not exist"}`)
+			case "/ak/api/v3/settings":
+				if busy++; busy < 3 {
+					res.Header().Set("Retry-After", "0")
+					res.WriteHeader(429)
+					fmt.Fprint(res, `{"statusCode": 429, "msg": "too many requests"}`)
+					return
+				}
+				res.WriteHeader(200)
+				fmt.Fprint(res, `{"MAX_REQ_PER_MIN": 25}`)
+			default:
+				res.WriteHeader(200)
+				fmt.Fprint(res, `{"id": "peer1", "type": "fabric-peer"}`)
+			}
+		}))
+		var err error
+		service, err = blockchainv3.NewBlockchainV3(&blockchainv3.BlockchainV3Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+
+		exporter = tracetest.NewInMemoryExporter()
+		meters = metrictest.NewMeterProvider()
+		err = telemetry.Instrument(service, &telemetry.Options{
+			TracerProvider: sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter)),
+			MeterProvider:  meters,
+			Propagator:     propagation.TraceContext{},
+		})
+		Expect(err).To(BeNil())
+	})
+
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Creates a span per operation and propagates the trace context`, func() {
+		_, _, err := service.GetComponent(service.NewGetComponentOptions("peer1"))
+		Expect(err).To(BeNil())
+
+		spans := exporter.GetSpans()
+		Expect(spans).To(HaveLen(1))
+		Expect(spans[0].Name).To(Equal("GetComponent"))
+		attributes := spanAttributes(spans[0])
+		Expect(attributes[telemetry.OperationKey].AsString()).To(Equal("GetComponent"))
+		Expect(attributes[telemetry.ComponentIDKey].AsString()).To(Equal("peer1"))
+		Expect(attributes["http.status_code"].AsInt64()).To(Equal(int64(200)))
+		Expect(attributes[telemetry.RetryCountKey].AsInt64()).To(Equal(int64(0)))
+
+		traceparent := headers[0].Get("traceparent")
+		Expect(traceparent).To(ContainSubstring(spans[0].SpanContext.TraceID().String()))
+		Expect(traceparent).To(ContainSubstring(spans[0].SpanContext.SpanID().String()))
+	})
+	It(`Continues the trace of the caller`, func() {
+		provider := sdktrace.NewTracerProvider()
+		ctx, parent := provider.Tracer("test").Start(context.Background(), "provision")
+		_, _, err := service.GetSettingsWithContext(ctx, service.NewGetSettingsOptions())
+		Expect(err).ToNot(BeNil())
+		parent.End()
+
+		spans := exporter.GetSpans()
+		Expect(spans).To(HaveLen(1))
+		Expect(spans[0].Parent.SpanID()).To(Equal(parent.SpanContext().SpanID()))
+	})
+	It(`Reads the ID of a created component from the response`, func() {
+		msp := &blockchainv3.MspCryptoField{
+			Tlsca:     &blockchainv3.MspCryptoFieldTlsca{RootCerts: []string{"tlsroot"}},
+			Component: &blockchainv3.MspCryptoFieldComponent{TlsCert: core.StringPtr("tlscert")},
+		}
+		_, _, err := service.ImportPeer(service.NewImportPeerOptions("peer1", "https://proxy", msp, "org1msp"))
+		Expect(err).To(BeNil())
+		attributes := spanAttributes(exporter.GetSpans()[0])
+		Expect(attributes[telemetry.ComponentIDKey].AsString()).To(Equal("peer1"))
+	})
+	It(`Records failures and retries`, func() {
+		_, _, err := service.GetComponent(service.NewGetComponentOptions("missing"))
+		Expect(err).ToNot(BeNil())
+		service.EnableRetries(3, 0)
+		_, _, err = service.GetSettings(service.NewGetSettingsOptions())
+		Expect(err).To(BeNil())
+
+		spans := exporter.GetSpans()
+		Expect(spans).To(HaveLen(2))
+		Expect(spans[0].Status.Code).To(Equal(codes.Error))
+		Expect(spanAttributes(spans[0])[telemetry.ErrorTypeKey].AsString()).To(Equal("NotFoundError"))
+		Expect(spans[1].Status.Code).To(Equal(codes.Unset))
+		Expect(spanAttributes(spans[1])[telemetry.RetryCountKey].AsInt64()).To(Equal(int64(2)))
+
+		measured := map[string][]metrictest.Measured{}
+		for _, measurement := range metrictest.AsStructs(meters.MeasurementBatches) {
+			measured[measurement.Name] = append(measured[measurement.Name], measurement)
+		}
+		Expect(measured[telemetry.DurationMetric]).To(HaveLen(2))
+		Expect(measured[telemetry.DurationMetric][1].Labels[telemetry.OperationKey].AsString()).To(Equal("GetSettings"))
+		Expect(measured[telemetry.ErrorsMetric]).To(HaveLen(1))
+		Expect(measured[telemetry.ErrorsMetric][0].Labels["http.status_code"].AsInt64()).To(Equal(int64(404)))
+		Expect(measured[telemetry.ErrorsMetric][0].Number.AsInt64()).To(Equal(int64(1)))
+		Expect(measured[telemetry.RetriesMetric]).To(HaveLen(1))
+		Expect(measured[telemetry.RetriesMetric][0].Number.AsInt64()).To(Equal(int64(2)))
+	})
+})
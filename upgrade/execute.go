@@ -0,0 +1,107 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package upgrade
+
+import (
+	"context"
+	"fmt"
+	"github.com/IBM-Blockchain/ibp-go-sdk/blockchainv3"
+	"github.com/IBM-Blockchain/ibp-go-sdk/rolling"
+	"github.com/IBM/go-sdk-core/v4/core"
+)
+
+// ExecuteOptions : The Execute options.
+type ExecuteOptions struct {
+	// Checks the health of a component: before an orderer is upgraded, for the other nodes of its cluster, and after
+	// every step, for the upgraded component. Defaults to BlockchainV3API.WaitForComponentReady with ReadyOptions.
+	WaitForReady func(ctx context.Context, componentID string) error
+
+	// The options used by the default WaitForReady.
+	ReadyOptions *blockchainv3.WaitForComponentReadyOptions
+}
+
+// ExecuteResult : The outcome of Execute.
+type ExecuteResult struct {
+	// The steps that completed, in order.
+	Steps []*Step
+}
+
+// QuorumError : An orderer was not upgraded because too few of the other nodes of its cluster are ready.
+type QuorumError = rolling.QuorumError
+
+// Execute runs the steps of the plan in order. Before an orderer is upgraded, it checks that enough of the other nodes
+// of its cluster are ready to keep quorum, and peers that move from Fabric 1.x to 2.x have their databases upgraded
+// before their version is updated; after every step it waits for the upgraded component to be ready.
+// It stops at the first failure and returns the steps completed so far along with the error, so that the plan can be
+// computed and executed again once the problem is fixed.
+func Execute(ctx context.Context, service blockchainv3.BlockchainV3API, plan *Plan, options *ExecuteOptions) (*ExecuteResult, error) {
+	if options == nil {
+		options = new(ExecuteOptions)
+	}
+	waitForReady := options.WaitForReady
+	if waitForReady == nil {
+		waitForReady = func(ctx context.Context, componentID string) error {
+			return service.WaitForComponentReady(ctx, componentID, options.ReadyOptions)
+		}
+	}
+
+	result := new(ExecuteResult)
+	for _, step := range plan.Steps {
+		if err := execute(ctx, service, step, waitForReady); err != nil {
+			return result, fmt.Errorf("upgrade %s to %s: %w", step.ComponentID, step.To, err)
+		}
+		result.Steps = append(result.Steps, step)
+	}
+	return result, nil
+}
+
+func execute(ctx context.Context, service blockchainv3.BlockchainV3API, step *Step, waitForReady func(context.Context, string) error) error {
+	if err := rolling.CheckQuorum(ctx, step.ComponentID, step.ClusterNodes, step.Quorum, waitForReady); err != nil {
+		return err
+	}
+
+	if step.UpgradeDbs {
+		// The databases are upgraded before the peer restarts on the new version, which cannot read the old format.
+		actionOptions := service.NewPeerActionOptions(step.ComponentID)
+		actionOptions.UpgradeDbs = core.BoolPtr(true)
+		if _, _, err := service.PeerActionWithContext(ctx, actionOptions); err != nil {
+			return fmt.Errorf("could not upgrade the databases: %w", err)
+		}
+	}
+
+	var err error
+	switch step.Type {
+	case TypeCA:
+		options := service.NewUpdateCaOptions(step.ComponentID)
+		options.SetVersion(step.To)
+		_, _, err = service.UpdateCaWithContext(ctx, options)
+	case TypeOrderer:
+		options := service.NewUpdateOrdererOptions(step.ComponentID)
+		options.SetVersion(step.To)
+		_, _, err = service.UpdateOrdererWithContext(ctx, options)
+	case TypePeer:
+		options := service.NewUpdatePeerOptions(step.ComponentID)
+		options.SetVersion(step.To)
+		_, _, err = service.UpdatePeerWithContext(ctx, options)
+	default:
+		err = fmt.Errorf("components of type %q cannot be upgraded", step.Type)
+	}
+	if err != nil {
+		return err
+	}
+	return waitForReady(ctx, step.ComponentID)
+}
@@ -0,0 +1,239 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package upgrade plans and runs rolling upgrades of the Fabric version of a console's components.
+package upgrade
+
+import (
+	"context"
+	"fmt"
+	"github.com/IBM-Blockchain/ibp-go-sdk/blockchainv3"
+	"github.com/IBM-Blockchain/ibp-go-sdk/rolling"
+	"github.com/IBM/go-sdk-core/v4/core"
+	"strings"
+)
+
+// The component types that can be upgraded.
+const (
+	TypeCA      = rolling.TypeCA
+	TypePeer    = rolling.TypePeer
+	TypeOrderer = rolling.TypeOrderer
+)
+
+// Component : A deployed component and the versions it can be upgraded to.
+type Component struct {
+	ID string
+
+	DisplayName string
+
+	// One of TypeCA, TypePeer and TypeOrderer.
+	Type string
+
+	// The raft cluster of an orderer.
+	ClusterID string
+
+	// The version the component runs.
+	Version string
+
+	// The versions the console can upgrade the component to, oldest first.
+	Targets []string
+}
+
+// Inventory lists the components the console deploys, with their version and the versions they can be upgraded to.
+// Imported components, which the console does not deploy, are left out.
+func Inventory(ctx context.Context, service blockchainv3.BlockchainV3API) ([]Component, error) {
+	components, releases, err := inventory(ctx, service)
+	if err != nil {
+		return nil, err
+	}
+	for i := range components {
+		components[i].Targets = Targets(components[i].Version, releases[components[i].Type])
+	}
+	return components, nil
+}
+
+func inventory(ctx context.Context, service blockchainv3.BlockchainV3API) ([]Component, map[string][]Release, error) {
+	versions, _, err := service.GetFabVersionsWithContext(ctx, service.NewGetFabVersionsOptions())
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not get the Fabric versions: %w", err)
+	}
+	listOptions := service.NewListComponentsOptions()
+	listOptions.SetDeploymentAttrs(blockchainv3.ListComponentsOptions_DeploymentAttrs_Included)
+	list, _, err := service.ListComponentsWithContext(ctx, listOptions)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not list the components: %w", err)
+	}
+
+	var components []Component
+	for _, c := range list.Components {
+		componentType := core.StringNilMapper(c.Type)
+		if componentType != TypeCA && componentType != TypePeer && componentType != TypeOrderer {
+			continue
+		}
+		if c.Version == nil || *c.Version == "" {
+			continue
+		}
+		components = append(components, Component{
+			ID:          core.StringNilMapper(c.ID),
+			DisplayName: core.StringNilMapper(c.DisplayName),
+			Type:        componentType,
+			ClusterID:   core.StringNilMapper(c.ClusterID),
+			Version:     *c.Version,
+		})
+	}
+	return components, Releases(versions), nil
+}
+
+// PlanOptions : The ComputePlan options.
+type PlanOptions struct {
+	// The version to upgrade each component type (TypeCA, TypePeer, TypeOrderer) to. Defaults to the version the console
+	// deploys by default. Components already running the version, or a newer one, are left alone.
+	Versions map[string]string
+
+	// Only upgrade these components. All the deployed components by default.
+	ComponentIDs []string
+
+	// Allow upgrading raft clusters of fewer than three nodes, which lose quorum while a node restarts.
+	AllowQuorumLoss bool
+}
+
+// Step : The upgrade of one component.
+type Step struct {
+	ComponentID string
+
+	DisplayName string
+
+	Type string
+
+	// The raft cluster of an orderer.
+	ClusterID string
+
+	From string
+
+	To string
+
+	// Whether the ledger databases of a peer must be upgraded, which is the case when it moves from Fabric 1.x to 2.x.
+	UpgradeDbs bool
+
+	// The other nodes of the raft cluster of an orderer.
+	ClusterNodes []string
+
+	// How many of ClusterNodes must be ready before the orderer is upgraded, so that the cluster keeps its quorum
+	// while the orderer restarts.
+	Quorum int
+}
+
+// Plan : The ordered steps of a rolling upgrade: CAs first, then the orderers one at a time, then the peers.
+type Plan struct {
+	Steps []*Step
+}
+
+// String renders the plan for humans, one line per step.
+func (plan *Plan) String() string {
+	var b strings.Builder
+	for _, step := range plan.Steps {
+		fmt.Fprintf(&b, "%s/%s: %s -> %s", step.Type, step.ComponentID, step.From, step.To)
+		if step.UpgradeDbs {
+			b.WriteString(" (upgrade dbs)")
+		}
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+// ComputePlan plans the upgrade of the deployed components. CAs are upgraded first, then the orderers one at a time,
+// cluster by cluster, and the peers last, so that no peer runs a newer Fabric version than the ordering service.
+// It fails if a component cannot be upgraded to its target version, or if a raft cluster would lose its quorum and
+// AllowQuorumLoss is not set.
+func ComputePlan(ctx context.Context, service blockchainv3.BlockchainV3API, options *PlanOptions) (*Plan, error) {
+	if options == nil {
+		options = new(PlanOptions)
+	}
+	components, releases, err := inventory(ctx, service)
+	if err != nil {
+		return nil, err
+	}
+	selected := map[string]bool{}
+	for _, id := range options.ComponentIDs {
+		selected[id] = true
+	}
+	clusters := rolling.Clusters{}
+	for _, component := range components {
+		if component.Type == TypeOrderer {
+			clusters.Add(component.ClusterID, component.ID)
+		}
+	}
+
+	var steps []*Step
+	for _, component := range components {
+		if len(selected) > 0 && !selected[component.ID] {
+			continue
+		}
+		target := options.Versions[component.Type]
+		if target == "" {
+			target = defaultRelease(releases[component.Type])
+		}
+		if target == "" || !isNewer(component.Version, target) {
+			continue
+		}
+		if err := CanUpgrade(component.Version, target); err != nil {
+			return nil, fmt.Errorf("%s: %w", component.ID, err)
+		}
+		step := &Step{
+			ComponentID: component.ID,
+			DisplayName: component.DisplayName,
+			Type:        component.Type,
+			ClusterID:   component.ClusterID,
+			From:        component.Version,
+			To:          target,
+			UpgradeDbs:  component.Type == TypePeer && crossesMajor(component.Version, target),
+		}
+		if component.Type == TypeOrderer {
+			step.ClusterNodes, step.Quorum, err = clusters.Quorum(component.ID, component.ClusterID, options.AllowQuorumLoss)
+			if err != nil {
+				return nil, err
+			}
+		}
+		steps = append(steps, step)
+	}
+
+	plan := &Plan{}
+	for _, i := range rolling.Order(len(steps), func(i int) (string, string) { return steps[i].Type, steps[i].ClusterID }) {
+		plan.Steps = append(plan.Steps, steps[i])
+	}
+	return plan, nil
+}
+
+// defaultRelease returns the version the console deploys by default, or "" if it has none.
+func defaultRelease(releases []Release) string {
+	for _, release := range releases {
+		if release.Default {
+			return release.Version
+		}
+	}
+	return ""
+}
+
+// isNewer returns true if target is a newer version than current. Versions that cannot be parsed are left to
+// CanUpgrade to reject.
+func isNewer(current, target string) bool {
+	a, errA := ParseVersion(current)
+	b, errB := ParseVersion(target)
+	if errA != nil || errB != nil {
+		return current != target
+	}
+	return a.Less(b)
+}
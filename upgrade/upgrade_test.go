@@ -0,0 +1,235 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package upgrade_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/IBM-Blockchain/ibp-go-sdk/blockchainv3"
+	"github.com/IBM-Blockchain/ibp-go-sdk/blockchainv3fake"
+	"github.com/IBM-Blockchain/ibp-go-sdk/internal/testutil"
+	"github.com/IBM-Blockchain/ibp-go-sdk/upgrade"
+	"github.com/IBM/go-sdk-core/v4/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+const fabVersions = `{"versions": {
+	"ca": {
+		"1.4.9-5": {"default": false, "version": "1.4.9-5"},
+		"1.5.0-1": {"default": true, "version": "1.5.0-1"}
+	},
+	"peer": {
+		"1.3.0-0": {"default": false, "version": "1.3.0-0"},
+		"1.4.12-2": {"default": false, "version": "1.4.12-2"},
+		"2.2.1-4": {"default": true, "version": "2.2.1-4"},
+		"2.2.3-1": {"default": false, "version": "2.2.3-1"}
+	},
+	"orderer": {
+		"1.4.12-2": {"default": false, "version": "1.4.12-2"},
+		"2.2.1-4": {"default": true, "version": "2.2.1-4"}
+	}
+}}`
+
+func setFabVersions(console *blockchainv3fake.Console, data string) {
+	var raw map[string]json.RawMessage
+	Expect(json.Unmarshal([]byte(data), &raw)).To(Succeed())
+	var versions *blockchainv3.GetFabricVersionsResponse
+	Expect(core.UnmarshalModel(raw, "", &versions, blockchainv3.UnmarshalGetFabricVersionsResponse)).To(Succeed())
+	console.SetFabVersions(versions)
+}
+
+func version(console *blockchainv3fake.Console, id string) string {
+	component, _, err := console.GetComponent(console.NewGetComponentOptions(id))
+	Expect(err).To(BeNil())
+	return *component.Version
+}
+
+// orderedConsole records the order of the peer actions and updates.
+type orderedConsole struct {
+	*blockchainv3fake.Console
+	calls []string
+}
+
+func (console *orderedConsole) PeerActionWithContext(ctx context.Context, options *blockchainv3.PeerActionOptions) (*blockchainv3.ActionsResponse, *core.DetailedResponse, error) {
+	console.calls = append(console.calls, "PeerAction")
+	return console.Console.PeerActionWithContext(ctx, options)
+}
+
+func (console *orderedConsole) UpdatePeerWithContext(ctx context.Context, options *blockchainv3.UpdatePeerOptions) (*blockchainv3.PeerResponse, *core.DetailedResponse, error) {
+	console.calls = append(console.calls, "UpdatePeer")
+	return console.Console.UpdatePeerWithContext(ctx, options)
+}
+
+var _ = Describe(`Upgrade`, func() {
+	var console *blockchainv3fake.Console
+	var ordererIDs []string
+
+	BeforeEach(func() {
+		console = blockchainv3fake.NewConsole()
+		setFabVersions(console, fabVersions)
+
+		caOptions := console.NewCreateCaOptions("CA", &blockchainv3.CreateCaBodyConfigOverride{
+			Ca: &blockchainv3.ConfigCACreate{Registry: &blockchainv3.ConfigCARegistry{
+				Maxenrollments: core.Float64Ptr(-1),
+				Identities: []blockchainv3.ConfigCARegistryIdentitiesItem{
+					{Name: core.StringPtr("admin"), Pass: core.StringPtr("adminpw"), Type: core.StringPtr("client")},
+				},
+			}},
+		})
+		caOptions.SetID("ca")
+		caOptions.SetVersion("1.4.9-5")
+		_, _, err := console.CreateCa(caOptions)
+		Expect(err).To(BeNil())
+
+		peerOptions := console.NewCreatePeerOptions("org1msp", "Peer", testutil.CryptoObject())
+		peerOptions.SetID("peer")
+		peerOptions.SetVersion("1.4.12-2")
+		_, _, err = console.CreatePeer(peerOptions)
+		Expect(err).To(BeNil())
+
+		ordererOptions := console.NewCreateOrdererOptions("raft", "osmsp", "Orderer",
+			[]blockchainv3.CryptoObject{*testutil.CryptoObject(), *testutil.CryptoObject(), *testutil.CryptoObject()})
+		ordererOptions.SetVersion("1.4.12-2")
+		created, _, err := console.CreateOrderer(ordererOptions)
+		Expect(err).To(BeNil())
+		ordererIDs = nil
+		for _, node := range created.Created {
+			ordererIDs = append(ordererIDs, *node.ID)
+		}
+
+		importOptions := console.NewImportPeerOptions("Imported", "https://proxy", &blockchainv3.MspCryptoField{
+			Tlsca:     &blockchainv3.MspCryptoFieldTlsca{RootCerts: []string{"tlsroot"}},
+			Component: &blockchainv3.MspCryptoFieldComponent{TlsCert: core.StringPtr("tlscert")},
+		}, "org2msp")
+		_, _, err = console.ImportPeer(importOptions)
+		Expect(err).To(BeNil())
+	})
+
+	It(`Checks upgrade paths`, func() {
+		Expect(upgrade.CanUpgrade("1.4.12-2", "2.2.1-4")).To(Succeed())
+		Expect(upgrade.CanUpgrade("2.2.1-4", "2.2.3-1")).To(Succeed())
+		Expect(upgrade.CanUpgrade("1.4.9-5", "1.4.9-6")).To(Succeed())
+		Expect(upgrade.CanUpgrade("1.3.0-0", "2.2.1-4")).To(MatchError(ContainSubstring("upgrade 1.3.0-0 to 1.4 before")))
+		Expect(upgrade.CanUpgrade("2.2.1-4", "1.4.12-2")).To(MatchError(ContainSubstring("is not newer")))
+		Expect(upgrade.CanUpgrade("2.2.1-4", "2.2.1-4")).ToNot(Succeed())
+		Expect(upgrade.CanUpgrade("latest", "2.2.1-4")).To(MatchError(ContainSubstring("invalid version")))
+	})
+	It(`Lists the components with their upgrade targets`, func() {
+		components, err := upgrade.Inventory(context.Background(), console)
+		Expect(err).To(BeNil())
+		Expect(components).To(HaveLen(5))
+		Expect(components[0]).To(Equal(upgrade.Component{
+			ID: "ca", DisplayName: "CA", Type: upgrade.TypeCA, Version: "1.4.9-5", Targets: []string{"1.5.0-1"},
+		}))
+		Expect(components[1].Targets).To(Equal([]string{"2.2.1-4", "2.2.3-1"}))
+		Expect(components[2].Type).To(Equal(upgrade.TypeOrderer))
+		Expect(components[2].ClusterID).ToNot(BeEmpty())
+		Expect(components[2].Targets).To(Equal([]string{"2.2.1-4"}))
+	})
+	It(`Plans a rolling upgrade to the default versions`, func() {
+		plan, err := upgrade.ComputePlan(context.Background(), console, nil)
+		Expect(err).To(BeNil())
+		Expect(plan.Steps).To(HaveLen(5))
+		Expect(plan.Steps[0].ComponentID).To(Equal("ca"))
+		Expect(plan.Steps[0].To).To(Equal("1.5.0-1"))
+		for i, step := range plan.Steps[1:4] {
+			Expect(step.ComponentID).To(Equal(ordererIDs[i]))
+			Expect(step.To).To(Equal("2.2.1-4"))
+			Expect(step.Quorum).To(Equal(2))
+			Expect(step.ClusterNodes).To(HaveLen(2))
+			Expect(step.ClusterNodes).ToNot(ContainElement(step.ComponentID))
+		}
+		Expect(plan.Steps[4].ComponentID).To(Equal("peer"))
+		Expect(plan.Steps[4].UpgradeDbs).To(BeTrue())
+		Expect(plan.String()).To(ContainSubstring("fabric-peer/peer: 1.4.12-2 -> 2.2.1-4 (upgrade dbs)\n"))
+	})
+	It(`Plans the requested versions and components`, func() {
+		plan, err := upgrade.ComputePlan(context.Background(), console, &upgrade.PlanOptions{
+			Versions:     map[string]string{upgrade.TypePeer: "2.2.3-1"},
+			ComponentIDs: []string{"peer"},
+		})
+		Expect(err).To(BeNil())
+		Expect(plan.Steps).To(HaveLen(1))
+		Expect(plan.Steps[0].To).To(Equal("2.2.3-1"))
+
+		_, err = upgrade.ComputePlan(context.Background(), console, &upgrade.PlanOptions{
+			Versions: map[string]string{upgrade.TypePeer: "3.0.0-0"},
+		})
+		Expect(err).To(MatchError(ContainSubstring("cannot upgrade from 1.4.12-2 to 3.0.0-0")))
+	})
+	It(`Refuses to plan upgrades that lose raft quorum`, func() {
+		_, _, err := console.RemoveComponent(console.NewRemoveComponentOptions(ordererIDs[2]))
+		Expect(err).To(BeNil())
+		_, err = upgrade.ComputePlan(context.Background(), console, nil)
+		Expect(err).To(MatchError(ContainSubstring("the raft cluster has 2 node(s)")))
+
+		plan, err := upgrade.ComputePlan(context.Background(), console, &upgrade.PlanOptions{AllowQuorumLoss: true})
+		Expect(err).To(BeNil())
+		Expect(plan.Steps[1].Quorum).To(Equal(0))
+	})
+	It(`Executes the plan`, func() {
+		plan, err := upgrade.ComputePlan(context.Background(), console, nil)
+		Expect(err).To(BeNil())
+		result, err := upgrade.Execute(context.Background(), console, plan, nil)
+		Expect(err).To(BeNil())
+		Expect(result.Steps).To(Equal(plan.Steps))
+
+		Expect(version(console, "ca")).To(Equal("1.5.0-1"))
+		for _, id := range ordererIDs {
+			Expect(version(console, id)).To(Equal("2.2.1-4"))
+		}
+		Expect(version(console, "peer")).To(Equal("2.2.1-4"))
+		Expect(console.Actions("peer")).To(Equal([]string{"upgrade_dbs"}))
+
+		plan, err = upgrade.ComputePlan(context.Background(), console, nil)
+		Expect(err).To(BeNil())
+		Expect(plan.Steps).To(BeEmpty())
+	})
+	It(`Upgrades the databases of a peer before its version`, func() {
+		plan, err := upgrade.ComputePlan(context.Background(), console, &upgrade.PlanOptions{ComponentIDs: []string{"peer"}})
+		Expect(err).To(BeNil())
+		ordered := &orderedConsole{Console: console}
+		_, err = upgrade.Execute(context.Background(), ordered, plan, nil)
+		Expect(err).To(BeNil())
+		Expect(ordered.calls).To(Equal([]string{"PeerAction", "UpdatePeer"}))
+	})
+	It(`Stops before an orderer upgrade that would lose quorum`, func() {
+		plan, err := upgrade.ComputePlan(context.Background(), console, nil)
+		Expect(err).To(BeNil())
+		console.SetComponentReady(ordererIDs[1], false)
+
+		result, err := upgrade.Execute(context.Background(), console, plan, nil)
+		var quorumErr *upgrade.QuorumError
+		Expect(errors.As(err, &quorumErr)).To(BeTrue())
+		Expect(quorumErr.ComponentID).To(Equal(ordererIDs[0]))
+		Expect(quorumErr.Ready).To(Equal(1))
+		Expect(result.Steps).To(HaveLen(1))
+		Expect(version(console, ordererIDs[0])).To(Equal("1.4.12-2"))
+	})
+	It(`Stops when an upgraded component is not healthy`, func() {
+		plan, err := upgrade.ComputePlan(context.Background(), console, nil)
+		Expect(err).To(BeNil())
+		console.SetComponentReady("ca", false)
+
+		result, err := upgrade.Execute(context.Background(), console, plan, nil)
+		var notReady *blockchainv3.ComponentNotReadyError
+		Expect(errors.As(err, &notReady)).To(BeTrue())
+		Expect(result.Steps).To(BeEmpty())
+	})
+})
@@ -0,0 +1,169 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package upgrade
+
+import (
+	"fmt"
+	"github.com/IBM-Blockchain/ibp-go-sdk/blockchainv3"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Release : A Fabric version the console can deploy.
+type Release struct {
+	// The version, e.g. "2.2.1-4": the Fabric release followed by the console's build number.
+	Version string
+
+	// Whether the console deploys this version by default.
+	Default bool
+}
+
+// Releases returns the Fabric versions the console can deploy for each component type (TypeCA, TypePeer and
+// TypeOrderer), oldest first.
+func Releases(versions *blockchainv3.GetFabricVersionsResponse) map[string][]Release {
+	releases := map[string][]Release{}
+	if versions == nil || versions.Versions == nil {
+		return releases
+	}
+	for componentType, dictionary := range map[string]*blockchainv3.FabricVersionDictionary{
+		TypeCA:      versions.Versions.Ca,
+		TypePeer:    versions.Versions.Peer,
+		TypeOrderer: versions.Versions.Orderer,
+	} {
+		if dictionary != nil {
+			releases[componentType] = dictionaryReleases(dictionary)
+		}
+	}
+	return releases
+}
+
+// dictionaryReleases returns the releases of a version dictionary, from its declared and additional properties.
+func dictionaryReleases(dictionary *blockchainv3.FabricVersionDictionary) []Release {
+	var releases []Release
+	add := func(key string, version *string, isDefault *bool) {
+		release := Release{Version: key}
+		if version != nil && *version != "" {
+			release.Version = *version
+		}
+		release.Default = isDefault != nil && *isDefault
+		if _, err := ParseVersion(release.Version); err == nil {
+			releases = append(releases, release)
+		}
+	}
+	if v := dictionary.X1462; v != nil {
+		add("1.4.6-2", v.Version, v.Default)
+	}
+	if v := dictionary.X2100; v != nil {
+		add("2.1.0-0", v.Version, v.Default)
+	}
+	for key, value := range dictionary.GetProperties() {
+		properties, _ := value.(map[string]interface{})
+		version, _ := properties["version"].(string)
+		isDefault, _ := properties["default"].(bool)
+		add(key, &version, &isDefault)
+	}
+	sort.Slice(releases, func(i, j int) bool {
+		a, _ := ParseVersion(releases[i].Version)
+		b, _ := ParseVersion(releases[j].Version)
+		return a.Less(b)
+	})
+	return releases
+}
+
+// Version : A parsed component version, e.g. "1.4.12-2".
+type Version struct {
+	Major, Minor, Patch int
+
+	// The console's build number of the release, the part after the dash. Zero if there is none.
+	Build int
+}
+
+// ParseVersion parses a version of the form "<major>.<minor>.<patch>[-<build>]".
+func ParseVersion(version string) (Version, error) {
+	release, build := version, "0"
+	if i := strings.Index(version, "-"); i >= 0 {
+		release, build = version[:i], version[i+1:]
+	}
+	parts := strings.Split(release, ".")
+	if len(parts) != 3 {
+		return Version{}, fmt.Errorf("invalid version %q", version)
+	}
+	numbers := make([]int, 4)
+	for i, part := range append(parts, build) {
+		n, err := strconv.Atoi(part)
+		if err != nil || n < 0 {
+			return Version{}, fmt.Errorf("invalid version %q", version)
+		}
+		numbers[i] = n
+	}
+	return Version{Major: numbers[0], Minor: numbers[1], Patch: numbers[2], Build: numbers[3]}, nil
+}
+
+// Less returns true if v is older than other.
+func (v Version) Less(other Version) bool {
+	a := []int{v.Major, v.Minor, v.Patch, v.Build}
+	b := []int{other.Major, other.Minor, other.Patch, other.Build}
+	for i := range a {
+		if a[i] != b[i] {
+			return a[i] < b[i]
+		}
+	}
+	return false
+}
+
+// CanUpgrade returns nil if a component can be upgraded from one version to another: to a newer release of the same
+// major version, or from Fabric 1.4 to 2.x. Older 1.x releases must be upgraded to 1.4 first.
+func CanUpgrade(from, to string) error {
+	current, err := ParseVersion(from)
+	if err != nil {
+		return err
+	}
+	target, err := ParseVersion(to)
+	if err != nil {
+		return err
+	}
+	switch {
+	case !current.Less(target):
+		return fmt.Errorf("%s is not newer than %s", to, from)
+	case target.Major == current.Major:
+		return nil
+	case current.Major == 1 && current.Minor == 4 && target.Major == 2:
+		return nil
+	case current.Major == 1 && target.Major == 2:
+		return fmt.Errorf("upgrade %s to 1.4 before upgrading it to %s", from, to)
+	}
+	return fmt.Errorf("cannot upgrade from %s to %s", from, to)
+}
+
+// Targets returns the releases a component of the given version can be upgraded to, oldest first.
+func Targets(current string, releases []Release) []string {
+	var targets []string
+	for _, release := range releases {
+		if CanUpgrade(current, release.Version) == nil {
+			targets = append(targets, release.Version)
+		}
+	}
+	return targets
+}
+
+// crossesMajor returns true if an upgrade moves to another major Fabric version.
+func crossesMajor(from, to string) bool {
+	a, errA := ParseVersion(from)
+	b, errB := ParseVersion(to)
+	return errA == nil && errB == nil && a.Major != b.Major
+}